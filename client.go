@@ -26,7 +26,8 @@ type Client struct {
 	RTDS   rtds.Client
 	CTF    ctf.Client
 
-	builderCfg *auth.BuilderConfig
+	builderCfg    *auth.BuilderConfig
+	outcomeTokens outcomeTokenCache
 }
 
 // NewClient creates a new root client with optional overrides.
@@ -40,30 +41,44 @@ func NewClient(opts ...Option) *Client {
 	}
 
 	// 3. Ensure a default HTTP client with timeout if none was provided.
-	if c.Config.HTTPClient == nil && c.Config.Timeout > 0 {
-		c.Config.HTTPClient = &http.Client{Timeout: c.Config.Timeout}
+	if c.Config.HTTPClient == nil && (c.Config.Timeout > 0 || c.Config.HTTPTransport != nil) {
+		httpClient := &http.Client{Timeout: c.Config.Timeout}
+		if c.Config.HTTPTransport != nil {
+			httpClient.Transport = c.Config.HTTPTransport
+		}
+		c.Config.HTTPClient = httpClient
 	}
 
 	// 4. Initialize default transports and clients (if not overridden)
 	if c.CLOB == nil {
 		clobTransport := transport.NewClient(c.Config.HTTPClient, c.Config.BaseURLs.CLOB)
 		clobTransport.SetUserAgent(c.Config.UserAgent)
+		setHeaders(clobTransport, c.Config.Headers)
+		clobTransport.SetRateLimiter(c.Config.RateLimiter)
 		clobTransport.SetUseServerTime(c.Config.UseServerTime)
+		clobTransport.SetConditionalCache(c.Config.ConditionalCacheEntries)
 		c.CLOB = clob.NewClientWithGeoblock(clobTransport, c.Config.BaseURLs.Geoblock)
 	}
 	if c.Gamma == nil {
 		gammaTransport := transport.NewClient(c.Config.HTTPClient, c.Config.BaseURLs.Gamma)
 		gammaTransport.SetUserAgent(c.Config.UserAgent)
+		setHeaders(gammaTransport, c.Config.Headers)
+		gammaTransport.SetRateLimiter(c.Config.RateLimiter)
+		gammaTransport.SetConditionalCache(c.Config.ConditionalCacheEntries)
 		c.Gamma = gamma.NewClient(gammaTransport)
 	}
 	if c.Data == nil {
 		dataTransport := transport.NewClient(c.Config.HTTPClient, c.Config.BaseURLs.Data)
 		dataTransport.SetUserAgent(c.Config.UserAgent)
+		setHeaders(dataTransport, c.Config.Headers)
+		dataTransport.SetRateLimiter(c.Config.RateLimiter)
 		c.Data = data.NewClient(dataTransport)
 	}
 	if c.Bridge == nil {
 		bridgeTransport := transport.NewClient(c.Config.HTTPClient, c.Config.BaseURLs.Bridge)
 		bridgeTransport.SetUserAgent(c.Config.UserAgent)
+		setHeaders(bridgeTransport, c.Config.Headers)
+		bridgeTransport.SetRateLimiter(c.Config.RateLimiter)
 		c.Bridge = bridge.NewClient(bridgeTransport)
 	}
 	if c.RTDS == nil {
@@ -93,6 +108,12 @@ func NewClient(opts ...Option) *Client {
 	return c
 }
 
+func setHeaders(t *transport.Client, headers http.Header) {
+	for key := range headers {
+		t.SetHeader(key, headers.Get(key))
+	}
+}
+
 // WithAuth returns a new client with auth credentials applied to all sub-clients.
 func (c *Client) WithAuth(signer auth.Signer, apiKey *auth.APIKey) *Client {
 	if c.CLOB != nil {
@@ -1,7 +1,15 @@
 package polymarket
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
 
 func TestNewClientWithOptions(t *testing.T) {
@@ -29,3 +37,95 @@ func TestAttributionOptions(t *testing.T) {
 		WithBuilderAttribution("key", "secret", "pass"),
 	)
 }
+
+func TestRateLimiterSharedAcrossSubClients(t *testing.T) {
+	doer := &countingDoer{body: `{"data":"OK"}`}
+	c := NewClient(WithHTTPClient(doer), WithRateLimit(0.001, 2))
+	if c.Config.RateLimiter == nil {
+		t.Fatal("expected WithRateLimit to configure a limiter")
+	}
+	ctx := context.Background()
+
+	if _, err := c.CLOB.Health(ctx); err != nil {
+		t.Fatalf("CLOB Health failed: %v", err)
+	}
+	if _, err := c.Data.Health(ctx); err != nil {
+		t.Fatalf("Data Health failed: %v", err)
+	}
+	if got := c.Config.RateLimiter.Available(); got != 0 {
+		t.Errorf("available tokens = %d, want 0 after two requests", got)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.Gamma.Status(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected gamma request to wait on the shared limiter, got %v", err)
+	}
+	if doer.calls != 2 {
+		t.Errorf("doer calls = %d, want 2", doer.calls)
+	}
+}
+
+func TestConnectionPoolOption(t *testing.T) {
+	c := NewClient(WithConnectionPool(transport.PoolConfig{MaxIdleConnsPerHost: 48}))
+	httpClient, ok := c.Config.HTTPClient.(*http.Client)
+	if !ok {
+		t.Fatalf("expected *http.Client, got %T", c.Config.HTTPClient)
+	}
+	pooled, ok := httpClient.Transport.(*http.Transport)
+	if !ok || pooled.MaxIdleConnsPerHost != 48 {
+		t.Fatalf("expected pooled transport, got %#v", httpClient.Transport)
+	}
+	if httpClient.Timeout != DefaultConfig().Timeout {
+		t.Errorf("timeout = %v, want default", httpClient.Timeout)
+	}
+}
+
+func TestConditionalCacheOption(t *testing.T) {
+	var revalidated int
+	doer := &transportDoer{fn: func(req *http.Request) (int, http.Header, string) {
+		header := http.Header{"Etag": []string{`"m1"`}}
+		if req.Header.Get("If-None-Match") == `"m1"` {
+			revalidated++
+			return http.StatusNotModified, header, ""
+		}
+		return http.StatusOK, header, `{"data":[{"condition_id":"c1"}],"next_cursor":"LTE="}`
+	}}
+	c := NewClient(WithHTTPClient(doer), WithConditionalCache(16))
+	for i := 0; i < 2; i++ {
+		resp, err := c.CLOB.Markets(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("Markets %d failed: %v", i, err)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].ConditionID != "c1" {
+			t.Fatalf("Markets %d returned %+v", i, resp)
+		}
+	}
+	if revalidated != 1 {
+		t.Errorf("expected the second poll to be revalidated, got %d", revalidated)
+	}
+}
+
+type transportDoer struct {
+	fn func(req *http.Request) (int, http.Header, string)
+}
+
+func (d *transportDoer) Do(req *http.Request) (*http.Response, error) {
+	status, header, body := d.fn(req)
+	return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestHeaderOption(t *testing.T) {
+	var got http.Header
+	doer := &transportDoer{fn: func(req *http.Request) (int, http.Header, string) {
+		got = req.Header.Clone()
+		return http.StatusOK, http.Header{}, `{"data":"OK"}`
+	}}
+	c := NewClient(WithHTTPClient(doer), WithUserAgent("my-bot/2.0"), WithHeader("X-Gateway-Route", "eu"))
+	if _, err := c.Data.Health(context.Background()); err != nil {
+		t.Fatalf("Health failed: %v", err)
+	}
+	if got.Get("X-Gateway-Route") != "eu" || got.Get("User-Agent") != "my-bot/2.0" {
+		t.Errorf("unexpected headers: %v", got)
+	}
+}
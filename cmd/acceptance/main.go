@@ -14,6 +14,7 @@ import (
 
 	polymarket "github.com/GoPolymarket/polymarket-go-sdk"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/ws"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/rtds"
@@ -341,8 +342,6 @@ func pickMarketAndToken(ctx context.Context, client *polymarket.Client, timeout
 
 	var conditionID string
 	var altID string
-	maxTokens := 50
-	tokens := make([]string, 0, maxTokens)
 	for _, market := range resp.Data {
 		if conditionID == "" {
 			if market.ConditionID != "" {
@@ -354,19 +353,8 @@ func pickMarketAndToken(ctx context.Context, client *polymarket.Client, timeout
 		if altID == "" && market.ID != "" {
 			altID = market.ID
 		}
-		for _, token := range market.Tokens {
-			if token.TokenID != "" {
-				tokens = append(tokens, token.TokenID)
-				if len(tokens) >= maxTokens {
-					break
-				}
-			}
-		}
-		if len(tokens) >= maxTokens {
-			break
-		}
 	}
-	tokenID := findTokenWithOrderbook(ctx, client, timeout, tokens)
+	tokenID := findTokenWithOrderbook(ctx, client, timeout)
 	return conditionID, altID, tokenID, nil
 }
 
@@ -407,31 +395,15 @@ func printSummary(results []checkResult) {
 	}
 }
 
-func findTokenWithOrderbook(ctx context.Context, client *polymarket.Client, timeout time.Duration, tokens []string) string {
-	if len(tokens) == 0 {
+func findTokenWithOrderbook(ctx context.Context, client *polymarket.Client, timeout time.Duration) string {
+	token, err := clob.FindTradableToken(ctx, client.CLOB, &clob.TradableTokenOptions{
+		MaxMarkets:   25,
+		ProbeTimeout: minDuration(timeout, 2*time.Second),
+	})
+	if err != nil {
 		return ""
 	}
-	maxChecks := 25
-	if len(tokens) < maxChecks {
-		maxChecks = len(tokens)
-	}
-	probeTimeout := minDuration(timeout, 2*time.Second)
-	for i := 0; i < maxChecks; i++ {
-		if ctx.Err() != nil {
-			return ""
-		}
-		token := strings.TrimSpace(tokens[i])
-		if token == "" {
-			continue
-		}
-		probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
-		_, err := client.CLOB.OrderBook(probeCtx, &clobtypes.BookRequest{TokenID: token})
-		cancel()
-		if err == nil {
-			return token
-		}
-	}
-	return ""
+	return token.TokenID
 }
 
 func minDuration(a, b time.Duration) time.Duration {
@@ -1,6 +1,7 @@
 package polymarket
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
@@ -20,11 +21,25 @@ type BaseURLs struct {
 
 // Config holds shared SDK configuration.
 type Config struct {
-	BaseURLs      BaseURLs
-	HTTPClient    transport.Doer
+	BaseURLs   BaseURLs
+	HTTPClient transport.Doer
+	// HTTPTransport is used to build the default HTTP client when HTTPClient
+	// is nil. See transport.NewHTTPTransport for pool tuning.
+	HTTPTransport *http.Transport
 	UserAgent     string
+	// Headers are sent with every REST request, e.g. for an API gateway.
+	// See transport.Client.SetHeader.
+	Headers       http.Header
 	Timeout       time.Duration
 	UseServerTime bool
+	// RateLimiter, when set, is shared by the CLOB, Gamma, Data and Bridge
+	// transports so that all REST traffic draws from one budget. Nil disables
+	// client-side rate limiting.
+	RateLimiter *transport.RateLimiter
+	// ConditionalCacheEntries, when positive, enables ETag/Last-Modified
+	// revalidation of up to that many GET URLs on the CLOB and Gamma
+	// transports. See transport.Client.SetConditionalCache.
+	ConditionalCacheEntries int
 }
 
 // DefaultConfig returns default service endpoints.
@@ -3,11 +3,9 @@ package main
 import (
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
-	"strconv"
 	"strings"
 
 	polymarket "github.com/GoPolymarket/polymarket-go-sdk"
@@ -34,21 +32,12 @@ func main() {
 	gammaClient := gamma.NewClient(nil)
 
 	apiKey := loadAPIKeyFromEnv()
-	if apiKey == nil || apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
-		log.Println("No L2 API key provided, deriving via L1 signature...")
-		l1Client := client.CLOB.WithAuth(signer, nil)
-		resp, err := l1Client.DeriveAPIKey(ctx)
-		if err != nil {
-			log.Fatalf("DeriveAPIKey failed: %v", err)
-		}
-		apiKey = &auth.APIKey{
-			Key:        resp.APIKey,
-			Secret:     resp.Secret,
-			Passphrase: resp.Passphrase,
-		}
-	}
-
 	authClient := client.CLOB.WithAuth(signer, apiKey)
+	// EnsureAPIKey derives (or creates) L2 credentials when none are configured.
+	apiKey, err = authClient.EnsureAPIKey(ctx)
+	if err != nil {
+		log.Fatalf("EnsureAPIKey failed: %v", err)
+	}
 
 	builderConfig := loadBuilderConfigFromEnv()
 	if builderConfig == nil || !builderConfig.IsValid() {
@@ -182,27 +171,11 @@ func pickTokenIDFromGamma(ctx context.Context, gammaClient gamma.Client) (string
 }
 
 func pickTokenIDFromCLOB(ctx context.Context, clobClient clob.Client) (string, string, error) {
-	limit := 50
-	active := true
-	resp, err := clobClient.Markets(ctx, &clobtypes.MarketsRequest{
-		Limit:  limit,
-		Active: &active,
-	})
+	token, err := clob.FindTradableToken(ctx, clobClient, nil)
 	if err != nil {
 		return "", "", err
 	}
-
-	for _, market := range resp.Data {
-		if market.Closed {
-			continue
-		}
-		for _, token := range market.Tokens {
-			if token.TokenID != "" {
-				return token.TokenID, market.Question, nil
-			}
-		}
-	}
-	return "", "", fmt.Errorf("no token IDs found in clob markets")
+	return token.TokenID, token.Market.Question, nil
 }
 
 func firstGammaTokenID(market gamma.Market) string {
@@ -211,39 +184,9 @@ func firstGammaTokenID(market gamma.Market) string {
 			return token.TokenID
 		}
 	}
-	ids := parseClobTokenIDs(market.ClobTokenIds)
+	ids := market.TokenIDs()
 	if len(ids) > 0 {
 		return ids[0]
 	}
 	return ""
 }
-
-func parseClobTokenIDs(raw string) []string {
-	raw = strings.TrimSpace(raw)
-	if raw == "" {
-		return nil
-	}
-
-	var ids []string
-	if err := json.Unmarshal([]byte(raw), &ids); err == nil {
-		return ids
-	}
-
-	var anyIDs []interface{}
-	if err := json.Unmarshal([]byte(raw), &anyIDs); err != nil {
-		return nil
-	}
-
-	out := make([]string, 0, len(anyIDs))
-	for _, entry := range anyIDs {
-		switch v := entry.(type) {
-		case string:
-			if v != "" {
-				out = append(out, v)
-			}
-		case float64:
-			out = append(out, strconv.FormatInt(int64(v), 10))
-		}
-	}
-	return out
-}
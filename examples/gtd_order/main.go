@@ -33,14 +33,13 @@ func main() {
 	client := polymarket.NewClient(polymarket.WithUseServerTime(true))
 	authClient := client.CLOB.WithAuth(signer, apiKey)
 
-	expiration := time.Now().Add(30 * time.Minute).Unix()
 	signable, err := clob.NewOrderBuilder(authClient, signer).
 		TokenID("1234567890").
 		Side("SELL").
 		Price(0.42).
 		Size(10).
 		OrderType(clobtypes.OrderTypeGTD).
-		ExpirationUnix(expiration).
+		ExpirationIn(30 * time.Minute).
 		PostOnly(false).
 		BuildSignable()
 	if err != nil {
@@ -36,21 +36,13 @@ func main() {
 		Secret:     os.Getenv("POLYMARKET_API_SECRET"),
 		Passphrase: os.Getenv("POLYMARKET_API_PASSPHRASE"),
 	}
-	if apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
-		log.Println("No L2 API key provided, deriving via L1 signature...")
-		l1Client := client.CLOB.WithAuth(signer, nil)
-		resp, err := l1Client.DeriveAPIKey(ctx)
-		if err != nil {
-			log.Fatalf("DeriveAPIKey failed: %v", err)
-		}
-		apiKey = &auth.APIKey{
-			Key:        resp.APIKey,
-			Secret:     resp.Secret,
-			Passphrase: resp.Passphrase,
-		}
-	}
-
 	authClient := client.CLOB.WithAuth(signer, apiKey)
+	// EnsureAPIKey derives (or creates) L2 credentials when none are configured.
+	apiKey, err = authClient.EnsureAPIKey(ctx)
+	if err != nil {
+		log.Fatalf("EnsureAPIKey failed: %v", err)
+	}
+	authClient = authClient.WithAuth(signer, apiKey)
 	rfqClient := authClient.RFQ()
 
 	assetIn := os.Getenv("RFQ_ASSET_IN")
@@ -121,30 +113,30 @@ func main() {
 	log.Println("RFQ_ACCEPT_MAKER/RFQ_ACCEPT_SIGNER/RFQ_ACCEPT_TAKER/RFQ_ACCEPT_NONCE/RFQ_ACCEPT_EXPIRATION/")
 	log.Println("RFQ_ACCEPT_SIDE/RFQ_ACCEPT_FEE_RATE_BPS/RFQ_ACCEPT_SIGNATURE/RFQ_ACCEPT_SALT/RFQ_ACCEPT_OWNER")
 
-	if signed, err := buildSignedOrderFromEnv(authClient, signer, apiKey); err != nil {
-		log.Printf("RFQ signed order build failed: %v", err)
-	} else if signed != nil {
+	if builder, err := orderBuilderFromEnv(authClient, signer); err != nil {
+		log.Printf("RFQ order builder setup failed: %v", err)
+	} else if builder != nil {
 		if requestID := os.Getenv("RFQ_ACCEPT_REQUEST_ID"); requestID != "" {
 			if quoteID := os.Getenv("RFQ_ACCEPT_QUOTE_ID"); quoteID != "" {
-				req, err := rfq.BuildRFQAcceptRequestFromSignedOrder(requestID, quoteID, signed)
+				req, err := builder.BuildRFQAccept(ctx, requestID, quoteID)
 				if err != nil {
-					log.Printf("BuildRFQAcceptRequestFromSignedOrder failed: %v", err)
+					log.Printf("BuildRFQAccept failed: %v", err)
 				} else if _, err := rfqClient.RFQRequestAccept(ctx, req); err != nil {
 					log.Printf("RFQRequestAccept failed: %v", err)
 				} else {
-					log.Printf("RFQRequestAccept submitted (from signed order)")
+					log.Printf("RFQRequestAccept submitted (from order builder)")
 				}
 			}
 		}
 		if requestID := os.Getenv("RFQ_APPROVE_REQUEST_ID"); requestID != "" {
 			if quoteID := os.Getenv("RFQ_APPROVE_QUOTE_ID"); quoteID != "" {
-				req, err := rfq.BuildRFQApproveQuoteFromSignedOrder(requestID, quoteID, signed)
+				req, err := builder.BuildRFQApprove(ctx, requestID, quoteID)
 				if err != nil {
-					log.Printf("BuildRFQApproveQuoteFromSignedOrder failed: %v", err)
+					log.Printf("BuildRFQApprove failed: %v", err)
 				} else if _, err := rfqClient.RFQQuoteApprove(ctx, req); err != nil {
 					log.Printf("RFQQuoteApprove failed: %v", err)
 				} else {
-					log.Printf("RFQQuoteApprove submitted (from signed order)")
+					log.Printf("RFQQuoteApprove submitted (from order builder)")
 				}
 			}
 		}
@@ -167,7 +159,7 @@ func main() {
 	}
 }
 
-func buildSignedOrderFromEnv(client clob.Client, signer auth.Signer, apiKey *auth.APIKey) (*clobtypes.SignedOrder, error) {
+func orderBuilderFromEnv(client clob.Client, signer auth.Signer) (*clob.OrderBuilder, error) {
 	tokenID := os.Getenv("RFQ_SIGN_TOKEN_ID")
 	if tokenID == "" {
 		return nil, nil
@@ -201,19 +193,7 @@ func buildSignedOrderFromEnv(client clob.Client, signer auth.Signer, apiKey *aut
 		postOnly := strings.EqualFold(postOnlyRaw, "true") || postOnlyRaw == "1"
 		builder.PostOnly(postOnly)
 	}
-
-	signable, err := builder.BuildSignable()
-	if err != nil {
-		return nil, err
-	}
-
-	signed, err := clob.SignOrder(signer, apiKey, signable.Order)
-	if err != nil {
-		return nil, err
-	}
-	signed.OrderType = signable.OrderType
-	signed.PostOnly = signable.PostOnly
-	return signed, nil
+	return builder, nil
 }
 
 func parseFloat(raw string) (float64, error) {
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -61,8 +60,7 @@ func main() {
 		}
 		// Try ClobTokenIds
 		if m.ClobTokenIds != "" && !m.Closed {
-			var ids []string
-			if err := json.Unmarshal([]byte(m.ClobTokenIds), &ids); err == nil && len(ids) > 0 {
+			if ids := m.TokenIDs(); len(ids) > 0 {
 				market = m
 				tokenID = ids[0]
 				break
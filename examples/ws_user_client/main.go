@@ -33,18 +33,11 @@ func main() {
 		Secret:     os.Getenv("POLYMARKET_API_SECRET"),
 		Passphrase: os.Getenv("POLYMARKET_API_PASSPHRASE"),
 	}
-	if apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
-		log.Println("No L2 API key provided, deriving via L1 signature...")
-		l1Client := client.CLOB.WithAuth(signer, nil)
-		resp, err := l1Client.DeriveAPIKey(ctx)
-		if err != nil {
-			log.Fatalf("DeriveAPIKey failed: %v", err)
-		}
-		apiKey = &auth.APIKey{
-			Key:        resp.APIKey,
-			Secret:     resp.Secret,
-			Passphrase: resp.Passphrase,
-		}
+	authClient := client.CLOB.WithAuth(signer, apiKey)
+	// EnsureAPIKey derives (or creates) L2 credentials when none are configured.
+	apiKey, err = authClient.EnsureAPIKey(ctx)
+	if err != nil {
+		log.Fatalf("EnsureAPIKey failed: %v", err)
 	}
 
 	marketID, question, err := pickMarketID(ctx)
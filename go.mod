@@ -9,6 +9,8 @@ require (
 	github.com/ethereum/go-ethereum v1.16.8
 	github.com/gorilla/websocket v1.5.3
 	github.com/shopspring/decimal v1.4.0
+	go.uber.org/goleak v1.3.0
+	golang.org/x/sync v0.12.0
 )
 
 require (
@@ -35,8 +37,6 @@ require (
 	github.com/supranational/blst v0.3.16-0.20250831170142-f48500c1fdbe // indirect
 	github.com/tklauser/go-sysconf v0.3.12 // indirect
 	github.com/tklauser/numcpus v0.6.1 // indirect
-	go.uber.org/goleak v1.3.0 // indirect
 	golang.org/x/crypto v0.45.0 // indirect
-	golang.org/x/sync v0.12.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
 )
@@ -1,6 +1,8 @@
 package polymarket
 
 import (
+	"net/http"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/bridge"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob"
@@ -27,18 +29,65 @@ func WithHTTPClient(doer transport.Doer) Option {
 	}
 }
 
+// WithHTTPTransport sets the transport of the default HTTP client, e.g. to
+// tune connection pooling. It has no effect when WithHTTPClient is used.
+func WithHTTPTransport(t *http.Transport) Option {
+	return func(c *Client) {
+		c.Config.HTTPTransport = t
+	}
+}
+
+// WithConnectionPool builds the default HTTP client's transport from cfg.
+// transport.DefaultPoolConfig is a good starting point for trading workloads.
+func WithConnectionPool(cfg transport.PoolConfig) Option {
+	return WithHTTPTransport(transport.NewHTTPTransport(cfg))
+}
+
 func WithUserAgent(userAgent string) Option {
 	return func(c *Client) {
 		c.Config.UserAgent = userAgent
 	}
 }
 
+// WithHeader adds a header sent with every REST request, such as a gateway
+// routing key. The POLY_* authentication headers cannot be overridden.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.Config.Headers == nil {
+			c.Config.Headers = make(http.Header)
+		}
+		c.Config.Headers.Set(key, value)
+	}
+}
+
 func WithUseServerTime(use bool) Option {
 	return func(c *Client) {
 		c.Config.UseServerTime = use
 	}
 }
 
+// WithRateLimiter shares rl across all REST sub-clients created by NewClient.
+func WithRateLimiter(rl *transport.RateLimiter) Option {
+	return func(c *Client) {
+		c.Config.RateLimiter = rl
+	}
+}
+
+// WithRateLimit is shorthand for WithRateLimiter with a token bucket that
+// refills at rate requests per second and allows bursts of up to burst requests.
+func WithRateLimit(rate float64, burst int) Option {
+	return WithRateLimiter(transport.NewRateLimiterWithBurst(rate, burst))
+}
+
+// WithConditionalCache revalidates repeated CLOB and Gamma GETs, such as
+// market list polls, with ETag and Last-Modified, keeping up to maxEntries
+// response bodies per service. It is disabled by default.
+func WithConditionalCache(maxEntries int) Option {
+	return func(c *Client) {
+		c.Config.ConditionalCacheEntries = maxEntries
+	}
+}
+
 func WithCLOB(client clob.Client) Option {
 	return func(c *Client) {
 		c.CLOB = client
@@ -0,0 +1,98 @@
+package polymarket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/gamma"
+)
+
+// outcomeTokenCache maps a market slug to its outcome label (lower-cased) and token ID.
+type outcomeTokenCache struct {
+	mu     sync.RWMutex
+	tokens map[string]map[string]string
+}
+
+func (c *outcomeTokenCache) get(slug string) (map[string]string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tokens, ok := c.tokens[slug]
+	return tokens, ok
+}
+
+func (c *outcomeTokenCache) set(slug string, tokens map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tokens == nil {
+		c.tokens = make(map[string]map[string]string)
+	}
+	c.tokens[slug] = tokens
+}
+
+// TokenIDForOutcome resolves a gamma market slug and outcome label (e.g. "Yes")
+// to the CLOB token ID. Outcome matching is case-insensitive. Resolved markets
+// are cached for the lifetime of the client.
+func (c *Client) TokenIDForOutcome(ctx context.Context, slug, outcome string) (string, error) {
+	slug = strings.TrimSpace(slug)
+	if slug == "" {
+		return "", fmt.Errorf("slug is required")
+	}
+	key := strings.ToLower(strings.TrimSpace(outcome))
+	if key == "" {
+		return "", fmt.Errorf("outcome is required")
+	}
+
+	tokens, ok := c.outcomeTokens.get(slug)
+	if !ok {
+		if c.Gamma == nil {
+			return "", fmt.Errorf("gamma client is not configured")
+		}
+		market, err := c.Gamma.MarketBySlug(ctx, &gamma.MarketBySlugRequest{Slug: slug})
+		if err != nil {
+			return "", err
+		}
+		tokens = make(map[string]string)
+		for _, token := range market.ParsedTokens() {
+			label := strings.ToLower(strings.TrimSpace(token.Outcome))
+			if label != "" && token.TokenID != "" {
+				tokens[label] = token.TokenID
+			}
+		}
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("market %q has no outcome tokens", slug)
+		}
+		c.outcomeTokens.set(slug, tokens)
+	}
+
+	tokenID, ok := tokens[key]
+	if !ok {
+		return "", fmt.Errorf("market %q has no outcome %q", slug, outcome)
+	}
+	return tokenID, nil
+}
+
+// MidpointForOutcome returns the CLOB midpoint for an outcome of a gamma market,
+// e.g. the current YES price for a market slug.
+func (c *Client) MidpointForOutcome(ctx context.Context, slug, outcome string) (decimal.Decimal, error) {
+	tokenID, err := c.TokenIDForOutcome(ctx, slug, outcome)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if c.CLOB == nil {
+		return decimal.Zero, fmt.Errorf("clob client is not configured")
+	}
+	resp, err := c.CLOB.Midpoint(ctx, &clobtypes.MidpointRequest{TokenID: tokenID})
+	if err != nil {
+		return decimal.Zero, err
+	}
+	mid, err := decimal.NewFromString(resp.Midpoint)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid midpoint %q for token %s: %w", resp.Midpoint, tokenID, err)
+	}
+	return mid, nil
+}
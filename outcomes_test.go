@@ -0,0 +1,69 @@
+package polymarket
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtest"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/gamma"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+type countingDoer struct {
+	body  string
+	calls int
+}
+
+func (d *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewBufferString(d.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestMidpointForOutcome(t *testing.T) {
+	doer := &countingDoer{body: `{"slug":"will-it-rain","clobTokenIds":"[\"111\",\"222\"]","outcomes":"[\"Yes\",\"No\"]"}`}
+	mock := clobtest.NewMockClient()
+	mock.On("Midpoint", clobtypes.MidpointResponse{Midpoint: "0.62"}, nil)
+	client := NewClient(
+		WithGamma(gamma.NewClient(transport.NewClient(doer, "http://gamma"))),
+		WithCLOB(mock),
+	)
+	ctx := context.Background()
+
+	mid, err := client.MidpointForOutcome(ctx, "will-it-rain", "YES")
+	if err != nil {
+		t.Fatalf("MidpointForOutcome failed: %v", err)
+	}
+	if mid.String() != "0.62" {
+		t.Errorf("midpoint = %s, want 0.62", mid)
+	}
+	call, ok := mock.LastCall("Midpoint")
+	if !ok {
+		t.Fatal("expected Midpoint call")
+	}
+	if req := call.Args[0].(*clobtypes.MidpointRequest); req.TokenID != "111" {
+		t.Errorf("token id = %q, want 111", req.TokenID)
+	}
+
+	tokenID, err := client.TokenIDForOutcome(ctx, "will-it-rain", "no")
+	if err != nil || tokenID != "222" {
+		t.Fatalf("TokenIDForOutcome = %q, %v", tokenID, err)
+	}
+	if doer.calls != 1 {
+		t.Errorf("expected slug lookup to be cached, got %d gamma calls", doer.calls)
+	}
+
+	if _, err := client.MidpointForOutcome(ctx, "will-it-rain", "Maybe"); err == nil {
+		t.Error("expected error for unknown outcome")
+	}
+	if _, err := client.MidpointForOutcome(ctx, "", "Yes"); err == nil {
+		t.Error("expected error for empty slug")
+	}
+}
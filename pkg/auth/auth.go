@@ -18,6 +18,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/config"
 	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -73,19 +74,24 @@ const (
 	// SignatureEOA indicates a standard Externally Owned Account (EOA).
 	SignatureEOA SignatureType = 0
 	// SignatureProxy indicates a signature from a Polymarket Proxy wallet.
-	// Magic.link wallets use the same proxy factory, so SignatureProxy covers both.
 	SignatureProxy SignatureType = 1
+	// SignatureMagic indicates a signature from a Magic.link (email) wallet.
+	// Magic wallets are Polymarket Proxy wallets deployed by the proxy
+	// factory with the same salt, keccak256(eoa), so the maker derivation
+	// and the on-chain signature type are those of SignatureProxy.
+	SignatureMagic = SignatureProxy
 	// SignatureGnosisSafe indicates a signature from a Gnosis Safe multisig.
 	SignatureGnosisSafe SignatureType = 2
 )
 
 // Supported chain IDs for Polymarket operations.
 const (
-	PolygonChainID int64 = 137
-	AmoyChainID    int64 = 80002
+	PolygonChainID = config.PolygonChainID
+	AmoyChainID    = config.AmoyChainID
 )
 
-// Constants for Proxy Wallet Derivation.
+// Constants for Proxy Wallet Derivation. The factory addresses match the
+// Polygon deployment in pkg/config, which derivation uses for every chain.
 const (
 	// ProxyFactoryAddress is the factory contract for Polymarket Proxy wallets (Magic/email).
 	ProxyFactoryAddress = "0xaB45c5A4B0c941a2F231C04C3f49182e1A254052"
@@ -98,26 +104,11 @@ const (
 	SafeInitCodeHash = "0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf"
 )
 
-type walletConfig struct {
-	ProxyFactory *common.Address
-	SafeFactory  common.Address
-}
-
-var walletConfigs = map[int64]walletConfig{
-	PolygonChainID: {
-		ProxyFactory: ptrAddress(common.HexToAddress(ProxyFactoryAddress)),
-		SafeFactory:  common.HexToAddress(SafeFactoryAddress),
-	},
-	AmoyChainID: {
-		ProxyFactory: nil,
-		SafeFactory:  common.HexToAddress(SafeFactoryAddress),
-	},
-}
-
 var (
 	// Use unified error definitions from pkg/errors
 	ErrMissingSigner          = sdkerrors.ErrMissingSigner
 	ErrMissingCreds           = sdkerrors.ErrMissingCreds
+	ErrInvalidAPISecret       = sdkerrors.ErrInvalidAPISecret
 	ErrMissingBuilderConfig   = sdkerrors.ErrMissingBuilderConfig
 	ErrProxyWalletUnsupported = sdkerrors.ErrProxyWalletUnsupported
 	ErrSafeWalletUnsupported  = sdkerrors.ErrSafeWalletUnsupported
@@ -173,6 +164,16 @@ func (s *PrivateKeySigner) ChainID() *big.Int {
 	return s.chainID
 }
 
+// WithChainID returns a copy of the signer bound to a different chain ID.
+// The private key is shared; the original signer is left unchanged.
+func (s *PrivateKeySigner) WithChainID(chainID int64) Signer {
+	return &PrivateKeySigner{
+		key:     s.key,
+		address: s.address,
+		chainID: big.NewInt(chainID),
+	}
+}
+
 // BuildL1Headers creates the L1 authentication headers required for API key management.
 // It generates an EIP-712 signature over a standard authentication message.
 func BuildL1Headers(signer Signer, timestamp int64, nonce int64) (http.Header, error) {
@@ -240,7 +241,15 @@ func decodeSecret(secret string) ([]byte, error) {
 	if err == nil {
 		return decoded, nil
 	}
-	return nil, fmt.Errorf("invalid base64 secret: %w", err)
+	return nil, fmt.Errorf("%w: %v", ErrInvalidAPISecret, err)
+}
+
+// ValidateSecret reports whether secret can be used to sign L2 requests. It
+// accepts the same URL-safe and standard base64 variants, padded or not, as
+// SignHMAC, and returns an error wrapping ErrInvalidAPISecret otherwise.
+func ValidateSecret(secret string) error {
+	_, err := decodeSecret(secret)
+	return err
 }
 
 // BuildL2Headers returns the headers required for an HMAC-authenticated L2 request.
@@ -251,6 +260,9 @@ func BuildL2Headers(signer Signer, apiKey *APIKey, method, path string, body *st
 	if apiKey == nil {
 		return nil, ErrMissingCreds
 	}
+	if err := ValidateSecret(apiKey.Secret); err != nil {
+		return nil, err
+	}
 	if timestamp == 0 {
 		timestamp = time.Now().Unix()
 	}
@@ -441,7 +453,7 @@ func DeriveProxyWallet(eoa common.Address) (common.Address, error) {
 
 // DeriveProxyWalletForChain calculates the deterministic Proxy Wallet address for an EOA on a specific chain.
 func DeriveProxyWalletForChain(eoa common.Address, chainID int64) (common.Address, error) {
-	cfg, ok := walletConfigs[chainID]
+	cfg, ok := config.Lookup(chainID)
 	if !ok || cfg.ProxyFactory == nil {
 		return common.Address{}, ErrProxyWalletUnsupported
 	}
@@ -468,7 +480,7 @@ func DeriveSafeWallet(eoa common.Address) (common.Address, error) {
 
 // DeriveSafeWalletForChain calculates the deterministic Gnosis Safe address for an EOA on a specific chain.
 func DeriveSafeWalletForChain(eoa common.Address, chainID int64) (common.Address, error) {
-	cfg, ok := walletConfigs[chainID]
+	cfg, ok := config.Lookup(chainID)
 	if !ok {
 		return common.Address{}, ErrSafeWalletUnsupported
 	}
@@ -487,10 +499,6 @@ func DeriveSafeWalletForChain(eoa common.Address, chainID int64) (common.Address
 	return address, nil
 }
 
-func ptrAddress(addr common.Address) *common.Address {
-	return &addr
-}
-
 // SignTypedData signs EIP-712 typed data. It ensures the V value is correctly adjusted
 // for compatibility with Ethereum's expected 27/28 values.
 func (s *PrivateKeySigner) SignTypedData(domain *apitypes.TypedDataDomain, types apitypes.Types, message apitypes.TypedDataMessage, primaryType string) ([]byte, error) {
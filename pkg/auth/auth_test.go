@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -52,6 +53,46 @@ func TestNewPrivateKeySigner(t *testing.T) {
 	}
 }
 
+func TestPrivateKeySignerWithChainID(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	signer, err := NewPrivateKeySigner(fmt.Sprintf("%x", crypto.FromECDSA(key)), PolygonChainID)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+
+	amoy := signer.WithChainID(AmoyChainID)
+	if amoy.ChainID().Int64() != AmoyChainID {
+		t.Errorf("expected chainID %d, got %d", AmoyChainID, amoy.ChainID().Int64())
+	}
+	if amoy.Address() != signer.Address() {
+		t.Errorf("expected address %s, got %s", signer.Address().Hex(), amoy.Address().Hex())
+	}
+	if signer.ChainID().Int64() != PolygonChainID {
+		t.Errorf("original chainID changed to %d", signer.ChainID().Int64())
+	}
+
+	amoy.ChainID().SetInt64(1)
+	if signer.ChainID().Int64() != PolygonChainID {
+		t.Errorf("clone shares chainID with original")
+	}
+
+	// Both signers must sign independently and produce domain-specific signatures.
+	polyHeaders, err := BuildL1Headers(signer, 1700000000, 0)
+	if err != nil {
+		t.Fatalf("BuildL1Headers polygon failed: %v", err)
+	}
+	amoyHeaders, err := BuildL1Headers(signer.WithChainID(AmoyChainID), 1700000000, 0)
+	if err != nil {
+		t.Fatalf("BuildL1Headers amoy failed: %v", err)
+	}
+	if polyHeaders.Get(HeaderPolySignature) == amoyHeaders.Get(HeaderPolySignature) {
+		t.Error("expected signatures to differ across chains")
+	}
+}
+
 func TestSignHMAC(t *testing.T) {
 	secret := "dGVzdF9zZWNyZXRfa2V5" // base64("test_secret_key")
 	message := "test_message"
@@ -82,8 +123,32 @@ func TestSignHMAC(t *testing.T) {
 
 	// Test invalid secret
 	_, err = SignHMAC("invalid-base64-!@#", message)
-	if err == nil {
-		t.Error("expected error for invalid secret")
+	if !errors.Is(err, ErrInvalidAPISecret) {
+		t.Errorf("expected ErrInvalidAPISecret, got %v", err)
+	}
+}
+
+func TestValidateSecret(t *testing.T) {
+	raw := []byte("secret\xfb\xff")
+	for _, secret := range []string{
+		base64.StdEncoding.EncodeToString(raw),
+		base64.RawStdEncoding.EncodeToString(raw),
+		base64.URLEncoding.EncodeToString(raw),
+		base64.RawURLEncoding.EncodeToString(raw),
+	} {
+		if err := ValidateSecret(secret); err != nil {
+			t.Errorf("ValidateSecret(%q) failed: %v", secret, err)
+		}
+	}
+	if err := ValidateSecret("not base64!"); !errors.Is(err, ErrInvalidAPISecret) {
+		t.Errorf("expected ErrInvalidAPISecret, got %v", err)
+	}
+
+	key, _ := crypto.GenerateKey()
+	signer, _ := NewPrivateKeySigner(fmt.Sprintf("%x", crypto.FromECDSA(key)), 137)
+	_, err := BuildL2Headers(signer, &APIKey{Key: "k", Secret: "%%%", Passphrase: "p"}, "GET", "/", nil, 0)
+	if !errors.Is(err, ErrInvalidAPISecret) {
+		t.Errorf("expected ErrInvalidAPISecret from BuildL2Headers, got %v", err)
 	}
 }
 
@@ -0,0 +1,121 @@
+// Package authtest provides a deterministic auth.Signer for unit tests.
+package authtest
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+)
+
+// SignRequest records a single SignTypedData call.
+type SignRequest struct {
+	Domain      apitypes.TypedDataDomain
+	Types       apitypes.Types
+	Message     apitypes.TypedDataMessage
+	PrimaryType string
+	Hash        []byte
+}
+
+// MockSigner implements auth.Signer without a private key. Signatures are
+// derived from the EIP-712 hash and the configured address, so the same input
+// always yields the same 65-byte signature. They do not recover to Address.
+type MockSigner struct {
+	address common.Address
+	chainID *big.Int
+
+	mu       sync.Mutex
+	requests []SignRequest
+	err      error
+}
+
+var _ auth.Signer = (*MockSigner)(nil)
+
+// NewMockSigner creates a MockSigner for addr on Polygon mainnet.
+func NewMockSigner(addr common.Address) *MockSigner {
+	return &MockSigner{address: addr, chainID: big.NewInt(auth.PolygonChainID)}
+}
+
+// WithChainID returns a copy of the signer bound to a different chain ID.
+// Recorded requests and programmed errors are not shared.
+func (s *MockSigner) WithChainID(chainID int64) *MockSigner {
+	return &MockSigner{address: s.address, chainID: big.NewInt(chainID)}
+}
+
+// FailWith makes subsequent SignTypedData calls return err. Pass nil to clear it.
+func (s *MockSigner) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Address returns the configured address.
+func (s *MockSigner) Address() common.Address {
+	return s.address
+}
+
+// ChainID returns the configured chain ID.
+func (s *MockSigner) ChainID() *big.Int {
+	return new(big.Int).Set(s.chainID)
+}
+
+// SignTypedData hashes the typed data and returns a deterministic signature.
+func (s *MockSigner) SignTypedData(domain *apitypes.TypedDataDomain, types apitypes.Types, message apitypes.TypedDataMessage, primaryType string) ([]byte, error) {
+	if domain == nil {
+		return nil, fmt.Errorf("domain is required")
+	}
+	s.mu.Lock()
+	err := s.err
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      *domain,
+		Message:     message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, SignRequest{
+		Domain:      *domain,
+		Types:       types,
+		Message:     message,
+		PrimaryType: primaryType,
+		Hash:        hash,
+	})
+	s.mu.Unlock()
+
+	signature := make([]byte, 0, 65)
+	signature = append(signature, hash...)
+	signature = append(signature, crypto.Keccak256(s.address.Bytes(), hash)...)
+	signature = append(signature, 27)
+	return signature, nil
+}
+
+// Requests returns a copy of all recorded SignTypedData calls.
+func (s *MockSigner) Requests() []SignRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SignRequest(nil), s.requests...)
+}
+
+// LastRequest returns the most recent SignTypedData call.
+func (s *MockSigner) LastRequest() (SignRequest, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return SignRequest{}, false
+	}
+	return s.requests[len(s.requests)-1], true
+}
@@ -0,0 +1,71 @@
+package authtest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+)
+
+func TestMockSignerDeterministic(t *testing.T) {
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	signer := NewMockSigner(addr)
+	if signer.Address() != addr {
+		t.Fatalf("unexpected address %s", signer.Address().Hex())
+	}
+	if signer.ChainID().Int64() != auth.PolygonChainID {
+		t.Fatalf("unexpected chain id %d", signer.ChainID().Int64())
+	}
+
+	first, err := auth.BuildL1Headers(signer, 1700000000, 0)
+	if err != nil {
+		t.Fatalf("BuildL1Headers failed: %v", err)
+	}
+	second, err := auth.BuildL1Headers(signer, 1700000000, 0)
+	if err != nil {
+		t.Fatalf("BuildL1Headers failed: %v", err)
+	}
+	if first.Get(auth.HeaderPolySignature) != second.Get(auth.HeaderPolySignature) {
+		t.Fatalf("expected identical signatures for identical input")
+	}
+
+	amoy, err := auth.BuildL1Headers(signer.WithChainID(auth.AmoyChainID), 1700000000, 0)
+	if err != nil {
+		t.Fatalf("BuildL1Headers failed: %v", err)
+	}
+	if amoy.Get(auth.HeaderPolySignature) == first.Get(auth.HeaderPolySignature) {
+		t.Fatalf("expected chain id to change the signature")
+	}
+
+	reqs := signer.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 recorded requests, got %d", len(reqs))
+	}
+	last, ok := signer.LastRequest()
+	if !ok || last.PrimaryType != "ClobAuth" || len(last.Hash) != 32 {
+		t.Fatalf("unexpected last request: %+v", last)
+	}
+
+	other := NewMockSigner(common.HexToAddress("0x00000000000000000000000000000000000000bb"))
+	sigA, _ := signer.SignTypedData(&last.Domain, last.Types, last.Message, last.PrimaryType)
+	sigB, _ := other.SignTypedData(&last.Domain, last.Types, last.Message, last.PrimaryType)
+	if len(sigA) != 65 || bytes.Equal(sigA, sigB) {
+		t.Fatalf("expected 65-byte signatures that differ per address")
+	}
+}
+
+func TestMockSignerFailWith(t *testing.T) {
+	signer := NewMockSigner(common.Address{})
+	boom := errors.New("boom")
+	signer.FailWith(boom)
+	if _, err := auth.BuildL1Headers(signer, 1, 0); !errors.Is(err, boom) {
+		t.Fatalf("expected programmed error, got %v", err)
+	}
+	signer.FailWith(nil)
+	if _, err := auth.BuildL1Headers(signer, 1, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,298 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/ws"
+)
+
+const (
+	defaultCandleLookback   = 24 * time.Hour
+	defaultCandleMaxHistory = 500
+)
+
+// CandleStreamOptions configures NewCandleStream.
+type CandleStreamOptions struct {
+	// Lookback is how much price history seeds the series (default 24h).
+	Lookback time.Duration
+	// MaxHistory caps the number of closed candles retained (default 500).
+	MaxHistory int
+	// WS overrides the WebSocket client. Defaults to client.WS().
+	WS ws.Client
+}
+
+// CandleStream maintains a rolling OHLCV series for one token. It is seeded
+// from PricesHistory and then updated from last-trade-price events on the
+// market channel. Closed candles are delivered on C; the channel is buffered
+// and a slow reader misses candles rather than stalling the stream (History
+// still has them). C is closed when the stream stops.
+type CandleStream struct {
+	C <-chan clobtypes.Candle
+
+	tokenID    string
+	interval   time.Duration
+	maxHistory int
+	out        chan clobtypes.Candle
+
+	mu         sync.RWMutex
+	history    []clobtypes.Candle
+	current    clobtypes.Candle
+	hasCurrent bool
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCandleStream starts a candle series of the given interval (at least one
+// second) for tokenID.
+func NewCandleStream(ctx context.Context, client Client, tokenID string, interval time.Duration, opts *CandleStreamOptions) (*CandleStream, error) {
+	if client == nil {
+		return nil, errors.New("client is required")
+	}
+	tokenID = strings.TrimSpace(tokenID)
+	if tokenID == "" {
+		return nil, ErrTokenIDRequired
+	}
+	if interval < time.Second {
+		return nil, errors.New("interval must be at least one second")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var o CandleStreamOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Lookback <= 0 {
+		o.Lookback = defaultCandleLookback
+	}
+	if o.MaxHistory <= 0 {
+		o.MaxHistory = defaultCandleMaxHistory
+	}
+	wsClient := o.WS
+	if wsClient == nil {
+		wsClient = client.WS()
+	}
+	if wsClient == nil {
+		return nil, errors.New("websocket client is required")
+	}
+
+	now := time.Now()
+	fidelity := int(interval / time.Minute)
+	if fidelity < 1 {
+		fidelity = 1
+	}
+	points, err := client.PricesHistory(ctx, &clobtypes.PricesHistoryRequest{
+		Market:   tokenID,
+		StartTs:  now.Add(-o.Lookback).Unix(),
+		EndTs:    now.Unix(),
+		Fidelity: fidelity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan clobtypes.Candle, 64)
+	s := &CandleStream{
+		C:          out,
+		tokenID:    tokenID,
+		interval:   interval,
+		maxHistory: o.MaxHistory,
+		out:        out,
+		done:       make(chan struct{}),
+	}
+	s.seed(points.Resample(interval), now)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	trades, err := wsClient.SubscribeLastTradePricesStream(runCtx, []string{tokenID})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s.cancel = cancel
+	go s.run(runCtx, trades)
+	return s, nil
+}
+
+// History returns the closed candles, oldest first.
+func (s *CandleStream) History() []clobtypes.Candle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]clobtypes.Candle, len(s.history))
+	copy(out, s.history)
+	return out
+}
+
+// Current returns the candle that is still forming.
+func (s *CandleStream) Current() (clobtypes.Candle, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current, s.hasCurrent
+}
+
+// Close stops the stream and closes C.
+func (s *CandleStream) Close() error {
+	s.closeOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+			<-s.done
+		}
+	})
+	return nil
+}
+
+// seed loads resampled history; a candle for the bucket containing now is
+// still forming and becomes the current candle.
+func (s *CandleStream) seed(candles []clobtypes.Candle, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n := len(candles); n > 0 && candles[n-1].Start.Equal(s.bucketStart(now)) {
+		s.current = candles[n-1]
+		s.hasCurrent = true
+		candles = candles[:n-1]
+	}
+	if len(candles) > s.maxHistory {
+		candles = candles[len(candles)-s.maxHistory:]
+	}
+	s.history = append(s.history, candles...)
+}
+
+func (s *CandleStream) run(ctx context.Context, trades *ws.Stream[ws.LastTradePriceEvent]) {
+	defer close(s.done)
+	defer close(s.out)
+	defer func() { _ = trades.Close() }()
+
+	tick := time.Second
+	if s.interval < tick {
+		tick = s.interval
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-trades.C:
+			if !ok {
+				return
+			}
+			s.applyTrade(event)
+		case now := <-ticker.C:
+			s.flush(now)
+		}
+	}
+}
+
+// applyTrade folds a last-trade-price event into the series, closing the
+// current candle when the trade falls in a later bucket. Trades older than the
+// current bucket are ignored.
+func (s *CandleStream) applyTrade(event ws.LastTradePriceEvent) {
+	if event.AssetID != "" && event.AssetID != s.tokenID {
+		return
+	}
+	price, err := strconv.ParseFloat(strings.TrimSpace(event.Price), 64)
+	if err != nil {
+		return
+	}
+	size, _ := strconv.ParseFloat(strings.TrimSpace(event.Size), 64)
+	at := parseEventTime(event.Timestamp)
+	if at.IsZero() {
+		at = time.Now()
+	}
+	start := s.bucketStart(at)
+
+	s.mu.Lock()
+	var closed *clobtypes.Candle
+	if s.hasCurrent {
+		switch {
+		case start.Before(s.current.Start):
+			s.mu.Unlock()
+			return
+		case start.After(s.current.Start):
+			c := s.closeCurrentLocked()
+			closed = &c
+		}
+	}
+	if !s.hasCurrent {
+		s.current = clobtypes.Candle{Start: start, Open: price, High: price, Low: price}
+		s.hasCurrent = true
+	}
+	c := &s.current
+	if price > c.High {
+		c.High = price
+	}
+	if price < c.Low {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume += size
+	c.Points++
+	s.mu.Unlock()
+
+	if closed != nil {
+		s.emit(*closed)
+	}
+}
+
+// flush closes the current candle once its interval has elapsed, so candles
+// close on time even when no further trades arrive.
+func (s *CandleStream) flush(now time.Time) {
+	s.mu.Lock()
+	if !s.hasCurrent || now.Before(s.current.Start.Add(s.interval)) {
+		s.mu.Unlock()
+		return
+	}
+	closed := s.closeCurrentLocked()
+	s.mu.Unlock()
+	s.emit(closed)
+}
+
+func (s *CandleStream) closeCurrentLocked() clobtypes.Candle {
+	closed := s.current
+	s.history = append(s.history, closed)
+	if len(s.history) > s.maxHistory {
+		s.history = s.history[len(s.history)-s.maxHistory:]
+	}
+	s.current = clobtypes.Candle{}
+	s.hasCurrent = false
+	return closed
+}
+
+func (s *CandleStream) emit(c clobtypes.Candle) {
+	select {
+	case s.out <- c:
+	default:
+	}
+}
+
+// bucketStart aligns t to the interval since the unix epoch, matching
+// PricesHistoryResponse.Resample.
+func (s *CandleStream) bucketStart(t time.Time) time.Time {
+	step := int64(s.interval / time.Second)
+	ts := t.Unix()
+	rem := ts % step
+	if rem < 0 {
+		rem += step
+	}
+	return time.Unix(ts-rem, 0)
+}
+
+// parseEventTime parses a WebSocket timestamp in unix seconds or milliseconds.
+func parseEventTime(raw string) time.Time {
+	ts, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil || ts <= 0 {
+		return time.Time{}
+	}
+	if ts > 1e12 {
+		return time.UnixMilli(ts)
+	}
+	return time.Unix(ts, 0)
+}
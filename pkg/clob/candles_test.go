@@ -0,0 +1,90 @@
+package clob
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/ws"
+	"github.com/shopspring/decimal"
+)
+
+type fakeTradeWS struct {
+	ws.Client
+	trades chan ws.LastTradePriceEvent
+	assets []string
+}
+
+func (f *fakeTradeWS) SubscribeLastTradePricesStream(ctx context.Context, assetIDs []string) (*ws.Stream[ws.LastTradePriceEvent], error) {
+	f.assets = assetIDs
+	return &ws.Stream[ws.LastTradePriceEvent]{C: f.trades}, nil
+}
+
+func TestCandleStream(t *testing.T) {
+	now := time.Now()
+	hour := now.Unix() - now.Unix()%3600
+	stub := newStubClient()
+	stub.history = clobtypes.PricesHistoryResponse{
+		{Timestamp: hour - 5*3600 + 10, Price: decimal.RequireFromString("0.40")},
+		{Timestamp: hour - 5*3600 + 20, Price: decimal.RequireFromString("0.42")},
+		{Timestamp: hour - 4*3600 + 10, Price: decimal.RequireFromString("0.45")},
+	}
+	fake := &fakeTradeWS{trades: make(chan ws.LastTradePriceEvent, 10)}
+
+	stream, err := NewCandleStream(context.Background(), stub, "tok1", time.Hour, &CandleStreamOptions{WS: fake})
+	if err != nil {
+		t.Fatalf("NewCandleStream failed: %v", err)
+	}
+	defer stream.Close()
+
+	if len(fake.assets) != 1 || fake.assets[0] != "tok1" {
+		t.Fatalf("subscribed to %v", fake.assets)
+	}
+	if len(stub.historyReqs) != 1 || stub.historyReqs[0].Market != "tok1" || stub.historyReqs[0].Fidelity != 60 {
+		t.Fatalf("unexpected history request %+v", stub.historyReqs)
+	}
+	history := stream.History()
+	if len(history) != 2 || history[0].Open != 0.40 || history[0].Close != 0.42 || history[1].Open != 0.45 {
+		t.Fatalf("unexpected seeded history %+v", history)
+	}
+
+	// Trades two and three hours ahead keep the test clear of the flush timer.
+	start := hour + 2*3600
+	ms := func(sec int64) string { return strconv.FormatInt(sec*1000, 10) }
+	fake.trades <- ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.50", Size: "10", Timestamp: ms(start + 5)}
+	fake.trades <- ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.55", Size: "4", Timestamp: ms(start + 60)}
+	fake.trades <- ws.LastTradePriceEvent{AssetID: "other", Price: "0.99", Size: "1", Timestamp: ms(start + 61)}
+	fake.trades <- ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.48", Size: "6", Timestamp: ms(start + 120)}
+	fake.trades <- ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.52", Size: "1", Timestamp: ms(start + 3600)}
+
+	select {
+	case c := <-stream.C:
+		if c.Start.Unix() != start || c.Open != 0.50 || c.High != 0.55 || c.Low != 0.48 || c.Close != 0.48 || c.Volume != 20 || c.Points != 3 {
+			t.Fatalf("unexpected closed candle %+v", c)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for closed candle")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		cur, ok := stream.Current()
+		if ok && cur.Start.Unix() == start+3600 && cur.Open == 0.52 && cur.Volume == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected current candle %+v (%v)", cur, ok)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := len(stream.History()); got != 3 {
+		t.Errorf("history length = %d, want 3", got)
+	}
+
+	_ = stream.Close()
+	if _, ok := <-stream.C; ok {
+		t.Error("expected C to be closed after Close")
+	}
+}
@@ -1,5 +1,9 @@
 // Package clob provides the client for interacting with the Polymarket Central Limit Order Book.
 // It handles order placement, market data retrieval, account management, and real-time streaming.
+//
+// Request and response types are defined once, in package clobtypes; this
+// package does not declare its own copies, so the JSON shape of a type does
+// not depend on which package it is imported from.
 package clob
 
 import (
@@ -21,11 +25,20 @@ type Client interface {
 
 	// WithAuth returns a new client instance configured with the provided signer and API credentials.
 	WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client
+	// UpdateCredentials rotates the API credentials in place, without
+	// rebuilding the client or its connections, and re-authenticates the
+	// WebSocket user channel.
+	UpdateCredentials(apiKey *auth.APIKey) error
+	// AuthError returns why the credentials set by WithAuth or
+	// UpdateCredentials are unusable, such as an API secret that is not valid
+	// base64, or nil. Authenticated requests fail with the same error.
+	AuthError() error
 	// WithBuilderConfig returns a new client instance configured for builder attribution.
 	WithBuilderConfig(config *auth.BuilderConfig) Client
 	// PromoteToBuilder switches the client into builder attribution mode.
 	PromoteToBuilder(config *auth.BuilderConfig) Client
 	// WithSignatureType sets the default signature type used for order signing and balance/rewards requests.
+	// A SignatureType set on an individual request or order takes precedence over this default.
 	WithSignatureType(sigType auth.SignatureType) Client
 	// WithAuthNonce sets the default nonce used when creating/deriving API keys.
 	WithAuthNonce(nonce int64) Client
@@ -33,14 +46,33 @@ type Client interface {
 	WithFunder(funder types.Address) Client
 	// WithSaltGenerator sets the default salt generator used for new orders.
 	WithSaltGenerator(gen SaltGenerator) Client
+	// WithNonceManager sets the nonce manager that OrderBuilder.AutoNonce
+	// draws order nonces from.
+	WithNonceManager(m *NonceManager) Client
 	// WithUseServerTime configures the client to synchronize with server time for request signing.
 	WithUseServerTime(use bool) Client
 	// WithGeoblockHost overrides the host used for checking geoblocking status.
 	WithGeoblockHost(host string) Client
+	// WithGeoblockCheck makes order placement check Geoblock once before the
+	// first order and fail with an error wrapping errors.ErrGeoblocked instead
+	// of a bare 403 from the exchange. The status is cached for the lifetime
+	// of the returned client; see InvalidateGeoblock.
+	//
+	// Only PostOrder, PostOrders and CancelAndReplace are checked, along with
+	// the helpers built on them (CreateOrder, CreateOrderWithOptions,
+	// CreateOrderFromSignable and the OrderBuilder Submit methods). Every
+	// other call is exempt. Cancels stay open so that clients in close-only
+	// regions can still pull orders and exit positions. Reads, API key
+	// management and heartbeats open no exposure. The RFQ sub-client is not
+	// checked either; callers must check Geoblock themselves before trading
+	// through it.
+	WithGeoblockCheck(enabled bool) Client
 	// WithWS associates a WebSocket client with this REST client.
 	WithWS(ws ws.Client) Client
 	// WithHeartbeatInterval enables automatic heartbeat scheduling.
 	WithHeartbeatInterval(interval time.Duration) Client
+	// WithLimitPolicy sets whether over-limit list requests are sent as-is (default), clamped, or rejected.
+	WithLimitPolicy(policy LimitPolicy) Client
 	// StopHeartbeats stops any active heartbeat loop.
 	StopHeartbeats()
 
@@ -71,11 +103,11 @@ type Client interface {
 	// Market retrieves detailed information for a single market by its ID.
 	Market(ctx context.Context, id string) (clobtypes.MarketResponse, error)
 	// SimplifiedMarkets retrieves a simplified view of available markets.
-	SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error)
 	// SamplingMarkets retrieves a sampled list of markets.
 	SamplingMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
 	// SamplingSimplifiedMarkets retrieves a sampled and simplified list of markets.
-	SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error)
 
 	// -- Order Book & Pricing --
 
@@ -91,8 +123,8 @@ type Client interface {
 	Price(ctx context.Context, req *clobtypes.PriceRequest) (clobtypes.PriceResponse, error)
 	// Prices retrieves multiple prices in a batch request.
 	Prices(ctx context.Context, req *clobtypes.PricesRequest) (clobtypes.PricesResponse, error)
-	// AllPrices retrieves current prices for all active tokens.
-	AllPrices(ctx context.Context) (clobtypes.PricesResponse, error)
+	// AllPrices retrieves current prices for all active tokens, keyed by token ID and side.
+	AllPrices(ctx context.Context) (clobtypes.AllPricesResponse, error)
 	// Spread retrieves the current bid-ask spread for a token.
 	Spread(ctx context.Context, req *clobtypes.SpreadRequest) (clobtypes.SpreadResponse, error)
 	// Spreads retrieves multiple spreads in a batch request.
@@ -114,6 +146,12 @@ type Client interface {
 
 	// InvalidateCaches clears all internally cached market metadata (tick sizes, fee rates).
 	InvalidateCaches()
+	// InvalidateTickSize drops the cached tick size of a token so the next
+	// TickSize call fetches it again.
+	InvalidateTickSize(tokenID string)
+	// InvalidateGeoblock drops the status cached by WithGeoblockCheck so the
+	// next order placement checks Geoblock again, e.g. after a network change.
+	InvalidateGeoblock()
 	// SetTickSize manually populates the tick size cache for a token.
 	SetTickSize(tokenID string, tickSize float64)
 	// SetNegRisk manually populates the negative risk cache for a token.
@@ -129,14 +167,27 @@ type Client interface {
 	PostOrders(ctx context.Context, req *clobtypes.SignedOrders) (clobtypes.PostOrdersResponse, error)
 	// CancelOrder requests the cancellation of a single open order by its ID.
 	CancelOrder(ctx context.Context, req *clobtypes.CancelOrderRequest) (clobtypes.CancelResponse, error)
+	// CancelAndReplace cancels cancelID and, once the cancel is confirmed,
+	// signs and posts replacement. The two steps are not atomic; see
+	// CancelReplaceError for how partial failures are reported.
+	CancelAndReplace(ctx context.Context, cancelID string, replacement *clobtypes.SignableOrder) (clobtypes.OrderResponse, error)
 	// CancelOrders requests the cancellation of multiple orders by their IDs.
 	CancelOrders(ctx context.Context, req *clobtypes.CancelOrdersRequest) (clobtypes.CancelResponse, error)
 	// CancelAll requests the cancellation of all open orders for the authenticated account.
 	CancelAll(ctx context.Context) (clobtypes.CancelAllResponse, error)
 	// CancelMarketOrders requests the cancellation of all orders in a specific market.
 	CancelMarketOrders(ctx context.Context, req *clobtypes.CancelMarketOrdersRequest) (clobtypes.CancelMarketOrdersResponse, error)
+	// CancelOrdersForAsset cancels all open orders for a single token.
+	CancelOrdersForAsset(ctx context.Context, assetID string) (clobtypes.CancelMarketOrdersResponse, error)
 	// Order retrieves the current status and details of a specific order.
 	Order(ctx context.Context, id string) (clobtypes.OrderResponse, error)
+	// WaitForOrderStatus polls Order every interval (DefaultOrderPollInterval
+	// when zero) until the order's status is in terminal, compared without
+	// case (DefaultTerminalOrderStatuses when empty), and returns that order.
+	// Rate limits, server and network errors are retried with backoff; other
+	// errors, including an unknown order, stop polling. When ctx is done the
+	// last order seen is returned with ctx's error.
+	WaitForOrderStatus(ctx context.Context, id string, terminal []string, interval time.Duration) (clobtypes.OrderResponse, error)
 	// Orders retrieves a paginated list of open orders for the authenticated account.
 	Orders(ctx context.Context, req *clobtypes.OrdersRequest) (clobtypes.OrdersResponse, error)
 	// Trades retrieves a paginated list of executed trades.
@@ -144,6 +195,8 @@ type Client interface {
 
 	// OrdersAll automatically iterates through all pages to retrieve all open orders.
 	OrdersAll(ctx context.Context, req *clobtypes.OrdersRequest) ([]clobtypes.OrderResponse, error)
+	// OpenOrdersByMarket retrieves all open orders for a market, grouped by asset and side and sorted best price first.
+	OpenOrdersByMarket(ctx context.Context, market string) ([]clobtypes.OrderResponse, error)
 	// TradesAll automatically iterates through all pages to retrieve all recent trades.
 	TradesAll(ctx context.Context, req *clobtypes.TradesRequest) ([]clobtypes.Trade, error)
 	// BuilderTradesAll automatically iterates through all pages to retrieve all trades attributed to a builder.
@@ -200,6 +253,8 @@ type Client interface {
 	CreateOrDeriveAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error)
 	// CreateOrDeriveAPIKeyWithNonce attempts to create a new API key with an explicit nonce, falling back to derive on failure.
 	CreateOrDeriveAPIKeyWithNonce(ctx context.Context, nonce int64) (clobtypes.APIKeyResponse, error)
+	// EnsureAPIKey validates the configured L2 credentials, deriving or creating them when missing or rejected.
+	EnsureAPIKey(ctx context.Context) (*auth.APIKey, error)
 	// ClosedOnlyStatus checks if the account is restricted to "close-only" trading.
 	ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error)
 
@@ -228,6 +283,18 @@ type Client interface {
 	// MarketTradesEvents retrieves a stream of recent trade events for a market.
 	MarketTradesEvents(ctx context.Context, id string) (clobtypes.MarketTradesEventsResponse, error)
 
+	// WatchRFQRequest polls the quotes of an RFQ request every interval
+	// (DefaultRFQWatchInterval when zero) and emits each new quote once,
+	// deduplicated by quote ID. The channel is closed when the request's
+	// Expiry passes or ctx is done.
+	WatchRFQRequest(ctx context.Context, requestID string, interval time.Duration) (<-chan rfq.RFQQuoteItem, error)
+	// SubscribeRFQQuotes delivers quotes for requestIDs (or every quote visible
+	// to the account when empty) as they arrive. The CLOB has no RFQ
+	// WebSocket channel, so this polls every RFQQuotePollInterval using the
+	// client's API-key auth; fetch errors are delivered and polling continues.
+	// The channel is closed when ctx is done.
+	SubscribeRFQQuotes(ctx context.Context, requestIDs []string) (<-chan StreamResult[rfq.RFQQuoteItem], error)
+
 	// -- Sub-Client Accessors --
 
 	// RFQ returns the Request For Quote sub-client.
@@ -12,12 +12,28 @@ import (
 )
 
 // FromTypeErr maps a generic types.Error (from transport layer) to a specific
-// structured error type from pkg/errors.
+// structured error type from pkg/errors. Order rejections with a known code
+// are returned as *OrderError; see CodeOf and the Is* predicates.
 func FromTypeErr(err *types.Error) error {
 	if err == nil {
 		return nil
 	}
 
+	mapped := mapTypeErr(err)
+	code, ok := ParseOrderErrorCode(err.Code)
+	if !ok {
+		code, ok = ParseOrderErrorCode(err.Message)
+	}
+	if !ok {
+		return mapped
+	}
+	if sentinel := orderSentinel(code); sentinel != nil {
+		mapped = fmt.Errorf("%w: %s", sentinel, err.Message)
+	}
+	return &OrderError{Code: code, API: err, mapped: mapped}
+}
+
+func mapTypeErr(err *types.Error) error {
 	// Map by Code if available (most reliable)
 	code := strings.ToUpper(err.Code)
 	switch code {
@@ -0,0 +1,202 @@
+package cloberrors
+
+import (
+	"errors"
+	"strings"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// OrderErrorCode is a rejection code returned by the CLOB when an order is
+// refused at placement time.
+type OrderErrorCode string
+
+// Known order rejection codes.
+const (
+	CodeInvalidOrderMinTickSize      OrderErrorCode = "INVALID_ORDER_MIN_TICK_SIZE"
+	CodeInvalidOrderMinSize          OrderErrorCode = "INVALID_ORDER_MIN_SIZE"
+	CodeInvalidOrderDuplicated       OrderErrorCode = "INVALID_ORDER_DUPLICATED"
+	CodeInvalidOrderNotEnoughBalance OrderErrorCode = "INVALID_ORDER_NOT_ENOUGH_BALANCE"
+	CodeInvalidOrderExpiration       OrderErrorCode = "INVALID_ORDER_EXPIRATION"
+	CodeInvalidOrderError            OrderErrorCode = "INVALID_ORDER_ERROR"
+	CodeExecutionError               OrderErrorCode = "EXECUTION_ERROR"
+	CodeOrderDelayed                 OrderErrorCode = "ORDER_DELAYED"
+	CodeDelayingOrderError           OrderErrorCode = "DELAYING_ORDER_ERROR"
+	CodeFOKOrderNotFilled            OrderErrorCode = "FOK_ORDER_NOT_FILLED_ERROR"
+	CodeMarketNotReady               OrderErrorCode = "MARKET_NOT_READY"
+	CodeInsufficientBalance          OrderErrorCode = "INSUFFICIENT_BALANCE"
+	CodeInsufficientAllowance        OrderErrorCode = "INSUFFICIENT_ALLOWANCE"
+)
+
+// KnownOrderErrorCodes lists every order rejection code recognized by the SDK.
+var KnownOrderErrorCodes = []OrderErrorCode{
+	CodeInvalidOrderMinTickSize,
+	CodeInvalidOrderMinSize,
+	CodeInvalidOrderDuplicated,
+	CodeInvalidOrderNotEnoughBalance,
+	CodeInvalidOrderExpiration,
+	CodeInvalidOrderError,
+	CodeExecutionError,
+	CodeOrderDelayed,
+	CodeDelayingOrderError,
+	CodeFOKOrderNotFilled,
+	CodeMarketNotReady,
+	CodeInsufficientBalance,
+	CodeInsufficientAllowance,
+}
+
+var knownOrderErrorCodes = func() map[OrderErrorCode]struct{} {
+	m := make(map[OrderErrorCode]struct{}, len(KnownOrderErrorCodes))
+	for _, code := range KnownOrderErrorCodes {
+		m[code] = struct{}{}
+	}
+	return m
+}()
+
+// IsKnown reports whether c is one of KnownOrderErrorCodes.
+func (c OrderErrorCode) IsKnown() bool {
+	_, ok := knownOrderErrorCodes[c]
+	return ok
+}
+
+// ParseOrderErrorCode finds a known rejection code in s. It accepts a bare
+// code ("invalid_order_min_size") as well as a message that embeds one, such
+// as the errorMsg of a rejected order ("INVALID_ORDER_MIN_SIZE: size 1 ...").
+func ParseOrderErrorCode(s string) (OrderErrorCode, bool) {
+	tokens := strings.FieldsFunc(strings.ToUpper(s), func(r rune) bool {
+		return (r < 'A' || r > 'Z') && r != '_'
+	})
+	for _, token := range tokens {
+		if code := OrderErrorCode(token); code.IsKnown() {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// OrderError is returned by FromTypeErr for order rejections with a known
+// code. It unwraps to both the matching pkg/errors sentinel and the original
+// *types.Error, so errors.Is and errors.As keep working.
+type OrderError struct {
+	Code OrderErrorCode
+	API  *types.Error
+
+	mapped error
+}
+
+func (e *OrderError) Error() string {
+	if e.mapped != nil {
+		return e.mapped.Error()
+	}
+	return string(e.Code)
+}
+
+func (e *OrderError) Unwrap() []error {
+	errs := make([]error, 0, 2)
+	if e.mapped != nil {
+		errs = append(errs, e.mapped)
+	}
+	if e.API != nil {
+		errs = append(errs, e.API)
+	}
+	return errs
+}
+
+// orderSentinel returns the pkg/errors sentinel for order codes that do not
+// already have a mapping in FromTypeErr.
+func orderSentinel(code OrderErrorCode) error {
+	switch code {
+	case CodeInvalidOrderNotEnoughBalance:
+		return sdkerrors.ErrInsufficientFunds
+	case CodeInvalidOrderMinTickSize:
+		return sdkerrors.ErrInvalidPrice
+	case CodeInvalidOrderMinSize:
+		return sdkerrors.ErrInvalidSize
+	}
+	return nil
+}
+
+// CodeOf extracts the order rejection code from err, looking at the code and
+// then the message of a wrapped *types.Error.
+func CodeOf(err error) (OrderErrorCode, bool) {
+	if err == nil {
+		return "", false
+	}
+	var orderErr *OrderError
+	if errors.As(err, &orderErr) {
+		return orderErr.Code, true
+	}
+	var apiErr *types.Error
+	if errors.As(err, &apiErr) {
+		if code, ok := ParseOrderErrorCode(apiErr.Code); ok {
+			return code, true
+		}
+		return ParseOrderErrorCode(apiErr.Message)
+	}
+	return "", false
+}
+
+// HasCode reports whether err carries one of the given rejection codes.
+func HasCode(err error, codes ...OrderErrorCode) bool {
+	code, ok := CodeOf(err)
+	if !ok {
+		return false
+	}
+	for _, c := range codes {
+		if code == c {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInsufficientBalance reports whether the order was rejected for lack of balance.
+func IsInsufficientBalance(err error) bool {
+	return HasCode(err, CodeInvalidOrderNotEnoughBalance, CodeInsufficientBalance)
+}
+
+// IsInsufficientAllowance reports whether the order was rejected for lack of allowance.
+func IsInsufficientAllowance(err error) bool {
+	return HasCode(err, CodeInsufficientAllowance)
+}
+
+// IsTickSizeError reports whether the price breaks the market's minimum tick size.
+func IsTickSizeError(err error) bool {
+	return HasCode(err, CodeInvalidOrderMinTickSize)
+}
+
+// IsMinSizeError reports whether the size is below the market's minimum order size.
+func IsMinSizeError(err error) bool {
+	return HasCode(err, CodeInvalidOrderMinSize)
+}
+
+// IsDuplicateOrder reports whether the same order was already placed.
+func IsDuplicateOrder(err error) bool {
+	return HasCode(err, CodeInvalidOrderDuplicated)
+}
+
+// IsExpirationError reports whether the order expiration is invalid.
+func IsExpirationError(err error) bool {
+	return HasCode(err, CodeInvalidOrderExpiration)
+}
+
+// IsFOKNotFilled reports whether a fill-or-kill order could not be fully filled.
+func IsFOKNotFilled(err error) bool {
+	return HasCode(err, CodeFOKOrderNotFilled)
+}
+
+// IsOrderDelayed reports whether the order was delayed or failed while delayed.
+func IsOrderDelayed(err error) bool {
+	return HasCode(err, CodeOrderDelayed, CodeDelayingOrderError)
+}
+
+// IsMarketNotReady reports whether the market is not yet accepting orders.
+func IsMarketNotReady(err error) bool {
+	return HasCode(err, CodeMarketNotReady)
+}
+
+// IsExecutionError reports whether the order failed during matching.
+func IsExecutionError(err error) bool {
+	return HasCode(err, CodeExecutionError)
+}
@@ -0,0 +1,128 @@
+package cloberrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+func TestKnownOrderErrorCodes(t *testing.T) {
+	want := []string{
+		"INVALID_ORDER_MIN_TICK_SIZE",
+		"INVALID_ORDER_MIN_SIZE",
+		"INVALID_ORDER_DUPLICATED",
+		"INVALID_ORDER_NOT_ENOUGH_BALANCE",
+		"INVALID_ORDER_EXPIRATION",
+		"INVALID_ORDER_ERROR",
+		"EXECUTION_ERROR",
+		"ORDER_DELAYED",
+		"DELAYING_ORDER_ERROR",
+		"FOK_ORDER_NOT_FILLED_ERROR",
+		"MARKET_NOT_READY",
+		"INSUFFICIENT_BALANCE",
+		"INSUFFICIENT_ALLOWANCE",
+	}
+	if len(KnownOrderErrorCodes) != len(want) {
+		t.Fatalf("KnownOrderErrorCodes has %d entries, want %d", len(KnownOrderErrorCodes), len(want))
+	}
+	for i, code := range want {
+		if string(KnownOrderErrorCodes[i]) != code {
+			t.Errorf("KnownOrderErrorCodes[%d] = %s, want %s", i, KnownOrderErrorCodes[i], code)
+		}
+		parsed, ok := ParseOrderErrorCode(code)
+		if !ok || string(parsed) != code {
+			t.Errorf("ParseOrderErrorCode(%q) = %q, %v", code, parsed, ok)
+		}
+		err := FromTypeErr(&types.Error{Status: 400, Code: code, Message: "rejected"})
+		if got, ok := CodeOf(err); !ok || string(got) != code {
+			t.Errorf("CodeOf(FromTypeErr(%s)) = %q, %v", code, got, ok)
+		}
+	}
+	if OrderErrorCode("SOMETHING_ELSE").IsKnown() {
+		t.Error("unexpected known code")
+	}
+}
+
+func TestParseOrderErrorCode_FromMessage(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want OrderErrorCode
+		ok   bool
+	}{
+		{"invalid_order_min_tick_size", CodeInvalidOrderMinTickSize, true},
+		{"INVALID_ORDER_MIN_SIZE: order size 1 is below minimum 5", CodeInvalidOrderMinSize, true},
+		{`{"error":"FOK_ORDER_NOT_FILLED_ERROR"}`, CodeFOKOrderNotFilled, true},
+		{"order couldn't be fully filled", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseOrderErrorCode(tt.msg)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseOrderErrorCode(%q) = %q, %v; want %q, %v", tt.msg, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestOrderErrorPredicates(t *testing.T) {
+	tests := []struct {
+		code string
+		is   func(error) bool
+	}{
+		{"INVALID_ORDER_NOT_ENOUGH_BALANCE", IsInsufficientBalance},
+		{"INSUFFICIENT_BALANCE", IsInsufficientBalance},
+		{"INSUFFICIENT_ALLOWANCE", IsInsufficientAllowance},
+		{"INVALID_ORDER_MIN_TICK_SIZE", IsTickSizeError},
+		{"INVALID_ORDER_MIN_SIZE", IsMinSizeError},
+		{"INVALID_ORDER_DUPLICATED", IsDuplicateOrder},
+		{"INVALID_ORDER_EXPIRATION", IsExpirationError},
+		{"FOK_ORDER_NOT_FILLED_ERROR", IsFOKNotFilled},
+		{"ORDER_DELAYED", IsOrderDelayed},
+		{"DELAYING_ORDER_ERROR", IsOrderDelayed},
+		{"MARKET_NOT_READY", IsMarketNotReady},
+		{"EXECUTION_ERROR", IsExecutionError},
+	}
+	for _, tt := range tests {
+		err := fmt.Errorf("post order: %w", FromTypeErr(&types.Error{Status: 400, Code: tt.code}))
+		if !tt.is(err) {
+			t.Errorf("predicate for %s returned false", tt.code)
+		}
+		if IsFOKNotFilled(err) && tt.code != "FOK_ORDER_NOT_FILLED_ERROR" {
+			t.Errorf("IsFOKNotFilled matched %s", tt.code)
+		}
+	}
+	if IsInsufficientBalance(errors.New("INSUFFICIENT_BALANCE")) {
+		t.Error("plain errors should not match predicates")
+	}
+	if IsInsufficientBalance(nil) {
+		t.Error("nil should not match predicates")
+	}
+}
+
+func TestFromTypeErr_OrderErrorWrapping(t *testing.T) {
+	apiErr := &types.Error{Status: 400, Message: "INVALID_ORDER_MIN_TICK_SIZE: price 0.123 breaks tick 0.01"}
+	err := FromTypeErr(apiErr)
+
+	var orderErr *OrderError
+	if !errors.As(err, &orderErr) || orderErr.Code != CodeInvalidOrderMinTickSize {
+		t.Fatalf("expected *OrderError with tick size code, got %v", err)
+	}
+	if !errors.Is(err, sdkerrors.ErrInvalidPrice) {
+		t.Error("tick size rejection should wrap ErrInvalidPrice")
+	}
+	var gotAPI *types.Error
+	if !errors.As(err, &gotAPI) || gotAPI != apiErr {
+		t.Error("original *types.Error should remain reachable")
+	}
+
+	balance := FromTypeErr(&types.Error{Status: 400, Code: "INSUFFICIENT_BALANCE"})
+	if !errors.Is(balance, sdkerrors.ErrInsufficientFunds) {
+		t.Error("INSUFFICIENT_BALANCE should still wrap ErrInsufficientFunds")
+	}
+	delayed := FromTypeErr(&types.Error{Status: 400, Code: "ORDER_DELAYED"})
+	if !errors.Is(delayed, sdkerrors.ErrBadRequest) {
+		t.Error("codes without a dedicated sentinel should fall back to status mapping")
+	}
+}
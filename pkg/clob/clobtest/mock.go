@@ -0,0 +1,655 @@
+// Package clobtest provides an in-memory clob.Client for unit tests.
+//
+// MockClient never touches the network. Responses are programmed per method
+// name with On or OnCall, and every call is recorded for later assertions.
+package clobtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/heartbeat"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/rfq"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// DefaultTickSize is the tick size returned by TickSize until another response is programmed.
+const DefaultTickSize = 0.01
+
+// Call records a single invocation of a MockClient method.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Responder computes the response for a call. The returned value must have the
+// method's response type (or be nil for the zero value).
+type Responder func(call Call) (any, error)
+
+// MockClient is an in-memory implementation of clob.Client.
+type MockClient struct {
+	mu         sync.Mutex
+	calls      []Call
+	responders map[string]Responder
+	posted     []clobtypes.SignedOrder
+	signer     auth.Signer
+	apiKey     *auth.APIKey
+}
+
+var _ clob.Client = (*MockClient)(nil)
+
+// NewMockClient creates a MockClient with no programmed responses other than
+// a DefaultTickSize tick size, so order builders work out of the box.
+func NewMockClient() *MockClient {
+	m := &MockClient{responders: make(map[string]Responder)}
+	m.On("TickSize", clobtypes.TickSizeResponse{MinimumTickSize: DefaultTickSize}, nil)
+	return m
+}
+
+// On programs a canned response and error for every call to method.
+func (m *MockClient) On(method string, resp any, err error) *MockClient {
+	return m.OnCall(method, func(Call) (any, error) { return resp, err })
+}
+
+// OnCall programs a responder for method, replacing any previous response.
+func (m *MockClient) OnCall(method string, fn Responder) *MockClient {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if fn == nil {
+		delete(m.responders, method)
+	} else {
+		m.responders[method] = fn
+	}
+	return m
+}
+
+// Calls returns a copy of all recorded calls in order.
+func (m *MockClient) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the recorded calls to method in order.
+func (m *MockClient) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Call
+	for _, call := range m.calls {
+		if call.Method == method {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+// CallCount returns how many times method was called.
+func (m *MockClient) CallCount(method string) int {
+	return len(m.CallsTo(method))
+}
+
+// LastCall returns the most recent call to method.
+func (m *MockClient) LastCall(method string) (Call, bool) {
+	calls := m.CallsTo(method)
+	if len(calls) == 0 {
+		return Call{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// OrdersPosted returns every order submitted through PostOrder or PostOrders.
+func (m *MockClient) OrdersPosted() []clobtypes.SignedOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]clobtypes.SignedOrder(nil), m.posted...)
+}
+
+// LastOrderPosted returns the most recent order submitted through PostOrder or
+// PostOrders, or nil if none was posted.
+func (m *MockClient) LastOrderPosted() *clobtypes.SignedOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.posted) == 0 {
+		return nil
+	}
+	order := m.posted[len(m.posted)-1]
+	return &order
+}
+
+// Signer returns the signer passed to WithAuth.
+func (m *MockClient) Signer() auth.Signer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.signer
+}
+
+// APIKey returns the credentials passed to WithAuth or produced by EnsureAPIKey.
+func (m *MockClient) APIKey() *auth.APIKey {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.apiKey
+}
+
+// Reset clears recorded calls and posted orders. Programmed responses are kept.
+func (m *MockClient) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+	m.posted = nil
+}
+
+func (m *MockClient) record(method string, args ...any) Responder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	return m.responders[method]
+}
+
+func respond[T any](m *MockClient, method string, args ...any) (T, error) {
+	var zero T
+	fn := m.record(method, args...)
+	if fn == nil {
+		return zero, nil
+	}
+	resp, err := fn(Call{Method: method, Args: args})
+	if resp == nil {
+		return zero, err
+	}
+	typed, ok := resp.(T)
+	if !ok {
+		return zero, fmt.Errorf("clobtest: %s response has type %T, want %T", method, resp, zero)
+	}
+	return typed, err
+}
+
+// -- Authentication & Configuration --
+
+func (m *MockClient) WithAuth(signer auth.Signer, apiKey *auth.APIKey) clob.Client {
+	m.record("WithAuth", signer, apiKey)
+	m.mu.Lock()
+	m.signer = signer
+	m.apiKey = apiKey
+	m.mu.Unlock()
+	return m
+}
+
+func (m *MockClient) WithBuilderConfig(config *auth.BuilderConfig) clob.Client {
+	m.record("WithBuilderConfig", config)
+	return m
+}
+
+func (m *MockClient) PromoteToBuilder(config *auth.BuilderConfig) clob.Client {
+	m.record("PromoteToBuilder", config)
+	return m
+}
+
+func (m *MockClient) WithSignatureType(sigType auth.SignatureType) clob.Client {
+	m.record("WithSignatureType", sigType)
+	return m
+}
+
+func (m *MockClient) UpdateCredentials(apiKey *auth.APIKey) error {
+	_, err := respond[any](m, "UpdateCredentials", apiKey)
+	if err == nil {
+		m.mu.Lock()
+		m.apiKey = apiKey
+		m.mu.Unlock()
+	}
+	return err
+}
+
+func (m *MockClient) AuthError() error {
+	_, err := respond[any](m, "AuthError")
+	return err
+}
+
+func (m *MockClient) WithAuthNonce(nonce int64) clob.Client {
+	m.record("WithAuthNonce", nonce)
+	return m
+}
+
+func (m *MockClient) WithFunder(funder types.Address) clob.Client {
+	m.record("WithFunder", funder)
+	return m
+}
+
+func (m *MockClient) WithSaltGenerator(gen clob.SaltGenerator) clob.Client {
+	m.record("WithSaltGenerator", gen)
+	return m
+}
+
+func (m *MockClient) WithNonceManager(nonces *clob.NonceManager) clob.Client {
+	m.record("WithNonceManager", nonces)
+	return m
+}
+
+func (m *MockClient) WithGeoblockCheck(enabled bool) clob.Client {
+	m.record("WithGeoblockCheck", enabled)
+	return m
+}
+
+func (m *MockClient) WithUseServerTime(use bool) clob.Client {
+	m.record("WithUseServerTime", use)
+	return m
+}
+
+func (m *MockClient) WithGeoblockHost(host string) clob.Client {
+	m.record("WithGeoblockHost", host)
+	return m
+}
+
+func (m *MockClient) WithWS(client ws.Client) clob.Client {
+	m.record("WithWS", client)
+	return m
+}
+
+func (m *MockClient) WithHeartbeatInterval(interval time.Duration) clob.Client {
+	m.record("WithHeartbeatInterval", interval)
+	return m
+}
+
+func (m *MockClient) WithLimitPolicy(policy clob.LimitPolicy) clob.Client {
+	m.record("WithLimitPolicy", policy)
+	return m
+}
+
+func (m *MockClient) StopHeartbeats() {
+	m.record("StopHeartbeats")
+}
+
+// -- High-level Helpers --
+
+func (m *MockClient) CreateOrder(ctx context.Context, order *clobtypes.Order) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "CreateOrder", order)
+}
+
+func (m *MockClient) CreateOrderWithOptions(ctx context.Context, order *clobtypes.Order, opts *clobtypes.OrderOptions) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "CreateOrderWithOptions", order, opts)
+}
+
+func (m *MockClient) CreateOrderFromSignable(ctx context.Context, order *clobtypes.SignableOrder) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "CreateOrderFromSignable", order)
+}
+
+// -- System Status --
+
+func (m *MockClient) Health(ctx context.Context) (string, error) {
+	return respond[string](m, "Health")
+}
+
+func (m *MockClient) Time(ctx context.Context) (clobtypes.TimeResponse, error) {
+	return respond[clobtypes.TimeResponse](m, "Time")
+}
+
+func (m *MockClient) Geoblock(ctx context.Context) (clobtypes.GeoblockResponse, error) {
+	return respond[clobtypes.GeoblockResponse](m, "Geoblock")
+}
+
+// -- Market Data --
+
+func (m *MockClient) Markets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
+	return respond[clobtypes.MarketsResponse](m, "Markets", req)
+}
+
+func (m *MockClient) MarketsAll(ctx context.Context, req *clobtypes.MarketsRequest) ([]clobtypes.Market, error) {
+	return respond[[]clobtypes.Market](m, "MarketsAll", req)
+}
+
+func (m *MockClient) Market(ctx context.Context, id string) (clobtypes.MarketResponse, error) {
+	return respond[clobtypes.MarketResponse](m, "Market", id)
+}
+
+func (m *MockClient) SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error) {
+	return respond[clobtypes.SimplifiedMarketsResponse](m, "SimplifiedMarkets", req)
+}
+
+func (m *MockClient) SamplingMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
+	return respond[clobtypes.MarketsResponse](m, "SamplingMarkets", req)
+}
+
+func (m *MockClient) SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error) {
+	return respond[clobtypes.SimplifiedMarketsResponse](m, "SamplingSimplifiedMarkets", req)
+}
+
+// -- Order Book & Pricing --
+
+func (m *MockClient) OrderBook(ctx context.Context, req *clobtypes.BookRequest) (clobtypes.OrderBookResponse, error) {
+	return respond[clobtypes.OrderBookResponse](m, "OrderBook", req)
+}
+
+func (m *MockClient) OrderBooks(ctx context.Context, req *clobtypes.BooksRequest) (clobtypes.OrderBooksResponse, error) {
+	return respond[clobtypes.OrderBooksResponse](m, "OrderBooks", req)
+}
+
+func (m *MockClient) Midpoint(ctx context.Context, req *clobtypes.MidpointRequest) (clobtypes.MidpointResponse, error) {
+	return respond[clobtypes.MidpointResponse](m, "Midpoint", req)
+}
+
+func (m *MockClient) Midpoints(ctx context.Context, req *clobtypes.MidpointsRequest) (clobtypes.MidpointsResponse, error) {
+	return respond[clobtypes.MidpointsResponse](m, "Midpoints", req)
+}
+
+func (m *MockClient) Price(ctx context.Context, req *clobtypes.PriceRequest) (clobtypes.PriceResponse, error) {
+	return respond[clobtypes.PriceResponse](m, "Price", req)
+}
+
+func (m *MockClient) Prices(ctx context.Context, req *clobtypes.PricesRequest) (clobtypes.PricesResponse, error) {
+	return respond[clobtypes.PricesResponse](m, "Prices", req)
+}
+
+func (m *MockClient) AllPrices(ctx context.Context) (clobtypes.AllPricesResponse, error) {
+	return respond[clobtypes.AllPricesResponse](m, "AllPrices")
+}
+
+func (m *MockClient) Spread(ctx context.Context, req *clobtypes.SpreadRequest) (clobtypes.SpreadResponse, error) {
+	return respond[clobtypes.SpreadResponse](m, "Spread", req)
+}
+
+func (m *MockClient) Spreads(ctx context.Context, req *clobtypes.SpreadsRequest) (clobtypes.SpreadsResponse, error) {
+	return respond[clobtypes.SpreadsResponse](m, "Spreads", req)
+}
+
+func (m *MockClient) LastTradePrice(ctx context.Context, req *clobtypes.LastTradePriceRequest) (clobtypes.LastTradePriceResponse, error) {
+	return respond[clobtypes.LastTradePriceResponse](m, "LastTradePrice", req)
+}
+
+func (m *MockClient) LastTradesPrices(ctx context.Context, req *clobtypes.LastTradesPricesRequest) (clobtypes.LastTradesPricesResponse, error) {
+	return respond[clobtypes.LastTradesPricesResponse](m, "LastTradesPrices", req)
+}
+
+func (m *MockClient) TickSize(ctx context.Context, req *clobtypes.TickSizeRequest) (clobtypes.TickSizeResponse, error) {
+	return respond[clobtypes.TickSizeResponse](m, "TickSize", req)
+}
+
+func (m *MockClient) NegRisk(ctx context.Context, req *clobtypes.NegRiskRequest) (clobtypes.NegRiskResponse, error) {
+	return respond[clobtypes.NegRiskResponse](m, "NegRisk", req)
+}
+
+func (m *MockClient) FeeRate(ctx context.Context, req *clobtypes.FeeRateRequest) (clobtypes.FeeRateResponse, error) {
+	return respond[clobtypes.FeeRateResponse](m, "FeeRate", req)
+}
+
+func (m *MockClient) PricesHistory(ctx context.Context, req *clobtypes.PricesHistoryRequest) (clobtypes.PricesHistoryResponse, error) {
+	return respond[clobtypes.PricesHistoryResponse](m, "PricesHistory", req)
+}
+
+// -- Cache Management --
+
+func (m *MockClient) InvalidateCaches() {
+	m.record("InvalidateCaches")
+}
+
+func (m *MockClient) InvalidateTickSize(tokenID string) {
+	m.record("InvalidateTickSize", tokenID)
+}
+
+func (m *MockClient) InvalidateGeoblock() {
+	m.record("InvalidateGeoblock")
+}
+
+func (m *MockClient) SetTickSize(tokenID string, tickSize float64) {
+	m.record("SetTickSize", tokenID, tickSize)
+}
+
+func (m *MockClient) SetNegRisk(tokenID string, negRisk bool) {
+	m.record("SetNegRisk", tokenID, negRisk)
+}
+
+func (m *MockClient) SetFeeRateBps(tokenID string, feeRateBps int64) {
+	m.record("SetFeeRateBps", tokenID, feeRateBps)
+}
+
+// -- Order & Trade Management --
+
+func (m *MockClient) PostOrder(ctx context.Context, req *clobtypes.SignedOrder) (clobtypes.OrderResponse, error) {
+	if req != nil {
+		m.mu.Lock()
+		m.posted = append(m.posted, *req)
+		m.mu.Unlock()
+	}
+	return respond[clobtypes.OrderResponse](m, "PostOrder", req)
+}
+
+func (m *MockClient) PostOrders(ctx context.Context, req *clobtypes.SignedOrders) (clobtypes.PostOrdersResponse, error) {
+	if req != nil {
+		m.mu.Lock()
+		m.posted = append(m.posted, req.Orders...)
+		m.mu.Unlock()
+	}
+	return respond[clobtypes.PostOrdersResponse](m, "PostOrders", req)
+}
+
+func (m *MockClient) CancelAndReplace(ctx context.Context, cancelID string, replacement *clobtypes.SignableOrder) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "CancelAndReplace", cancelID, replacement)
+}
+
+func (m *MockClient) CancelOrder(ctx context.Context, req *clobtypes.CancelOrderRequest) (clobtypes.CancelResponse, error) {
+	return respond[clobtypes.CancelResponse](m, "CancelOrder", req)
+}
+
+func (m *MockClient) CancelOrders(ctx context.Context, req *clobtypes.CancelOrdersRequest) (clobtypes.CancelResponse, error) {
+	return respond[clobtypes.CancelResponse](m, "CancelOrders", req)
+}
+
+func (m *MockClient) CancelAll(ctx context.Context) (clobtypes.CancelAllResponse, error) {
+	return respond[clobtypes.CancelAllResponse](m, "CancelAll")
+}
+
+func (m *MockClient) CancelMarketOrders(ctx context.Context, req *clobtypes.CancelMarketOrdersRequest) (clobtypes.CancelMarketOrdersResponse, error) {
+	return respond[clobtypes.CancelMarketOrdersResponse](m, "CancelMarketOrders", req)
+}
+
+func (m *MockClient) CancelOrdersForAsset(ctx context.Context, assetID string) (clobtypes.CancelMarketOrdersResponse, error) {
+	return respond[clobtypes.CancelMarketOrdersResponse](m, "CancelOrdersForAsset", assetID)
+}
+
+func (m *MockClient) Order(ctx context.Context, id string) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "Order", id)
+}
+
+func (m *MockClient) WaitForOrderStatus(ctx context.Context, id string, terminal []string, interval time.Duration) (clobtypes.OrderResponse, error) {
+	return respond[clobtypes.OrderResponse](m, "WaitForOrderStatus", id, terminal, interval)
+}
+
+func (m *MockClient) Orders(ctx context.Context, req *clobtypes.OrdersRequest) (clobtypes.OrdersResponse, error) {
+	return respond[clobtypes.OrdersResponse](m, "Orders", req)
+}
+
+func (m *MockClient) Trades(ctx context.Context, req *clobtypes.TradesRequest) (clobtypes.TradesResponse, error) {
+	return respond[clobtypes.TradesResponse](m, "Trades", req)
+}
+
+func (m *MockClient) OrdersAll(ctx context.Context, req *clobtypes.OrdersRequest) ([]clobtypes.OrderResponse, error) {
+	return respond[[]clobtypes.OrderResponse](m, "OrdersAll", req)
+}
+
+func (m *MockClient) OpenOrdersByMarket(ctx context.Context, market string) ([]clobtypes.OrderResponse, error) {
+	return respond[[]clobtypes.OrderResponse](m, "OpenOrdersByMarket", market)
+}
+
+func (m *MockClient) TradesAll(ctx context.Context, req *clobtypes.TradesRequest) ([]clobtypes.Trade, error) {
+	return respond[[]clobtypes.Trade](m, "TradesAll", req)
+}
+
+func (m *MockClient) BuilderTradesAll(ctx context.Context, req *clobtypes.BuilderTradesRequest) ([]clobtypes.Trade, error) {
+	return respond[[]clobtypes.Trade](m, "BuilderTradesAll", req)
+}
+
+// -- Scoring & Performance --
+
+func (m *MockClient) OrderScoring(ctx context.Context, req *clobtypes.OrderScoringRequest) (clobtypes.OrderScoringResponse, error) {
+	return respond[clobtypes.OrderScoringResponse](m, "OrderScoring", req)
+}
+
+func (m *MockClient) OrdersScoring(ctx context.Context, req *clobtypes.OrdersScoringRequest) (clobtypes.OrdersScoringResponse, error) {
+	return respond[clobtypes.OrdersScoringResponse](m, "OrdersScoring", req)
+}
+
+// -- Account & Notifications --
+
+func (m *MockClient) BalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceRequest) (clobtypes.BalanceAllowanceResponse, error) {
+	return respond[clobtypes.BalanceAllowanceResponse](m, "BalanceAllowance", req)
+}
+
+func (m *MockClient) UpdateBalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceUpdateRequest) (clobtypes.BalanceAllowanceResponse, error) {
+	return respond[clobtypes.BalanceAllowanceResponse](m, "UpdateBalanceAllowance", req)
+}
+
+func (m *MockClient) Notifications(ctx context.Context, req *clobtypes.NotificationsRequest) (clobtypes.NotificationsResponse, error) {
+	return respond[clobtypes.NotificationsResponse](m, "Notifications", req)
+}
+
+func (m *MockClient) DropNotifications(ctx context.Context, req *clobtypes.DropNotificationsRequest) (clobtypes.DropNotificationsResponse, error) {
+	return respond[clobtypes.DropNotificationsResponse](m, "DropNotifications", req)
+}
+
+// -- Rewards & Earnings --
+
+func (m *MockClient) UserEarnings(ctx context.Context, req *clobtypes.UserEarningsRequest) (clobtypes.UserEarningsResponse, error) {
+	return respond[clobtypes.UserEarningsResponse](m, "UserEarnings", req)
+}
+
+func (m *MockClient) UserTotalEarnings(ctx context.Context, req *clobtypes.UserTotalEarningsRequest) (clobtypes.UserTotalEarningsResponse, error) {
+	return respond[clobtypes.UserTotalEarningsResponse](m, "UserTotalEarnings", req)
+}
+
+func (m *MockClient) UserRewardPercentages(ctx context.Context, req *clobtypes.UserRewardPercentagesRequest) (clobtypes.UserRewardPercentagesResponse, error) {
+	return respond[clobtypes.UserRewardPercentagesResponse](m, "UserRewardPercentages", req)
+}
+
+func (m *MockClient) RewardsMarketsCurrent(ctx context.Context, req *clobtypes.RewardsMarketsRequest) (clobtypes.RewardsMarketsResponse, error) {
+	return respond[clobtypes.RewardsMarketsResponse](m, "RewardsMarketsCurrent", req)
+}
+
+func (m *MockClient) RewardsMarkets(ctx context.Context, req *clobtypes.RewardsMarketRequest) (clobtypes.RewardsMarketResponse, error) {
+	return respond[clobtypes.RewardsMarketResponse](m, "RewardsMarkets", req)
+}
+
+func (m *MockClient) UserRewardsByMarket(ctx context.Context, req *clobtypes.UserRewardsByMarketRequest) (clobtypes.UserRewardsByMarketResponse, error) {
+	return respond[clobtypes.UserRewardsByMarketResponse](m, "UserRewardsByMarket", req)
+}
+
+// -- API Key Management --
+
+func (m *MockClient) CreateAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateAPIKey")
+}
+
+func (m *MockClient) CreateAPIKeyWithNonce(ctx context.Context, nonce int64) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateAPIKeyWithNonce", nonce)
+}
+
+func (m *MockClient) ListAPIKeys(ctx context.Context) (clobtypes.APIKeyListResponse, error) {
+	return respond[clobtypes.APIKeyListResponse](m, "ListAPIKeys")
+}
+
+func (m *MockClient) DeleteAPIKey(ctx context.Context, id string) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "DeleteAPIKey", id)
+}
+
+func (m *MockClient) DeriveAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "DeriveAPIKey")
+}
+
+func (m *MockClient) DeriveAPIKeyWithNonce(ctx context.Context, nonce int64) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "DeriveAPIKeyWithNonce", nonce)
+}
+
+func (m *MockClient) CreateOrDeriveAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateOrDeriveAPIKey")
+}
+
+func (m *MockClient) CreateOrDeriveAPIKeyWithNonce(ctx context.Context, nonce int64) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateOrDeriveAPIKeyWithNonce", nonce)
+}
+
+// EnsureAPIKey returns the programmed credentials, falling back to the ones
+// passed to WithAuth.
+func (m *MockClient) EnsureAPIKey(ctx context.Context) (*auth.APIKey, error) {
+	key, err := respond[*auth.APIKey](m, "EnsureAPIKey")
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if key == nil {
+		key = m.apiKey
+	}
+	m.apiKey = key
+	return key, nil
+}
+
+func (m *MockClient) ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error) {
+	return respond[clobtypes.ClosedOnlyResponse](m, "ClosedOnlyStatus")
+}
+
+// -- Read-only API Keys --
+
+func (m *MockClient) CreateReadonlyAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateReadonlyAPIKey")
+}
+
+func (m *MockClient) ListReadonlyAPIKeys(ctx context.Context) (clobtypes.APIKeyListResponse, error) {
+	return respond[clobtypes.APIKeyListResponse](m, "ListReadonlyAPIKeys")
+}
+
+func (m *MockClient) DeleteReadonlyAPIKey(ctx context.Context, id string) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "DeleteReadonlyAPIKey", id)
+}
+
+func (m *MockClient) ValidateReadonlyAPIKey(ctx context.Context, req *clobtypes.ValidateReadonlyAPIKeyRequest) (clobtypes.ValidateReadonlyAPIKeyResponse, error) {
+	return respond[clobtypes.ValidateReadonlyAPIKeyResponse](m, "ValidateReadonlyAPIKey", req)
+}
+
+// -- Builder API Keys --
+
+func (m *MockClient) CreateBuilderAPIKey(ctx context.Context) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "CreateBuilderAPIKey")
+}
+
+func (m *MockClient) ListBuilderAPIKeys(ctx context.Context) (clobtypes.APIKeyListResponse, error) {
+	return respond[clobtypes.APIKeyListResponse](m, "ListBuilderAPIKeys")
+}
+
+func (m *MockClient) RevokeBuilderAPIKey(ctx context.Context, id string) (clobtypes.APIKeyResponse, error) {
+	return respond[clobtypes.APIKeyResponse](m, "RevokeBuilderAPIKey", id)
+}
+
+func (m *MockClient) BuilderTrades(ctx context.Context, req *clobtypes.BuilderTradesRequest) (clobtypes.BuilderTradesResponse, error) {
+	return respond[clobtypes.BuilderTradesResponse](m, "BuilderTrades", req)
+}
+
+func (m *MockClient) MarketTradesEvents(ctx context.Context, id string) (clobtypes.MarketTradesEventsResponse, error) {
+	return respond[clobtypes.MarketTradesEventsResponse](m, "MarketTradesEvents", id)
+}
+
+func (m *MockClient) WatchRFQRequest(ctx context.Context, requestID string, interval time.Duration) (<-chan rfq.RFQQuoteItem, error) {
+	return respond[<-chan rfq.RFQQuoteItem](m, "WatchRFQRequest", requestID, interval)
+}
+
+func (m *MockClient) SubscribeRFQQuotes(ctx context.Context, requestIDs []string) (<-chan clob.StreamResult[rfq.RFQQuoteItem], error) {
+	return respond[<-chan clob.StreamResult[rfq.RFQQuoteItem]](m, "SubscribeRFQQuotes", requestIDs)
+}
+
+// -- Sub-Client Accessors --
+
+func (m *MockClient) RFQ() rfq.Client {
+	client, _ := respond[rfq.Client](m, "RFQ")
+	return client
+}
+
+func (m *MockClient) WS() ws.Client {
+	client, _ := respond[ws.Client](m, "WS")
+	return client
+}
+
+func (m *MockClient) Heartbeat() heartbeat.Client {
+	client, _ := respond[heartbeat.Client](m, "Heartbeat")
+	return client
+}
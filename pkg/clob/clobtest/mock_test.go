@@ -0,0 +1,111 @@
+package clobtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth/authtest"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+)
+
+func TestMockClientCannedResponses(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockClient()
+	mock.On("Midpoint", clobtypes.MidpointResponse{Midpoint: "0.42"}, nil)
+	mock.OnCall("Price", func(call Call) (any, error) {
+		req := call.Args[0].(*clobtypes.PriceRequest)
+		return clobtypes.PriceResponse{Price: req.TokenID}, nil
+	})
+	mock.On("Health", nil, errors.New("down"))
+
+	mid, err := mock.Midpoint(ctx, &clobtypes.MidpointRequest{TokenID: "t1"})
+	if err != nil || mid.Midpoint != "0.42" {
+		t.Fatalf("unexpected midpoint: %+v %v", mid, err)
+	}
+	price, err := mock.Price(ctx, &clobtypes.PriceRequest{TokenID: "t2"})
+	if err != nil || price.Price != "t2" {
+		t.Fatalf("unexpected price: %+v %v", price, err)
+	}
+	if _, err := mock.Health(ctx); err == nil || err.Error() != "down" {
+		t.Fatalf("expected programmed error, got %v", err)
+	}
+	if book, err := mock.OrderBook(ctx, nil); err != nil || len(book.Bids) != 0 {
+		t.Fatalf("expected zero value for unprogrammed method, got %+v %v", book, err)
+	}
+
+	mock.On("Spread", "wrong", nil)
+	if _, err := mock.Spread(ctx, nil); err == nil || !strings.Contains(err.Error(), "Spread") {
+		t.Fatalf("expected type mismatch error, got %v", err)
+	}
+
+	if got := mock.CallCount("Midpoint"); got != 1 {
+		t.Fatalf("expected 1 Midpoint call, got %d", got)
+	}
+	last, ok := mock.LastCall("Price")
+	if !ok || last.Args[0].(*clobtypes.PriceRequest).TokenID != "t2" {
+		t.Fatalf("unexpected last Price call: %+v", last)
+	}
+	if len(mock.Calls()) != 5 {
+		t.Fatalf("expected 5 recorded calls, got %d", len(mock.Calls()))
+	}
+	mock.Reset()
+	if len(mock.Calls()) != 0 {
+		t.Fatalf("expected calls cleared after Reset")
+	}
+}
+
+func TestMockClientOrderFlow(t *testing.T) {
+	ctx := context.Background()
+	signer := authtest.NewMockSigner(common.HexToAddress("0x0000000000000000000000000000000000000001"))
+	apiKey := &auth.APIKey{Key: "key", Secret: "c2VjcmV0", Passphrase: "pass"}
+
+	mock := NewMockClient()
+	mock.On("PostOrder", clobtypes.OrderResponse{ID: "order-1", Status: "live"}, nil)
+	client := mock.WithAuth(signer, apiKey)
+	if client != clob.Client(mock) {
+		t.Fatalf("expected WithAuth to return the mock itself")
+	}
+
+	if mock.LastOrderPosted() != nil {
+		t.Fatalf("expected no posted order")
+	}
+
+	signable, err := clob.NewOrderBuilder(client, signer).
+		TokenID("123").
+		Side("BUY").
+		Price(0.5).
+		Size(10).
+		BuildSignableWithContext(ctx)
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+	if mock.CallCount("TickSize") != 1 {
+		t.Fatalf("expected builder to query tick size")
+	}
+
+	signed, err := clob.SignOrder(signer, mock.APIKey(), signable.Order)
+	if err != nil {
+		t.Fatalf("sign failed: %v", err)
+	}
+	resp, err := client.PostOrder(ctx, signed)
+	if err != nil || resp.ID != "order-1" {
+		t.Fatalf("unexpected post response: %+v %v", resp, err)
+	}
+
+	posted := mock.LastOrderPosted()
+	if posted == nil {
+		t.Fatalf("expected posted order")
+	}
+	if posted.Order.TokenID.String() != "123" || posted.Owner != "key" {
+		t.Fatalf("unexpected posted order: %+v", posted)
+	}
+	if len(signer.Requests()) != 1 {
+		t.Fatalf("expected one signature request, got %d", len(signer.Requests()))
+	}
+}
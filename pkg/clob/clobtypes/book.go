@@ -0,0 +1,70 @@
+package clobtypes
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceDec parses Price as a decimal.
+func (l PriceLevel) PriceDec() (decimal.Decimal, error) {
+	return decimal.NewFromString(strings.TrimSpace(l.Price))
+}
+
+// SizeDec parses Size as a decimal.
+func (l PriceLevel) SizeDec() (decimal.Decimal, error) {
+	return decimal.NewFromString(strings.TrimSpace(l.Size))
+}
+
+// BestBid returns the highest bid price. Levels are scanned rather than taking
+// the first entry because the API returns the best level last. ok is false if
+// there are no parsable bids.
+func (b OrderBook) BestBid() (decimal.Decimal, bool) {
+	return bestPrice(b.Bids, true)
+}
+
+// BestAsk returns the lowest ask price. ok is false if there are no parsable asks.
+func (b OrderBook) BestAsk() (decimal.Decimal, bool) {
+	return bestPrice(b.Asks, false)
+}
+
+// Midpoint returns (best bid + best ask) / 2, the same definition as the
+// /midpoint endpoint. ok is false unless both sides are present.
+func (b OrderBook) Midpoint() (decimal.Decimal, bool) {
+	bid, ask, ok := b.bestBidAsk()
+	if !ok {
+		return decimal.Zero, false
+	}
+	return bid.Add(ask).Div(decimal.NewFromInt(2)), true
+}
+
+// Spread returns best ask minus best bid. ok is false unless both sides are present.
+func (b OrderBook) Spread() (decimal.Decimal, bool) {
+	bid, ask, ok := b.bestBidAsk()
+	if !ok {
+		return decimal.Zero, false
+	}
+	return ask.Sub(bid), true
+}
+
+func (b OrderBook) bestBidAsk() (bid, ask decimal.Decimal, ok bool) {
+	bid, bidOK := b.BestBid()
+	ask, askOK := b.BestAsk()
+	return bid, ask, bidOK && askOK
+}
+
+func bestPrice(levels []PriceLevel, highest bool) (decimal.Decimal, bool) {
+	var best decimal.Decimal
+	found := false
+	for _, level := range levels {
+		price, err := level.PriceDec()
+		if err != nil {
+			continue
+		}
+		if !found || (highest && price.GreaterThan(best)) || (!highest && price.LessThan(best)) {
+			best = price
+			found = true
+		}
+	}
+	return best, found
+}
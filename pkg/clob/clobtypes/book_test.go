@@ -0,0 +1,45 @@
+package clobtypes
+
+import "testing"
+
+func TestOrderBookHelpers(t *testing.T) {
+	book := OrderBook{
+		Bids: []PriceLevel{{Price: "0.40", Size: "10"}, {Price: "bad", Size: "1"}, {Price: "0.45", Size: "5"}},
+		Asks: []PriceLevel{{Price: "0.60", Size: "7"}, {Price: "0.55", Size: "2"}},
+	}
+
+	size, err := book.Bids[2].SizeDec()
+	if err != nil || size.String() != "5" {
+		t.Fatalf("SizeDec = %s, %v", size, err)
+	}
+	if _, err := book.Bids[1].PriceDec(); err == nil {
+		t.Fatal("expected PriceDec error for bad level")
+	}
+
+	checks := []struct {
+		name string
+		fn   func() (string, bool)
+		want string
+	}{
+		{"BestBid", func() (string, bool) { v, ok := book.BestBid(); return v.String(), ok }, "0.45"},
+		{"BestAsk", func() (string, bool) { v, ok := book.BestAsk(); return v.String(), ok }, "0.55"},
+		{"Midpoint", func() (string, bool) { v, ok := book.Midpoint(); return v.String(), ok }, "0.5"},
+		{"Spread", func() (string, bool) { v, ok := book.Spread(); return v.String(), ok }, "0.1"},
+	}
+	for _, c := range checks {
+		if got, ok := c.fn(); !ok || got != c.want {
+			t.Errorf("%s = %s, %v; want %s", c.name, got, ok, c.want)
+		}
+	}
+
+	oneSided := OrderBook{Bids: book.Bids}
+	if _, ok := oneSided.Midpoint(); ok {
+		t.Error("Midpoint should be unavailable for a one-sided book")
+	}
+	if _, ok := oneSided.Spread(); ok {
+		t.Error("Spread should be unavailable for a one-sided book")
+	}
+	if _, ok := (OrderBook{}).BestBid(); ok {
+		t.Error("BestBid should be unavailable for an empty book")
+	}
+}
@@ -0,0 +1,83 @@
+package clobtypes
+
+import (
+	"sort"
+	"time"
+)
+
+// Candle is an OHLC bar built from price history points.
+type Candle struct {
+	// Start is the beginning of the bucket; the bar covers [Start, Start+interval).
+	Start time.Time
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+	// Volume is the traded size in the bucket. Price history carries no size,
+	// so candles resampled from it have zero volume.
+	Volume float64
+	// Points is the number of history points or trades in the bucket.
+	Points int
+}
+
+// Time returns the point's timestamp (unix seconds) as a time.Time.
+func (p PriceHistoryPoint) Time() time.Time {
+	return time.Unix(p.Timestamp, 0)
+}
+
+// PriceFloat returns the price as a float64, which may round prices with more
+// than about 15 significant digits.
+func (p PriceHistoryPoint) PriceFloat() float64 {
+	f, _ := p.Price.Float64()
+	return f
+}
+
+// Resample buckets the points into OHLC candles of the given interval. Buckets
+// are aligned to multiples of interval since the unix epoch, points are taken
+// in timestamp order regardless of their order in the response, and buckets
+// without points are omitted. Intervals shorter than one second return nil.
+func (p PricesHistoryResponse) Resample(interval time.Duration) []Candle {
+	step := int64(interval / time.Second)
+	if step <= 0 || len(p) == 0 {
+		return nil
+	}
+	points := make([]PriceHistoryPoint, len(p))
+	copy(points, p)
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Timestamp < points[j].Timestamp })
+
+	var candles []Candle
+	bucket := int64(0)
+	for _, point := range points {
+		price := point.PriceFloat()
+		start := point.Timestamp - mod(point.Timestamp, step)
+		if len(candles) == 0 || start != bucket {
+			bucket = start
+			candles = append(candles, Candle{
+				Start: time.Unix(start, 0),
+				Open:  price,
+				High:  price,
+				Low:   price,
+				Close: price,
+			})
+		}
+		c := &candles[len(candles)-1]
+		if price > c.High {
+			c.High = price
+		}
+		if price < c.Low {
+			c.Low = price
+		}
+		c.Close = price
+		c.Points++
+	}
+	return candles
+}
+
+// mod returns a non-negative remainder so pre-epoch timestamps floor correctly.
+func mod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
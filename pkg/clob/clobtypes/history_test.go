@@ -0,0 +1,70 @@
+package clobtypes
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPricesHistoryResample(t *testing.T) {
+	// Out of order on purpose; minute buckets start at 1700000040.
+	history := PricesHistoryResponse{
+		{Timestamp: 1700000100, Price: decimal.RequireFromString("0.55")},
+		{Timestamp: 1700000045, Price: decimal.RequireFromString("0.50")},
+		{Timestamp: 1700000060, Price: decimal.RequireFromString("0.53")},
+		{Timestamp: 1700000050, Price: decimal.RequireFromString("0.48")},
+		{Timestamp: 1700000099, Price: decimal.RequireFromString("0.51")},
+		{Timestamp: 1700000090, Price: decimal.RequireFromString("0.56")},
+		{Timestamp: 1700000280, Price: decimal.RequireFromString("0.60")},
+	}
+
+	if got := history[0].Time(); !got.Equal(time.Unix(1700000100, 0)) {
+		t.Fatalf("Time() = %v", got)
+	}
+
+	candles := history.Resample(time.Minute)
+	want := []Candle{
+		{Start: time.Unix(1700000040, 0), Open: 0.50, High: 0.56, Low: 0.48, Close: 0.51, Points: 5},
+		{Start: time.Unix(1700000100, 0), Open: 0.55, High: 0.55, Low: 0.55, Close: 0.55, Points: 1},
+		{Start: time.Unix(1700000280, 0), Open: 0.60, High: 0.60, Low: 0.60, Close: 0.60, Points: 1},
+	}
+	if len(candles) != len(want) {
+		t.Fatalf("got %d candles, want %d: %+v", len(candles), len(want), candles)
+	}
+	for i := range want {
+		got := candles[i]
+		if !got.Start.Equal(want[i].Start) || got.Open != want[i].Open || got.High != want[i].High ||
+			got.Low != want[i].Low || got.Close != want[i].Close || got.Points != want[i].Points {
+			t.Errorf("candle %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+	if history[0].Timestamp != 1700000100 {
+		t.Error("Resample must not reorder the receiver")
+	}
+
+	if candles := history.Resample(time.Hour); len(candles) != 1 || candles[0].Points != len(history) {
+		t.Errorf("hourly resample = %+v", candles)
+	}
+	if history.Resample(0) != nil || PricesHistoryResponse(nil).Resample(time.Minute) != nil {
+		t.Error("expected nil for invalid interval or empty history")
+	}
+}
+
+func TestPriceHistoryPointPrecision(t *testing.T) {
+	var resp PricesHistoryResponse
+	data := `[{"t":1,"p":0.999999999999999},{"t":2,"p":"0.999999999999999"},{"t":3,"p":0.1234567890123456789}]`
+	if err := json.Unmarshal([]byte(data), &resp); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	want := []string{"0.999999999999999", "0.999999999999999", "0.1234567890123456789"}
+	for i, w := range want {
+		if got := resp[i].Price.String(); got != w {
+			t.Errorf("Point[%d].Price = %s, want %s", i, got, w)
+		}
+	}
+	if got := resp[0].PriceFloat(); got != 0.999999999999999 {
+		t.Errorf("PriceFloat = %v, want 0.999999999999999", got)
+	}
+}
@@ -0,0 +1,187 @@
+package clobtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// signedOrderPayload is the POST /order body, used to parse payloads back
+// into a SignedOrder. U256 and Decimal accept both strings and numbers, so
+// maps from ToPayload and maps decoded from JSON parse the same way.
+type signedOrderPayload struct {
+	Order struct {
+		Salt          types.U256    `json:"salt"`
+		Maker         types.Address `json:"maker"`
+		Signer        types.Address `json:"signer"`
+		Taker         types.Address `json:"taker"`
+		TokenID       types.U256    `json:"tokenId"`
+		MakerAmount   types.Decimal `json:"makerAmount"`
+		TakerAmount   types.Decimal `json:"takerAmount"`
+		Side          string        `json:"side"`
+		Expiration    types.U256    `json:"expiration"`
+		Nonce         types.U256    `json:"nonce"`
+		FeeRateBps    types.Decimal `json:"feeRateBps"`
+		SignatureType *int          `json:"signatureType"`
+		Signature     string        `json:"signature"`
+	} `json:"order"`
+	Owner     string    `json:"owner"`
+	OrderType OrderType `json:"orderType"`
+	PostOnly  *bool     `json:"postOnly,omitempty"`
+	DeferExec *bool     `json:"deferExec,omitempty"`
+}
+
+// ToPayload returns the exact body the CLOB expects for POST /order, as
+// sent by Client.PostOrder. Encoding it with encoding/json yields the
+// request body, so orders can be logged or submitted over another HTTP
+// stack (the L2 auth headers are still required).
+//
+// The order fields are camelCase. The salt is a JSON number, the side is
+// "BUY" or "SELL", signatureType is an integer, and the token ID, amounts,
+// expiration, nonce and fee rate are decimal strings. orderType defaults to
+// GTC; postOnly and deferExec are only present when set.
+func (o *SignedOrder) ToPayload() (map[string]interface{}, error) {
+	if o == nil {
+		return nil, fmt.Errorf("order is required")
+	}
+	orderType := o.OrderType.normalize(OrderTypeGTC)
+	if o.PostOnly != nil && *o.PostOnly && orderType != OrderTypeGTC && orderType != OrderTypeGTD {
+		return nil, fmt.Errorf("postOnly is only supported for GTC and GTD orders")
+	}
+	orderMap, err := o.orderWithSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"order":     orderMap,
+		"owner":     o.Owner,
+		"orderType": orderType,
+	}
+	if o.PostOnly != nil {
+		payload["postOnly"] = *o.PostOnly
+	}
+	if o.DeferExec != nil {
+		payload["deferExec"] = *o.DeferExec
+	}
+	return payload, nil
+}
+
+// FromPayload replaces o with the order described by payload, the inverse
+// of ToPayload. It accepts the map returned by ToPayload as well as a
+// POST /order body decoded into a map. The payload is validated like
+// ToPayload; o is left unchanged on error. SignatureType is always set
+// afterwards since the payload always carries it.
+func (o *SignedOrder) FromPayload(payload map[string]interface{}) error {
+	if payload == nil {
+		return fmt.Errorf("payload is required")
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode payload: %w", err)
+	}
+	var wire signedOrderPayload
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return fmt.Errorf("decode payload: %w", err)
+	}
+
+	parsed := SignedOrder{
+		Order: Order{
+			Salt:          wire.Order.Salt,
+			Signer:        wire.Order.Signer,
+			Maker:         wire.Order.Maker,
+			Taker:         wire.Order.Taker,
+			TokenID:       wire.Order.TokenID,
+			MakerAmount:   wire.Order.MakerAmount,
+			TakerAmount:   wire.Order.TakerAmount,
+			Expiration:    wire.Order.Expiration,
+			Side:          wire.Order.Side,
+			FeeRateBps:    wire.Order.FeeRateBps,
+			Nonce:         wire.Order.Nonce,
+			SignatureType: wire.Order.SignatureType,
+		},
+		Signature: wire.Order.Signature,
+		Owner:     wire.Owner,
+		OrderType: wire.OrderType,
+		PostOnly:  wire.PostOnly,
+		DeferExec: wire.DeferExec,
+	}
+	if parsed.Order.SignatureType == nil {
+		parsed.Order.SignatureType = new(int)
+	}
+	if _, err := parsed.ToPayload(); err != nil {
+		return err
+	}
+	*o = parsed
+	return nil
+}
+
+func (o *SignedOrder) orderWithSignature() (map[string]interface{}, error) {
+	if o.Signature == "" {
+		return nil, fmt.Errorf("signature is required")
+	}
+	if o.Owner == "" {
+		return nil, fmt.Errorf("owner is required")
+	}
+
+	sigType := 0
+	if o.Order.SignatureType != nil {
+		sigType = *o.Order.SignatureType
+	}
+
+	side := strings.ToUpper(o.Order.Side)
+	if side != "BUY" && side != "SELL" {
+		return nil, fmt.Errorf("invalid order side %q", o.Order.Side)
+	}
+
+	salt, err := saltToJSON(o.Order.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"salt":          salt,
+		"maker":         o.Order.Maker.Hex(),
+		"signer":        o.Order.Signer.Hex(),
+		"taker":         o.Order.Taker.Hex(),
+		"tokenId":       u256String(o.Order.TokenID),
+		"makerAmount":   o.Order.MakerAmount.String(),
+		"takerAmount":   o.Order.TakerAmount.String(),
+		"side":          side,
+		"expiration":    u256String(o.Order.Expiration),
+		"nonce":         u256String(o.Order.Nonce),
+		"feeRateBps":    o.Order.FeeRateBps.String(),
+		"signatureType": sigType,
+		"signature":     o.Signature,
+	}, nil
+}
+
+func (t OrderType) normalize(fallback OrderType) OrderType {
+	trimmed := strings.TrimSpace(string(t))
+	if trimmed == "" {
+		return fallback
+	}
+	return OrderType(strings.ToUpper(trimmed))
+}
+
+func u256String(value types.U256) string {
+	if value.Int == nil {
+		return "0"
+	}
+	return value.Int.String()
+}
+
+func saltToJSON(value types.U256) (interface{}, error) {
+	if value.Int == nil {
+		return uint64(0), nil
+	}
+	if value.Int.Sign() < 0 {
+		return nil, fmt.Errorf("salt must be non-negative")
+	}
+	if value.Int.BitLen() > 53 {
+		return nil, fmt.Errorf("salt is too large (max 53 bits)")
+	}
+	return value.Int.Uint64(), nil
+}
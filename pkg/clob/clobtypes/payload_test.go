@@ -0,0 +1,124 @@
+package clobtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+func goldenSignedOrder() SignedOrder {
+	sigType := 2
+	postOnly := true
+	tokenID, _ := new(big.Int).SetString("71321045679252212594626385532706912750332728571942532289631379312455583992563", 10)
+	return SignedOrder{
+		Order: Order{
+			Salt:          types.U256{Int: big.NewInt(1234567890123)},
+			Maker:         common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			Signer:        common.HexToAddress("0x2222222222222222222222222222222222222222"),
+			Taker:         common.Address{},
+			TokenID:       types.U256{Int: tokenID},
+			MakerAmount:   decimal.RequireFromString("5500000"),
+			TakerAmount:   decimal.RequireFromString("10000000"),
+			Side:          "buy",
+			Expiration:    types.U256{Int: big.NewInt(1767225600)},
+			FeeRateBps:    decimal.Zero,
+			Nonce:         types.U256{Int: big.NewInt(3)},
+			SignatureType: &sigType,
+		},
+		Signature: "0xsig",
+		Owner:     "api-key-owner",
+		OrderType: "gtd",
+		PostOnly:  &postOnly,
+	}
+}
+
+func TestSignedOrderPayloadGolden(t *testing.T) {
+	order := goldenSignedOrder()
+	payload, err := order.ToPayload()
+	if err != nil {
+		t.Fatalf("ToPayload failed: %v", err)
+	}
+	got, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	want, err := os.ReadFile("testdata/signed_order.golden.json")
+	if err != nil {
+		t.Fatalf("read golden: %v", err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+		t.Fatalf("payload does not match golden file:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestSignedOrderFromPayload(t *testing.T) {
+	want, err := os.ReadFile("testdata/signed_order.golden.json")
+	if err != nil {
+		t.Fatalf("read golden: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(want, &decoded); err != nil {
+		t.Fatalf("decode golden: %v", err)
+	}
+
+	var order SignedOrder
+	if err := order.FromPayload(decoded); err != nil {
+		t.Fatalf("FromPayload failed: %v", err)
+	}
+	orig := goldenSignedOrder()
+	if order.Order.Salt.Cmp(orig.Order.Salt.Int) != 0 || order.Order.TokenID.Cmp(orig.Order.TokenID.Int) != 0 {
+		t.Errorf("salt/tokenId = %v/%v", order.Order.Salt, order.Order.TokenID)
+	}
+	if order.Order.Maker != orig.Order.Maker || !order.Order.MakerAmount.Equal(orig.Order.MakerAmount) {
+		t.Errorf("maker/makerAmount = %s/%s", order.Order.Maker.Hex(), order.Order.MakerAmount)
+	}
+	if order.Order.Side != "BUY" || order.OrderType != OrderTypeGTD || order.PostOnly == nil || !*order.PostOnly {
+		t.Errorf("side/orderType/postOnly = %s/%s/%v", order.Order.Side, order.OrderType, order.PostOnly)
+	}
+
+	payload, err := order.ToPayload()
+	if err != nil {
+		t.Fatalf("ToPayload failed: %v", err)
+	}
+	got, _ := json.MarshalIndent(payload, "", "  ")
+	if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+		t.Fatalf("round trip changed the payload:\n got: %s\nwant: %s", got, want)
+	}
+
+	// The map from ToPayload parses the same as a decoded body.
+	var direct SignedOrder
+	if err := direct.FromPayload(payload); err != nil {
+		t.Fatalf("FromPayload(ToPayload()) failed: %v", err)
+	}
+	if direct.Order.Salt.Cmp(orig.Order.Salt.Int) != 0 || *direct.Order.SignatureType != 2 {
+		t.Errorf("salt/signatureType = %v/%d", direct.Order.Salt, *direct.Order.SignatureType)
+	}
+}
+
+func TestSignedOrderFromPayloadInvalid(t *testing.T) {
+	orig := goldenSignedOrder()
+	payload, err := orig.ToPayload()
+	if err != nil {
+		t.Fatalf("ToPayload failed: %v", err)
+	}
+	payload["order"].(map[string]interface{})["side"] = "HOLD"
+
+	order := SignedOrder{Owner: "unchanged"}
+	if err := order.FromPayload(payload); err == nil || !strings.Contains(err.Error(), "side") {
+		t.Fatalf("expected side error, got %v", err)
+	}
+	if order.Owner != "unchanged" {
+		t.Errorf("order modified on error: %+v", order)
+	}
+	if err := order.FromPayload(nil); err == nil {
+		t.Fatal("expected error for nil payload")
+	}
+}
@@ -1,8 +1,14 @@
+// Package clobtypes holds the request and response types of the CLOB REST
+// API. It is the single definition used by package clob and its mocks.
 package clobtypes
 
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 )
@@ -17,6 +23,69 @@ const (
 	OrderTypeFOK OrderType = "FOK"
 )
 
+// Side is the side of an order or trade. Fields on the wire stay plain
+// strings; use ParseSide to validate and normalize them.
+type Side string
+
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+)
+
+// ParseSide returns the Side named by s, ignoring case and surrounding space.
+func ParseSide(s string) (Side, error) {
+	side := Side(strings.ToUpper(strings.TrimSpace(s)))
+	if !side.Valid() {
+		return "", fmt.Errorf("invalid side %q: must be BUY or SELL", s)
+	}
+	return side, nil
+}
+
+// Valid reports whether s is SideBuy or SideSell.
+func (s Side) Valid() bool {
+	return s == SideBuy || s == SideSell
+}
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// OrderStatus is the status of an order as reported by placement and the
+// order endpoints.
+type OrderStatus string
+
+const (
+	OrderStatusLive      OrderStatus = "LIVE"
+	OrderStatusMatched   OrderStatus = "MATCHED"
+	OrderStatusDelayed   OrderStatus = "DELAYED"
+	OrderStatusUnmatched OrderStatus = "UNMATCHED"
+	OrderStatusCanceled  OrderStatus = "CANCELED"
+)
+
+// ParseOrderStatus returns the OrderStatus named by s, ignoring case and
+// surrounding space. Placement responses use lowercase statuses.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	status := OrderStatus(strings.ToUpper(strings.TrimSpace(s)))
+	if !status.Valid() {
+		return "", fmt.Errorf("invalid order status %q", s)
+	}
+	return status, nil
+}
+
+// Valid reports whether s is one of the known order statuses.
+func (s OrderStatus) Valid() bool {
+	switch s {
+	case OrderStatusLive, OrderStatusMatched, OrderStatusDelayed, OrderStatusUnmatched, OrderStatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s OrderStatus) String() string {
+	return string(s)
+}
+
 // PriceHistoryInterval represents the supported time intervals for price history.
 type PriceHistoryInterval string
 
@@ -114,10 +183,12 @@ type (
 		Order     *Order    `json:"order"`
 		OrderType OrderType `json:"order_type"`
 		PostOnly  *bool     `json:"post_only,omitempty"`
+		DeferExec *bool     `json:"defer_exec,omitempty"`
 	}
 	OrderOptions struct {
 		OrderType OrderType
 		PostOnly  *bool
+		// DeferExec is sent as "deferExec" when set; see OrderBuilder.DeferExec.
 		DeferExec *bool
 	}
 	SignedOrder struct {
@@ -138,7 +209,9 @@ type (
 		OrderID string `json:"orderId,omitempty"`
 	}
 	CancelOrdersRequest struct {
-		// OrderIDs is the canonical batch payload.
+		// OrderIDs is the canonical batch payload. Client.CancelOrders sends
+		// the IDs as a bare JSON array; the "orderIds" tag only applies when the
+		// request itself is marshaled.
 		OrderIDs []string `json:"orderIds,omitempty"`
 	}
 	CancelMarketOrdersRequest struct {
@@ -146,7 +219,8 @@ type (
 		Market string `json:"market,omitempty"`
 		// AssetID is an optional asset filter.
 		AssetID string `json:"asset_id,omitempty"`
-		// Deprecated: legacy field name.
+		// Deprecated: use Market. Client.CancelMarketOrders sends it as
+		// "market" when Market is empty.
 		MarketID string `json:"market_id,omitempty"`
 	}
 	OrdersRequest struct {
@@ -184,6 +258,7 @@ type (
 		// TokenID is required when AssetType=CONDITIONAL.
 		TokenID string `json:"token_id,omitempty"`
 		// SignatureType is the user signature type (0=EOA, 1=Proxy, 2=Safe).
+		// When nil, the client's WithSignatureType default is sent.
 		SignatureType *int `json:"signature_type,omitempty"`
 	}
 	BalanceAllowanceUpdateRequest struct {
@@ -194,6 +269,7 @@ type (
 		// TokenID is required when AssetType=CONDITIONAL.
 		TokenID string `json:"token_id,omitempty"`
 		// SignatureType is the user signature type (0=EOA, 1=Proxy, 2=Safe).
+		// When nil, the client's WithSignatureType default is sent.
 		SignatureType *int `json:"signature_type,omitempty"`
 		// Amount is deprecated by the API but kept for compatibility.
 		Amount string `json:"amount,omitempty"`
@@ -209,6 +285,7 @@ type (
 		// Date is required by the API (YYYY-MM-DD).
 		Date string `json:"date,omitempty"`
 		// SignatureType is the user signature type (0=EOA, 1=Proxy, 2=Safe).
+		// When nil, the client's WithSignatureType default is sent.
 		SignatureType *int `json:"signature_type,omitempty"`
 		// NextCursor paginates results.
 		NextCursor string `json:"next_cursor,omitempty"`
@@ -219,6 +296,7 @@ type (
 		// Date is required by the API (YYYY-MM-DD).
 		Date string `json:"date,omitempty"`
 		// SignatureType is the user signature type (0=EOA, 1=Proxy, 2=Safe).
+		// When nil, the client's WithSignatureType default is sent.
 		SignatureType *int `json:"signature_type,omitempty"`
 		// Asset is deprecated and kept for compatibility.
 		Asset string `json:"asset,omitempty"`
@@ -234,6 +312,7 @@ type (
 		// NoCompetition toggles competition filtering.
 		NoCompetition bool `json:"no_competition,omitempty"`
 		// SignatureType is the user signature type (0=EOA, 1=Proxy, 2=Safe).
+		// When nil, the client's WithSignatureType default is sent.
 		SignatureType *int `json:"signature_type,omitempty"`
 		// NextCursor paginates results.
 		NextCursor string `json:"next_cursor,omitempty"`
@@ -279,6 +358,12 @@ type (
 		Limit      int      `json:"limit"`
 		Count      int      `json:"count"`
 	}
+	SimplifiedMarketsResponse struct {
+		Data       []SimplifiedMarket `json:"data"`
+		NextCursor string             `json:"next_cursor"`
+		Limit      int                `json:"limit"`
+		Count      int                `json:"count"`
+	}
 	MarketResponse     Market
 	OrderBookResponse  OrderBook
 	OrderBooksResponse []OrderBook
@@ -316,9 +401,32 @@ type (
 		Region  string `json:"region"`
 	}
 	PricesHistoryResponse []PriceHistoryPoint
-	OrderResponse         struct {
-		ID     string `json:"orderID"`
-		Status string `json:"status"`
+	// OrderResponse is returned by order placement and by the /data/order(s)
+	// endpoints. Placement fills ID, Status, Success, ErrorMsg and the hash and
+	// amount fields; the data endpoints fill the order detail fields.
+	OrderResponse struct {
+		ID              string        `json:"orderID"`
+		Status          string        `json:"status"`
+		Owner           string        `json:"owner,omitempty"`
+		MakerAddress    string        `json:"maker_address,omitempty"`
+		Market          string        `json:"market,omitempty"`
+		AssetID         string        `json:"asset_id,omitempty"`
+		Outcome         string        `json:"outcome,omitempty"`
+		Side            string        `json:"side,omitempty"`
+		OrderType       OrderType     `json:"order_type,omitempty"`
+		Price           types.Decimal `json:"price"`
+		OriginalSize    types.Decimal `json:"original_size"`
+		SizeMatched     types.Decimal `json:"size_matched"`
+		AssociateTrades []string      `json:"associate_trades,omitempty"`
+		// Expiration and CreatedAt are unix seconds; Expiration is 0 for orders without one.
+		Expiration int64 `json:"expiration,omitempty"`
+		CreatedAt  int64 `json:"created_at,omitempty"`
+
+		Success           bool     `json:"success,omitempty"`
+		ErrorMsg          string   `json:"errorMsg,omitempty"`
+		TransactionHashes []string `json:"transactionsHashes,omitempty"`
+		TakingAmount      string   `json:"takingAmount,omitempty"`
+		MakingAmount      string   `json:"makingAmount,omitempty"`
 	}
 	PostOrdersResponse []OrderResponse
 	OrdersResponse     struct {
@@ -329,6 +437,10 @@ type (
 	}
 	CancelResponse struct {
 		Status string `json:"status"`
+		// Canceled lists the order IDs the server canceled.
+		Canceled []string `json:"canceled,omitempty"`
+		// NotCanceled maps order IDs that were not canceled to the reason.
+		NotCanceled map[string]string `json:"not_canceled,omitempty"`
 	}
 	CancelAllResponse struct {
 		Status string `json:"status"`
@@ -415,14 +527,40 @@ type (
 		EndDate     string        `json:"end_date"`
 		Tokens      []MarketToken `json:"tokens"`
 		// Add minimal fields to match "Simplified" or "Active"
-		Active bool `json:"active"`
-		Closed bool `json:"closed"`
+		Active          bool `json:"active"`
+		Closed          bool `json:"closed"`
+		AcceptingOrders bool `json:"accepting_orders"`
+		EnableOrderBook bool `json:"enable_order_book"`
 	}
 
 	MarketToken struct {
 		TokenID string  `json:"token_id"`
 		Outcome string  `json:"outcome"`
 		Price   float64 `json:"price"`
+		Winner  bool    `json:"winner"`
+	}
+
+	// SimplifiedMarket is the lean market shape returned by the simplified and
+	// sampling-simplified endpoints.
+	SimplifiedMarket struct {
+		ConditionID     string                  `json:"condition_id"`
+		Rewards         SimplifiedMarketRewards `json:"rewards"`
+		Tokens          []MarketToken           `json:"tokens"`
+		Active          bool                    `json:"active"`
+		Closed          bool                    `json:"closed"`
+		Archived        bool                    `json:"archived"`
+		AcceptingOrders bool                    `json:"accepting_orders"`
+	}
+
+	SimplifiedMarketRewards struct {
+		Rates     []SimplifiedRewardRate `json:"rates"`
+		MinSize   float64                `json:"min_size"`
+		MaxSpread float64                `json:"max_spread"`
+	}
+
+	SimplifiedRewardRate struct {
+		AssetAddress     string  `json:"asset_address"`
+		RewardsDailyRate float64 `json:"rewards_daily_rate"`
 	}
 
 	OrderBook struct {
@@ -454,8 +592,10 @@ type (
 	}
 
 	PriceHistoryPoint struct {
-		Timestamp int64   `json:"t"`
-		Price     float64 `json:"p"`
+		Timestamp int64 `json:"t"`
+		// Price is decoded from a JSON number or string without loss of
+		// precision. Use PriceFloat for the float64 value.
+		Price types.Decimal `json:"p"`
 	}
 
 	Trade struct {
@@ -556,8 +696,34 @@ type (
 		RewardsConfig         []MarketRewardsConfig `json:"rewards_config,omitempty"`
 	}
 
+	// TradeEvent is a public fill returned by MarketTradesEvents.
 	TradeEvent struct {
-		// ...
+		EventType       string           `json:"event_type"`
+		Market          TradeEventMarket `json:"market"`
+		User            TradeEventUser   `json:"user"`
+		Side            string           `json:"side"`
+		Size            types.Decimal    `json:"size"`
+		Price           types.Decimal    `json:"price"`
+		FeeRateBps      types.Decimal    `json:"fee_rate_bps"`
+		Outcome         string           `json:"outcome"`
+		OutcomeIndex    int              `json:"outcome_index"`
+		TransactionHash string           `json:"transaction_hash"`
+		// Timestamp is in unix seconds.
+		Timestamp int64 `json:"timestamp"`
+	}
+	TradeEventMarket struct {
+		ConditionID string `json:"condition_id"`
+		AssetID     string `json:"asset_id"`
+		Question    string `json:"question"`
+		Icon        string `json:"icon,omitempty"`
+		Slug        string `json:"slug"`
+	}
+	TradeEventUser struct {
+		Address                 string `json:"address"`
+		Username                string `json:"username,omitempty"`
+		ProfilePicture          string `json:"profile_picture,omitempty"`
+		OptimizedProfilePicture string `json:"optimized_profile_picture,omitempty"`
+		Pseudonym               string `json:"pseudonym,omitempty"`
 	}
 
 	APIKeyInfo struct {
@@ -566,6 +732,92 @@ type (
 	}
 )
 
+// AllPricesResponse maps token ID to side ("BUY"/"SELL") to price.
+type AllPricesResponse map[string]map[string]types.Decimal
+
+// Price returns the price for tokenID on side. Side matching is case-insensitive.
+func (p AllPricesResponse) Price(tokenID, side string) (types.Decimal, bool) {
+	sides, ok := p[tokenID]
+	if !ok {
+		return types.Decimal{}, false
+	}
+	price, ok := sides[strings.ToUpper(side)]
+	return price, ok
+}
+
+// UnmarshalJSON accepts both the "orderID" key used by order placement and the
+// "id" key used by the /data/order(s) endpoints. Timestamps may be encoded as
+// numbers or numeric strings.
+func (r *OrderResponse) UnmarshalJSON(data []byte) error {
+	type alias OrderResponse
+	aux := struct {
+		*alias
+		DataID     string      `json:"id"`
+		Expiration json.Number `json:"expiration"`
+		CreatedAt  json.Number `json:"created_at"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if r.ID == "" {
+		r.ID = aux.DataID
+	}
+	var err error
+	if r.Expiration, err = parseUnixNumber(aux.Expiration); err != nil {
+		return fmt.Errorf("invalid expiration: %w", err)
+	}
+	if r.CreatedAt, err = parseUnixNumber(aux.CreatedAt); err != nil {
+		return fmt.Errorf("invalid created_at: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalJSON accepts the timestamp as a number or a numeric string.
+func (e *TradeEvent) UnmarshalJSON(data []byte) error {
+	type alias TradeEvent
+	aux := struct {
+		*alias
+		Timestamp json.Number `json:"timestamp"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if e.Timestamp, err = parseUnixNumber(aux.Timestamp); err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+	return nil
+}
+
+// RemainingSize returns the unfilled size of the order.
+func (r OrderResponse) RemainingSize() types.Decimal {
+	return r.OriginalSize.Sub(r.SizeMatched)
+}
+
+// OrderStatus returns Status as an OrderStatus, normalized to upper case.
+// Unknown statuses are returned as-is.
+func (r OrderResponse) OrderStatus() OrderStatus {
+	if status, err := ParseOrderStatus(r.Status); err == nil {
+		return status
+	}
+	return OrderStatus(r.Status)
+}
+
+// CreatedTime returns CreatedAt as a time.Time, or the zero time if unset.
+func (r OrderResponse) CreatedTime() time.Time {
+	if r.CreatedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(r.CreatedAt, 0)
+}
+
+func parseUnixNumber(n json.Number) (int64, error) {
+	if n == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
 // PricesHistoryResponse supports both legacy array responses and the current
 // object-wrapped form returned by the API (e.g. {"history":[...]}).
 func (p *PricesHistoryResponse) UnmarshalJSON(data []byte) error {
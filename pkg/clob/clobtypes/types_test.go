@@ -188,14 +188,14 @@ func TestPricesHistoryResponse_UnmarshalJSON_Array(t *testing.T) {
 	if resp[0].Timestamp != 1234567890 {
 		t.Errorf("Point[0].Timestamp = %d, want 1234567890", resp[0].Timestamp)
 	}
-	if resp[0].Price != 0.5 {
-		t.Errorf("Point[0].Price = %f, want 0.5", resp[0].Price)
+	if resp[0].PriceFloat() != 0.5 {
+		t.Errorf("Point[0].Price = %s, want 0.5", resp[0].Price)
 	}
 	if resp[1].Timestamp != 1234567900 {
 		t.Errorf("Point[1].Timestamp = %d, want 1234567900", resp[1].Timestamp)
 	}
-	if resp[1].Price != 0.6 {
-		t.Errorf("Point[1].Price = %f, want 0.6", resp[1].Price)
+	if resp[1].PriceFloat() != 0.6 {
+		t.Errorf("Point[1].Price = %s, want 0.6", resp[1].Price)
 	}
 }
 
@@ -220,8 +220,8 @@ func TestPricesHistoryResponse_UnmarshalJSON_HistoryWrapper(t *testing.T) {
 	if resp[0].Timestamp != 1234567890 {
 		t.Errorf("Point[0].Timestamp = %d, want 1234567890", resp[0].Timestamp)
 	}
-	if resp[0].Price != 0.5 {
-		t.Errorf("Point[0].Price = %f, want 0.5", resp[0].Price)
+	if resp[0].PriceFloat() != 0.5 {
+		t.Errorf("Point[0].Price = %s, want 0.5", resp[0].Price)
 	}
 }
 
@@ -503,6 +503,127 @@ func TestTradesResponse_JSON(t *testing.T) {
 	}
 }
 
+func TestOrderResponse_UnmarshalJSON_Detail(t *testing.T) {
+	payload := `{
+		"id": "0xabc",
+		"status": "LIVE",
+		"owner": "owner-key",
+		"maker_address": "0x1111111111111111111111111111111111111111",
+		"market": "0xmarket",
+		"asset_id": "123",
+		"outcome": "Yes",
+		"side": "BUY",
+		"order_type": "GTC",
+		"price": "0.45",
+		"original_size": "100",
+		"size_matched": "40.5",
+		"associate_trades": ["t1"],
+		"expiration": "0",
+		"created_at": 1700000000
+	}`
+	var resp OrderResponse
+	if err := json.Unmarshal([]byte(payload), &resp); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if resp.ID != "0xabc" || resp.Status != "LIVE" || resp.Outcome != "Yes" || resp.OrderType != OrderTypeGTC {
+		t.Errorf("unexpected fields: %+v", resp)
+	}
+	if resp.Price.String() != "0.45" {
+		t.Errorf("Price = %s, want 0.45", resp.Price)
+	}
+	if resp.RemainingSize().String() != "59.5" {
+		t.Errorf("RemainingSize = %s, want 59.5", resp.RemainingSize())
+	}
+	if resp.Expiration != 0 || resp.CreatedAt != 1700000000 {
+		t.Errorf("Expiration/CreatedAt = %d/%d", resp.Expiration, resp.CreatedAt)
+	}
+	if resp.CreatedTime().Unix() != 1700000000 {
+		t.Errorf("CreatedTime = %v", resp.CreatedTime())
+	}
+	if len(resp.AssociateTrades) != 1 || resp.AssociateTrades[0] != "t1" {
+		t.Errorf("AssociateTrades = %v", resp.AssociateTrades)
+	}
+}
+
+func TestOrderResponse_UnmarshalJSON_Placement(t *testing.T) {
+	payload := `{"success":true,"errorMsg":"","orderID":"0xdef","status":"matched","transactionsHashes":["0xtx"],"takingAmount":"10","makingAmount":"4.5"}`
+	var resp OrderResponse
+	if err := json.Unmarshal([]byte(payload), &resp); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if !resp.Success || resp.ID != "0xdef" || resp.Status != "matched" {
+		t.Errorf("unexpected fields: %+v", resp)
+	}
+	if len(resp.TransactionHashes) != 1 || resp.TakingAmount != "10" || resp.MakingAmount != "4.5" {
+		t.Errorf("unexpected placement fields: %+v", resp)
+	}
+	if !resp.CreatedTime().IsZero() {
+		t.Errorf("CreatedTime should be zero, got %v", resp.CreatedTime())
+	}
+
+	var bad OrderResponse
+	if err := json.Unmarshal([]byte(`{"id":"x","created_at":"soon"}`), &bad); err == nil {
+		t.Error("expected error for non-numeric created_at")
+	}
+}
+
+func TestMarketTradesEventsResponse_UnmarshalJSON(t *testing.T) {
+	payload := `[{
+		"event_type": "trade",
+		"market": {
+			"condition_id": "0xcond",
+			"asset_id": "123",
+			"question": "Will it rain?",
+			"icon": "https://example.com/icon.png",
+			"slug": "will-it-rain"
+		},
+		"user": {
+			"address": "0x1111111111111111111111111111111111111111",
+			"username": "alice",
+			"profile_picture": "",
+			"optimized_profile_picture": "",
+			"pseudonym": "Sunny-Cloud"
+		},
+		"side": "BUY",
+		"size": "25.5",
+		"fee_rate_bps": "0",
+		"price": "0.61",
+		"outcome": "Yes",
+		"outcome_index": 0,
+		"transaction_hash": "0xtx",
+		"timestamp": "1700000000"
+	}, {
+		"event_type": "trade",
+		"market": {"condition_id": "0xcond", "asset_id": "456"},
+		"user": {"address": "0x2222222222222222222222222222222222222222"},
+		"side": "SELL",
+		"size": 3,
+		"price": 0.39,
+		"outcome": "No",
+		"outcome_index": 1,
+		"timestamp": 1700000100
+	}]`
+
+	var resp MarketTradesEventsResponse
+	if err := json.Unmarshal([]byte(payload), &resp); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(resp))
+	}
+	first := resp[0]
+	if first.Market.ConditionID != "0xcond" || first.Market.Slug != "will-it-rain" || first.User.Username != "alice" {
+		t.Errorf("unexpected nested fields: %+v", first)
+	}
+	if first.Size.String() != "25.5" || first.Price.String() != "0.61" || first.Timestamp != 1700000000 {
+		t.Errorf("unexpected numeric fields: size=%s price=%s ts=%d", first.Size, first.Price, first.Timestamp)
+	}
+	second := resp[1]
+	if second.Side != "SELL" || second.OutcomeIndex != 1 || second.Price.String() != "0.39" || second.Timestamp != 1700000100 {
+		t.Errorf("unexpected second event: %+v", second)
+	}
+}
+
 func TestPricesHistoryRequest_JSON(t *testing.T) {
 	req := PricesHistoryRequest{
 		Market:     "market123",
@@ -622,3 +743,32 @@ func TestAPIKeyResponse_JSON(t *testing.T) {
 		t.Errorf("Passphrase = %s, want %s", decoded.Passphrase, resp.Passphrase)
 	}
 }
+
+func TestParseSideAndOrderStatus(t *testing.T) {
+	for _, in := range []string{"BUY", "buy", " Buy "} {
+		side, err := ParseSide(in)
+		if err != nil || side != SideBuy {
+			t.Errorf("ParseSide(%q) = %q, %v", in, side, err)
+		}
+	}
+	if _, err := ParseSide("long"); err == nil {
+		t.Error("expected error for invalid side")
+	}
+	if Side("sell").Valid() {
+		t.Error("lowercase side should not be valid without parsing")
+	}
+
+	status, err := ParseOrderStatus("live")
+	if err != nil || status != OrderStatusLive {
+		t.Errorf("ParseOrderStatus(live) = %q, %v", status, err)
+	}
+	if _, err := ParseOrderStatus("bogus"); err == nil {
+		t.Error("expected error for invalid status")
+	}
+	if got := (OrderResponse{Status: "matched"}).OrderStatus(); got != OrderStatusMatched {
+		t.Errorf("OrderStatus() = %q, want MATCHED", got)
+	}
+	if got := (OrderResponse{Status: "PENDING"}).OrderStatus(); got != "PENDING" {
+		t.Errorf("OrderStatus() = %q, want PENDING", got)
+	}
+}
@@ -1,5 +1,7 @@
 package clob
 
+import "github.com/GoPolymarket/polymarket-go-sdk/pkg/config"
+
 const (
 	// BaseURL is the default production endpoint for the CLOB.
 	BaseURL = "https://clob.polymarket.com"
@@ -7,3 +9,15 @@ const (
 	// DefaultGeoblockHost is the default host for the geoblock endpoint.
 	DefaultGeoblockHost = "https://polymarket.com"
 )
+
+// Exchange contracts that orders are signed for: the CTF exchange verifies
+// binary market orders and the neg-risk exchange neg-risk market orders.
+// They are the spenders that need collateral and CTF approvals, and the
+// verifying contracts for EIP-712 and EIP-1271 checks. The values come from
+// package config, which also holds the other contracts of each chain.
+const (
+	ExchangeAddressPolygon        = config.PolygonExchangeAddress
+	NegRiskExchangeAddressPolygon = config.PolygonNegRiskExchangeAddress
+	ExchangeAddressAmoy           = config.AmoyExchangeAddress
+	NegRiskExchangeAddressAmoy    = config.AmoyNegRiskExchangeAddress
+)
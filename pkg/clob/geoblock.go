@@ -0,0 +1,102 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+)
+
+// geoblockGuard caches the geoblock status of the caller for clients created
+// with WithGeoblockCheck(true). Failed lookups are not cached.
+type geoblockGuard struct {
+	mu      sync.Mutex
+	checked bool
+	status  clobtypes.GeoblockResponse
+}
+
+// check looks the status up on first use and returns an error wrapping
+// ErrGeoblocked when the caller is blocked.
+func (g *geoblockGuard) check(ctx context.Context, c *clientImpl) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.checked {
+		status, err := c.Geoblock(ctx)
+		if err != nil {
+			return fmt.Errorf("geoblock check: %w", err)
+		}
+		g.status, g.checked = status, true
+	}
+	if g.status.Blocked {
+		return fmt.Errorf("%w: trading is not available from %s", sdkerrors.ErrGeoblocked, geoblockLocation(g.status))
+	}
+	return nil
+}
+
+func (g *geoblockGuard) reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.checked = false
+	g.status = clobtypes.GeoblockResponse{}
+}
+
+func geoblockLocation(status clobtypes.GeoblockResponse) string {
+	switch {
+	case status.Country != "" && status.Region != "":
+		return status.Country + "/" + status.Region
+	case status.Country != "":
+		return status.Country
+	case status.IP != "":
+		return status.IP
+	default:
+		return "this location"
+	}
+}
+
+// checkGeoblock enforces WithGeoblockCheck before an order is placed.
+func (c *clientImpl) checkGeoblock(ctx context.Context) error {
+	if c.geoguard == nil {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return c.geoguard.check(ctx, c)
+}
+
+// WithGeoblockCheck enables or disables the geoblock guard on order
+// placement.
+func (c *clientImpl) WithGeoblockCheck(enabled bool) Client {
+	var guard *geoblockGuard
+	if enabled {
+		guard = &geoblockGuard{}
+	}
+	return &clientImpl{
+		httpClient:        c.httpClient,
+		signer:            c.signer,
+		creds:             c.creds,
+		builderCfg:        c.builderCfg,
+		signatureType:     c.signatureType,
+		authNonce:         c.authNonce,
+		funder:            c.funder,
+		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          guard,
+		cache:             c.cache,
+		geoblockHost:      c.geoblockHost,
+		geoblockClient:    c.geoblockClient,
+		rfq:               c.rfq,
+		ws:                c.ws,
+		heartbeat:         c.heartbeat,
+		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
+	}
+}
+
+func (c *clientImpl) InvalidateGeoblock() {
+	if c.geoguard != nil {
+		c.geoguard.reset()
+	}
+}
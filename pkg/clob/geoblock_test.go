@@ -0,0 +1,94 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+func TestWithGeoblockCheck(t *testing.T) {
+	ctx := context.Background()
+	const geoblockCall = "GET /api/geoblock"
+	countGeoblock := func(doer *statusDoer) int {
+		n := 0
+		for _, call := range doer.calls {
+			if call == geoblockCall {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("BlockedFailsBeforePosting", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			geoblockCall: {status: http.StatusOK, body: `{"blocked":true,"country":"US","region":"NY"}`},
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example")).WithGeoblockCheck(true)
+
+		_, err := client.PostOrder(ctx, &clobtypes.SignedOrder{})
+		if !errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("PostOrder error = %v, want ErrGeoblocked", err)
+		}
+		_, err = client.PostOrders(ctx, &clobtypes.SignedOrders{})
+		if !errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("PostOrders error = %v, want ErrGeoblocked", err)
+		}
+		_, err = client.CancelAndReplace(ctx, "order-1", &clobtypes.SignableOrder{Order: &clobtypes.Order{}})
+		if !errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("CancelAndReplace error = %v, want ErrGeoblocked", err)
+		}
+		if len(doer.calls) != 1 || doer.calls[0] != geoblockCall {
+			t.Fatalf("expected a single geoblock lookup, got %v", doer.calls)
+		}
+	})
+
+	t.Run("InvalidateRechecks", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			geoblockCall: {status: http.StatusOK, body: `{"blocked":true,"country":"US"}`},
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example")).WithGeoblockCheck(true)
+		if _, err := client.PostOrder(ctx, &clobtypes.SignedOrder{}); !errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("PostOrder error = %v, want ErrGeoblocked", err)
+		}
+
+		doer.responses[geoblockCall] = statusResponse{status: http.StatusOK, body: `{"blocked":false}`}
+		client.InvalidateGeoblock()
+		if _, err := client.PostOrder(ctx, &clobtypes.SignedOrder{}); errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("PostOrder still geoblocked after InvalidateGeoblock: %v", err)
+		}
+		if got := countGeoblock(doer); got != 2 {
+			t.Fatalf("expected 2 geoblock lookups, got %d", got)
+		}
+	})
+
+	t.Run("LookupFailureIsNotCached", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			geoblockCall: {status: http.StatusBadRequest, body: `{"error":"bad request"}`},
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example")).WithGeoblockCheck(true)
+		if _, err := client.PostOrder(ctx, &clobtypes.SignedOrder{}); err == nil || errors.Is(err, sdkerrors.ErrGeoblocked) {
+			t.Fatalf("PostOrder error = %v, want lookup failure", err)
+		}
+		if _, err := client.PostOrder(ctx, &clobtypes.SignedOrder{}); err == nil {
+			t.Fatal("expected second PostOrder to fail")
+		}
+		if got := countGeoblock(doer); got != 2 {
+			t.Fatalf("expected failed lookup to be retried, got %d lookups", got)
+		}
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		doer := &statusDoer{}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		_, _ = client.PostOrder(ctx, &clobtypes.SignedOrder{})
+		client.InvalidateGeoblock()
+		if got := countGeoblock(doer); got != 0 {
+			t.Fatalf("expected no geoblock lookup, got %d", got)
+		}
+	})
+}
@@ -2,12 +2,24 @@ package heartbeat
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
 
+// DefaultKeepaliveInterval is used by Keepalive when interval is not positive.
+const DefaultKeepaliveInterval = 5 * time.Second
+
 type Client interface {
 	Heartbeat(ctx context.Context, req *HeartbeatRequest) (HeartbeatResponse, error)
+	// Keepalive sends a heartbeat immediately and then every interval until
+	// ctx is done or stop is called, passing the heartbeat_id returned by the
+	// server into the next request. Errors are delivered on the returned
+	// channel, which is closed when the loop exits; errors are dropped while
+	// the channel is full. stop waits for the loop to exit and is safe to call
+	// more than once.
+	Keepalive(ctx context.Context, interval time.Duration, heartbeatID string) (<-chan error, func())
 }
 
 type clientImpl struct {
@@ -29,3 +41,51 @@ func (c *clientImpl) Heartbeat(ctx context.Context, req *HeartbeatRequest) (Hear
 	err := c.httpClient.Post(ctx, "/v1/heartbeats", body, &resp)
 	return resp, err
 }
+
+func (c *clientImpl) Keepalive(ctx context.Context, interval time.Duration, heartbeatID string) (<-chan error, func()) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if interval <= 0 {
+		interval = DefaultKeepaliveInterval
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	errs := make(chan error, 8)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer close(errs)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		id := heartbeatID
+		for {
+			resp, err := c.Heartbeat(ctx, &HeartbeatRequest{HeartbeatID: id})
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case errs <- err:
+				default:
+				}
+			} else if resp.HeartbeatID != "" {
+				id = resp.HeartbeatID
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+	return errs, stop
+}
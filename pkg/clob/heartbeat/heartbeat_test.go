@@ -3,9 +3,13 @@ package heartbeat
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
@@ -33,3 +37,78 @@ func TestHeartbeat(t *testing.T) {
 		t.Errorf("Heartbeat failed: %v", err)
 	}
 }
+
+type sessionDoer struct {
+	mu    sync.Mutex
+	ids   []string
+	fail  map[int]bool
+	calls int
+}
+
+func (d *sessionDoer) Do(req *http.Request) (*http.Response, error) {
+	var body struct {
+		HeartbeatID *string `json:"heartbeat_id"`
+	}
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls++
+	id := ""
+	if body.HeartbeatID != nil {
+		id = *body.HeartbeatID
+	}
+	d.ids = append(d.ids, id)
+	status, payload := http.StatusOK, fmt.Sprintf(`{"status":"OK","heartbeat_id":"hb-%d"}`, d.calls)
+	if d.fail[d.calls] {
+		status, payload = http.StatusBadRequest, `{"error":"invalid heartbeat id"}`
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewBufferString(payload)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestKeepalive(t *testing.T) {
+	doer := &sessionDoer{fail: map[int]bool{3: true}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	errs, stop := client.Keepalive(context.Background(), 5*time.Millisecond, "start")
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("expected a heartbeat error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for heartbeat error")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		doer.mu.Lock()
+		calls := doer.calls
+		doer.mu.Unlock()
+		if calls >= 5 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("only %d heartbeats sent", calls)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	stop()
+	stop()
+	if _, ok := <-errs; ok {
+		t.Error("expected error channel to be closed after stop")
+	}
+
+	doer.mu.Lock()
+	defer doer.mu.Unlock()
+	// The failed third heartbeat keeps the previous id for the next attempt.
+	want := []string{"start", "hb-1", "hb-2", "hb-2", "hb-4"}
+	for i, w := range want {
+		if doer.ids[i] != w {
+			t.Errorf("heartbeat %d sent id %q, want %q", i+1, doer.ids[i], w)
+		}
+	}
+}
@@ -6,4 +6,6 @@ type HeartbeatRequest struct {
 
 type HeartbeatResponse struct {
 	Status string `json:"status"`
+	// HeartbeatID identifies the session; send it with the next heartbeat.
+	HeartbeatID string `json:"heartbeat_id,omitempty"`
 }
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -21,12 +23,14 @@ import (
 type clientImpl struct {
 	httpClient     *transport.Client
 	signer         auth.Signer
-	apiKey         *auth.APIKey
+	creds          *apiCredentials // shared with With* clones and order builders
 	builderCfg     *auth.BuilderConfig
 	signatureType  auth.SignatureType
 	authNonce      *int64
 	funder         *types.Address
 	saltGenerator  SaltGenerator
+	nonces         *NonceManager
+	geoguard       *geoblockGuard // nil unless WithGeoblockCheck(true)
 	cache          *clientCache
 	geoblockHost   string
 	geoblockClient *transport.Client
@@ -35,6 +39,7 @@ type clientImpl struct {
 	heartbeat      heartbeat.Client
 
 	heartbeatInterval time.Duration
+	limitPolicy       LimitPolicy
 	heartbeatStop     chan struct{}
 	heartbeatMu       sync.Mutex
 }
@@ -46,10 +51,39 @@ type clientCache struct {
 	negRisk   map[string]bool
 }
 
+// apiCredentials holds the API key of a client. It is shared by the clients
+// derived with With* methods and by their order builders, so that
+// UpdateCredentials rotates the key used as order owner everywhere at once.
+type apiCredentials struct {
+	mu  sync.RWMutex
+	key *auth.APIKey
+}
+
+func newAPICredentials(apiKey *auth.APIKey) *apiCredentials {
+	return &apiCredentials{key: apiKey}
+}
+
+func (c *apiCredentials) get() *auth.APIKey {
+	if c == nil {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.key
+}
+
+func (c *apiCredentials) set(apiKey *auth.APIKey) {
+	c.mu.Lock()
+	c.key = apiKey
+	c.mu.Unlock()
+}
+
 type orderDefaults struct {
+	creds         *apiCredentials
 	signatureType auth.SignatureType
 	funder        *types.Address
 	saltGenerator SaltGenerator
+	nonces        *NonceManager
 }
 
 func newClientCache() *clientCache {
@@ -77,6 +111,7 @@ func NewClientWithGeoblock(httpClient *transport.Client, geoblockHost string) Cl
 
 	c := &clientImpl{
 		httpClient:     httpClient,
+		creds:          newAPICredentials(nil),
 		cache:          newClientCache(),
 		geoblockHost:   geoblockHost,
 		geoblockClient: nil,
@@ -114,12 +149,14 @@ func (c *clientImpl) WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client {
 	newC := &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            signer,
-		apiKey:            apiKey,
+		creds:             newAPICredentials(apiKey),
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -127,11 +164,55 @@ func (c *clientImpl) WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 	newC.startHeartbeats()
 	return newC
 }
 
+// UpdateCredentials swaps the API credentials used by this client, its
+// transport and its WebSocket client in place. Requests signed after it
+// returns use apiKey; the WebSocket user channel is re-authenticated.
+// Clients derived with a With* method, and the order builders of all of
+// them, share the credentials and the transport, so they pick up the new key
+// too, both for request signing and as the owner of new orders.
+func (c *clientImpl) UpdateCredentials(apiKey *auth.APIKey) error {
+	if apiKey == nil || apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
+		return errors.New("apiKey with key, secret and passphrase is required")
+	}
+	if err := auth.ValidateSecret(apiKey.Secret); err != nil {
+		return err
+	}
+	c.setAPIKey(apiKey)
+	if c.ws != nil {
+		if err := c.ws.UpdateAuth(apiKey); err != nil {
+			return fmt.Errorf("re-authenticate websocket: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) AuthError() error {
+	if c.httpClient == nil {
+		return nil
+	}
+	return c.httpClient.AuthError()
+}
+
+func (c *clientImpl) currentAPIKey() *auth.APIKey {
+	return c.creds.get()
+}
+
+func (c *clientImpl) setAPIKey(apiKey *auth.APIKey) {
+	if c.creds == nil {
+		c.creds = newAPICredentials(nil)
+	}
+	c.creds.set(apiKey)
+	if c.httpClient != nil {
+		c.httpClient.SetAuth(c.signer, apiKey)
+	}
+}
+
 // WithBuilderConfig sets the builder attribution config.
 func (c *clientImpl) WithBuilderConfig(config *auth.BuilderConfig) Client {
 	// If config is nil, we might want to disable it or revert to default.
@@ -143,12 +224,14 @@ func (c *clientImpl) WithBuilderConfig(config *auth.BuilderConfig) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        config,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -156,6 +239,7 @@ func (c *clientImpl) WithBuilderConfig(config *auth.BuilderConfig) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -172,12 +256,14 @@ func (c *clientImpl) PromoteToBuilder(config *auth.BuilderConfig) Client {
 	newC := &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        config,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -185,6 +271,7 @@ func (c *clientImpl) PromoteToBuilder(config *auth.BuilderConfig) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 	newC.startHeartbeats()
 	return newC
@@ -195,12 +282,14 @@ func (c *clientImpl) WithSignatureType(sigType auth.SignatureType) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     sigType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -208,6 +297,7 @@ func (c *clientImpl) WithSignatureType(sigType auth.SignatureType) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -216,12 +306,14 @@ func (c *clientImpl) WithAuthNonce(nonce int64) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         &nonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -229,6 +321,7 @@ func (c *clientImpl) WithAuthNonce(nonce int64) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -237,12 +330,14 @@ func (c *clientImpl) WithFunder(funder types.Address) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            &funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -250,6 +345,7 @@ func (c *clientImpl) WithFunder(funder types.Address) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -258,12 +354,14 @@ func (c *clientImpl) WithSaltGenerator(gen SaltGenerator) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     gen,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -271,6 +369,7 @@ func (c *clientImpl) WithSaltGenerator(gen SaltGenerator) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -287,12 +386,13 @@ func (c *clientImpl) WithGeoblockHost(host string) Client {
 	newC := &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
 		cache:             c.cache,
 		geoblockHost:      host,
 		geoblockClient:    nil,
@@ -300,10 +400,15 @@ func (c *clientImpl) WithGeoblockHost(host string) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 	if c.httpClient != nil {
 		newC.geoblockClient = c.httpClient.CloneWithBaseURL(host)
 	}
+	if c.geoguard != nil {
+		// A status cached from the previous host no longer applies.
+		newC.geoguard = &geoblockGuard{}
+	}
 	return newC
 }
 
@@ -312,12 +417,14 @@ func (c *clientImpl) WithWS(ws ws.Client) Client {
 	return &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -325,6 +432,7 @@ func (c *clientImpl) WithWS(ws ws.Client) Client {
 		ws:                ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
 	}
 }
 
@@ -332,12 +440,14 @@ func (c *clientImpl) WithHeartbeatInterval(interval time.Duration) Client {
 	newC := &clientImpl{
 		httpClient:        c.httpClient,
 		signer:            c.signer,
-		apiKey:            c.apiKey,
+		creds:             c.creds,
 		builderCfg:        c.builderCfg,
 		signatureType:     c.signatureType,
 		authNonce:         c.authNonce,
 		funder:            c.funder,
 		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
 		cache:             c.cache,
 		geoblockHost:      c.geoblockHost,
 		geoblockClient:    c.geoblockClient,
@@ -345,16 +455,43 @@ func (c *clientImpl) WithHeartbeatInterval(interval time.Duration) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: interval,
+		limitPolicy:       c.limitPolicy,
 	}
 	newC.startHeartbeats()
 	return newC
 }
 
+// WithLimitPolicy sets how request limits above the endpoint maximum are handled.
+func (c *clientImpl) WithLimitPolicy(policy LimitPolicy) Client {
+	return &clientImpl{
+		httpClient:        c.httpClient,
+		signer:            c.signer,
+		creds:             c.creds,
+		builderCfg:        c.builderCfg,
+		signatureType:     c.signatureType,
+		authNonce:         c.authNonce,
+		funder:            c.funder,
+		saltGenerator:     c.saltGenerator,
+		nonces:            c.nonces,
+		geoguard:          c.geoguard,
+		cache:             c.cache,
+		geoblockHost:      c.geoblockHost,
+		geoblockClient:    c.geoblockClient,
+		rfq:               c.rfq,
+		ws:                c.ws,
+		heartbeat:         c.heartbeat,
+		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       policy,
+	}
+}
+
 func (c *clientImpl) orderDefaults() orderDefaults {
 	return orderDefaults{
+		creds:         c.creds,
 		signatureType: c.signatureType,
 		funder:        c.funder,
 		saltGenerator: c.saltGenerator,
+		nonces:        c.nonces,
 	}
 }
 
@@ -373,7 +510,7 @@ func (c *clientImpl) startHeartbeats() {
 	if c.heartbeatInterval <= 0 {
 		return
 	}
-	if c.httpClient == nil || c.signer == nil || c.apiKey == nil || c.heartbeat == nil {
+	if c.httpClient == nil || c.signer == nil || c.currentAPIKey() == nil || c.heartbeat == nil {
 		return
 	}
 
@@ -477,6 +614,15 @@ func (c *clientImpl) InvalidateCaches() {
 	c.cache.mu.Unlock()
 }
 
+func (c *clientImpl) InvalidateTickSize(tokenID string) {
+	if c.cache == nil || tokenID == "" {
+		return
+	}
+	c.cache.mu.Lock()
+	delete(c.cache.tickSizes, tokenID)
+	c.cache.mu.Unlock()
+}
+
 func (c *clientImpl) SetTickSize(tokenID string, tickSize float64) {
 	if c.cache == nil || tokenID == "" {
 		return
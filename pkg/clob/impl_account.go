@@ -2,35 +2,27 @@ package clob
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
 )
 
+// rewardsDateLayout is the date format expected by the rewards endpoints.
+const rewardsDateLayout = "2006-01-02"
+
 func (c *clientImpl) BalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceRequest) (clobtypes.BalanceAllowanceResponse, error) {
-	q := url.Values{}
+	var q url.Values
 	if req != nil {
-		if req.Asset != "" {
-			q.Set("asset", req.Asset)
-		}
-		if req.AssetType != "" {
-			q.Set("asset_type", string(req.AssetType))
-		}
-		if req.TokenID != "" {
-			q.Set("token_id", req.TokenID)
-		}
-		sigType := req.SignatureType
-		if sigType == nil {
-			val := int(c.signatureType)
-			sigType = &val
-		}
-		if sigType != nil {
-			q.Set("signature_type", strconv.Itoa(*sigType))
-		}
+		q = c.balanceAllowanceQuery(req.Asset, req.AssetType, req.TokenID, req.SignatureType)
+	} else {
+		q = c.balanceAllowanceQuery("", "", "", nil)
 	}
 	var resp clobtypes.BalanceAllowanceResponse
 	err := c.httpClient.Get(ctx, "/balance-allowance", q, &resp)
@@ -38,38 +30,57 @@ func (c *clientImpl) BalanceAllowance(ctx context.Context, req *clobtypes.Balanc
 }
 
 func (c *clientImpl) UpdateBalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceUpdateRequest) (clobtypes.BalanceAllowanceResponse, error) {
-	q := url.Values{}
+	var q url.Values
 	if req != nil {
-		if req.Asset != "" {
-			q.Set("asset", req.Asset)
-		}
-		if req.AssetType != "" {
-			q.Set("asset_type", string(req.AssetType))
-		}
-		if req.TokenID != "" {
-			q.Set("token_id", req.TokenID)
-		}
-		sigType := req.SignatureType
-		if sigType == nil {
-			val := int(c.signatureType)
-			sigType = &val
-		}
-		if sigType != nil {
-			q.Set("signature_type", strconv.Itoa(*sigType))
-		}
+		q = c.balanceAllowanceQuery(req.Asset, req.AssetType, req.TokenID, req.SignatureType)
 		if req.Amount != "" {
 			q.Set("amount", req.Amount)
 		}
+	} else {
+		q = c.balanceAllowanceQuery("", "", "", nil)
 	}
 	var resp clobtypes.BalanceAllowanceResponse
 	err := c.httpClient.Call(ctx, "GET", "/balance-allowance/update", q, nil, &resp, nil)
 	return resp, mapError(err)
 }
 
+// requestSignatureType resolves the signature_type sent with account
+// requests: an explicit request field wins over the client's
+// WithSignatureType default.
+func (c *clientImpl) requestSignatureType(sigType *int) int {
+	if sigType != nil {
+		return *sigType
+	}
+	return int(c.signatureType)
+}
+
+// balanceAllowanceQuery builds the shared balance/allowance query. The client's
+// default signature type is used when sigType is nil.
+func (c *clientImpl) balanceAllowanceQuery(asset string, assetType clobtypes.AssetType, tokenID string, sigType *int) url.Values {
+	q := url.Values{}
+	if asset != "" {
+		q.Set("asset", asset)
+	}
+	if assetType != "" {
+		q.Set("asset_type", string(assetType))
+	}
+	if tokenID != "" {
+		q.Set("token_id", tokenID)
+	}
+	q.Set("signature_type", strconv.Itoa(c.requestSignatureType(sigType)))
+	return q
+}
+
 func (c *clientImpl) Notifications(ctx context.Context, req *clobtypes.NotificationsRequest) (clobtypes.NotificationsResponse, error) {
 	q := url.Values{}
-	if req != nil && req.Limit > 0 {
-		q.Set("limit", strconv.Itoa(req.Limit))
+	if req != nil {
+		limit, err := c.checkLimit("notifications", req.Limit, MaxNotificationsLimit)
+		if err != nil {
+			return clobtypes.NotificationsResponse{}, err
+		}
+		if limit > 0 {
+			q.Set("limit", strconv.Itoa(limit))
+		}
 	}
 	var resp clobtypes.NotificationsResponse
 	err := c.httpClient.Get(ctx, "/notifications", q, &resp)
@@ -79,9 +90,14 @@ func (c *clientImpl) Notifications(ctx context.Context, req *clobtypes.Notificat
 func (c *clientImpl) DropNotifications(ctx context.Context, req *clobtypes.DropNotificationsRequest) (clobtypes.DropNotificationsResponse, error) {
 	q := url.Values{}
 	if req != nil {
-		ids := req.IDs
+		ids := make([]string, 0, len(req.IDs))
+		for _, id := range req.IDs {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
 		if len(ids) > 0 {
-			q.Set("id", strings.Join(ids, ","))
+			q.Set("ids", strings.Join(ids, ","))
 		}
 	}
 	var resp clobtypes.DropNotificationsResponse
@@ -95,54 +111,54 @@ func (c *clientImpl) DropNotifications(ctx context.Context, req *clobtypes.DropN
 }
 
 func (c *clientImpl) UserEarnings(ctx context.Context, req *clobtypes.UserEarningsRequest) (clobtypes.UserEarningsResponse, error) {
-	q := url.Values{}
-	if req != nil {
-		if req.Date != "" {
-			q.Set("date", req.Date)
-		}
-		sigType := req.SignatureType
-		if sigType == nil {
-			val := int(c.signatureType)
-			sigType = &val
-		}
-		if sigType != nil {
-			q.Set("signature_type", strconv.Itoa(*sigType))
-		}
-		if req.NextCursor != "" {
-			q.Set("next_cursor", req.NextCursor)
-		}
-		if req.Asset != "" {
-			q.Set("asset", req.Asset)
-		}
+	if req == nil {
+		req = &clobtypes.UserEarningsRequest{}
+	}
+	q, err := c.earningsQuery(req.Date, req.SignatureType)
+	if err != nil {
+		return clobtypes.UserEarningsResponse{}, err
+	}
+	if req.NextCursor != "" {
+		q.Set("next_cursor", req.NextCursor)
+	}
+	if req.Asset != "" {
+		q.Set("asset", req.Asset)
 	}
 	var resp clobtypes.UserEarningsResponse
-	err := c.httpClient.Get(ctx, "/rewards/user", q, &resp)
+	err = c.httpClient.Get(ctx, "/rewards/user", q, &resp)
 	return resp, mapError(err)
 }
 
 func (c *clientImpl) UserTotalEarnings(ctx context.Context, req *clobtypes.UserTotalEarningsRequest) (clobtypes.UserTotalEarningsResponse, error) {
-	q := url.Values{}
-	if req != nil {
-		if req.Date != "" {
-			q.Set("date", req.Date)
-		}
-		sigType := req.SignatureType
-		if sigType == nil {
-			val := int(c.signatureType)
-			sigType = &val
-		}
-		if sigType != nil {
-			q.Set("signature_type", strconv.Itoa(*sigType))
-		}
-		if req.Asset != "" {
-			q.Set("asset", req.Asset)
-		}
+	if req == nil {
+		req = &clobtypes.UserTotalEarningsRequest{}
+	}
+	q, err := c.earningsQuery(req.Date, req.SignatureType)
+	if err != nil {
+		return nil, err
+	}
+	if req.Asset != "" {
+		q.Set("asset", req.Asset)
 	}
 	var resp clobtypes.UserTotalEarningsResponse
-	err := c.httpClient.Get(ctx, "/rewards/user/total", q, &resp)
+	err = c.httpClient.Get(ctx, "/rewards/user/total", q, &resp)
 	return resp, mapError(err)
 }
 
+// earningsQuery builds the date and signature_type parameters shared by the
+// rewards earnings endpoints. The date must be formatted as YYYY-MM-DD.
+func (c *clientImpl) earningsQuery(date string, sigType *int) (url.Values, error) {
+	q := url.Values{}
+	if date != "" {
+		if _, err := time.Parse(rewardsDateLayout, date); err != nil {
+			return nil, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", date)
+		}
+		q.Set("date", date)
+	}
+	q.Set("signature_type", strconv.Itoa(c.requestSignatureType(sigType)))
+	return q, nil
+}
+
 func (c *clientImpl) UserRewardPercentages(ctx context.Context, req *clobtypes.UserRewardPercentagesRequest) (clobtypes.UserRewardPercentagesResponse, error) {
 	var resp clobtypes.UserRewardPercentagesResponse
 	err := c.httpClient.Get(ctx, "/rewards/user/percentages", nil, &resp)
@@ -177,32 +193,25 @@ func (c *clientImpl) RewardsMarkets(ctx context.Context, req *clobtypes.RewardsM
 }
 
 func (c *clientImpl) UserRewardsByMarket(ctx context.Context, req *clobtypes.UserRewardsByMarketRequest) (clobtypes.UserRewardsByMarketResponse, error) {
-	q := url.Values{}
-	if req != nil {
-		if req.Date != "" {
-			q.Set("date", req.Date)
-		}
-		if req.OrderBy != "" {
-			q.Set("order_by", req.OrderBy)
-		}
-		if req.Position != "" {
-			q.Set("position", req.Position)
-		}
-		q.Set("no_competition", strconv.FormatBool(req.NoCompetition))
-		sigType := req.SignatureType
-		if sigType == nil {
-			val := int(c.signatureType)
-			sigType = &val
-		}
-		if sigType != nil {
-			q.Set("signature_type", strconv.Itoa(*sigType))
-		}
-		if req.NextCursor != "" {
-			q.Set("next_cursor", req.NextCursor)
-		}
+	if req == nil {
+		req = &clobtypes.UserRewardsByMarketRequest{}
+	}
+	q, err := c.earningsQuery(req.Date, req.SignatureType)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderBy != "" {
+		q.Set("order_by", req.OrderBy)
+	}
+	if req.Position != "" {
+		q.Set("position", req.Position)
+	}
+	q.Set("no_competition", strconv.FormatBool(req.NoCompetition))
+	if req.NextCursor != "" {
+		q.Set("next_cursor", req.NextCursor)
 	}
 	var resp clobtypes.UserRewardsByMarketResponse
-	err := c.httpClient.Get(ctx, "/rewards/user/by-market", q, &resp)
+	err = c.httpClient.Get(ctx, "/rewards/user/by-market", q, &resp)
 	return resp, mapError(err)
 }
 
@@ -298,6 +307,48 @@ func (c *clientImpl) CreateOrDeriveAPIKeyWithNonce(ctx context.Context, nonce in
 	return c.DeriveAPIKeyWithNonce(ctx, nonce)
 }
 
+// EnsureAPIKey makes sure the client has working L2 credentials. Existing credentials
+// are checked against the API; when they are missing or rejected, the key is derived
+// (falling back to create) via the L1 signer and installed on this client.
+func (c *clientImpl) EnsureAPIKey(ctx context.Context) (*auth.APIKey, error) {
+	if c.signer == nil {
+		return nil, auth.ErrMissingSigner
+	}
+	if current := c.currentAPIKey(); current != nil && current.Key != "" && current.Secret != "" && current.Passphrase != "" {
+		_, err := c.ListAPIKeys(ctx)
+		if err == nil {
+			return current, nil
+		}
+		if !errors.Is(err, sdkerrors.ErrUnauthorized) {
+			return nil, err
+		}
+	}
+
+	nonce := int64(0)
+	if c.authNonce != nil {
+		nonce = *c.authNonce
+	}
+	resp, deriveErr := c.DeriveAPIKeyWithNonce(ctx, nonce)
+	if deriveErr != nil || resp.APIKey == "" {
+		var createErr error
+		resp, createErr = c.CreateAPIKeyWithNonce(ctx, nonce)
+		if createErr != nil {
+			if deriveErr == nil {
+				deriveErr = fmt.Errorf("empty api key")
+			}
+			return nil, fmt.Errorf("derive api key: %v; create api key: %w", deriveErr, createErr)
+		}
+	}
+
+	apiKey := &auth.APIKey{
+		Key:        resp.APIKey,
+		Secret:     resp.Secret,
+		Passphrase: resp.Passphrase,
+	}
+	c.setAPIKey(apiKey)
+	return apiKey, nil
+}
+
 func (c *clientImpl) ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error) {
 	var resp clobtypes.ClosedOnlyResponse
 	err := c.httpClient.Get(ctx, "/auth/ban-status/closed-only", nil, &resp)
@@ -7,9 +7,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport/transporttest"
 )
 
 type headerCaptureDoer struct {
@@ -58,6 +61,46 @@ func TestAccountMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("BalanceAllowanceLegacyAsset", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{"/balance-allowance?asset=USDC&signature_type=2": `{"balance":"10","allowances":{}}`},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		sigType := 2
+		resp, err := client.BalanceAllowance(ctx, &clobtypes.BalanceAllowanceRequest{Asset: "USDC", SignatureType: &sigType})
+		if err != nil || resp.Balance != "10" {
+			t.Errorf("BalanceAllowance legacy asset failed: %v", err)
+		}
+	})
+
+	t.Run("BalanceAllowanceNilRequest", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{"/balance-allowance?signature_type=2": `{"balance":"5","allowances":{}}`},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example"), signatureType: auth.SignatureGnosisSafe}
+		resp, err := client.BalanceAllowance(ctx, nil)
+		if err != nil || resp.Balance != "5" {
+			t.Errorf("BalanceAllowance nil request failed: %v", err)
+		}
+	})
+
+	t.Run("UpdateBalanceAllowanceConditional", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{"/balance-allowance/update?amount=5&asset_type=CONDITIONAL&signature_type=1&token_id=123": `{"balance":"5","allowances":{}}`},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		sigType := 1
+		resp, err := client.UpdateBalanceAllowance(ctx, &clobtypes.BalanceAllowanceUpdateRequest{
+			AssetType:     clobtypes.AssetTypeConditional,
+			TokenID:       "123",
+			SignatureType: &sigType,
+			Amount:        "5",
+		})
+		if err != nil || resp.Balance != "5" {
+			t.Errorf("UpdateBalanceAllowance conditional failed: %v", err)
+		}
+	})
+
 	t.Run("BalanceAllowanceDefaultSignature", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{"/balance-allowance?asset_type=CONDITIONAL&signature_type=1&token_id=999": `{"balance":"75","allowances":{"0xaaa":"75"}}`},
@@ -84,6 +127,17 @@ func TestAccountMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("DropNotifications", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{"/notifications?ids=n1%2Cn2%2Cn3": `{}`},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		_, err := client.DropNotifications(ctx, &clobtypes.DropNotificationsRequest{IDs: []string{"n1", " n2", "", "n3"}})
+		if err != nil {
+			t.Errorf("DropNotifications failed: %v", err)
+		}
+	})
+
 	t.Run("UserEarnings", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{
@@ -110,6 +164,40 @@ func TestAccountMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("UserEarningsCursor", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{
+				"/rewards/user?date=2025-01-02&next_cursor=MTA%3D&signature_type=2": `{"data":[],"next_cursor":"LTE="}`,
+			},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		sigType := 2
+		resp, err := client.UserEarnings(ctx, &clobtypes.UserEarningsRequest{Date: "2025-01-02", NextCursor: "MTA=", SignatureType: &sigType})
+		if err != nil || resp.NextCursor != "LTE=" {
+			t.Errorf("UserEarnings cursor failed: %v", err)
+		}
+	})
+
+	t.Run("UserEarningsInvalidDate", func(t *testing.T) {
+		client := &clientImpl{httpClient: transport.NewClient(&staticDoer{}, "http://example")}
+		if _, err := client.UserEarnings(ctx, &clobtypes.UserEarningsRequest{Date: "01/02/2025"}); err == nil {
+			t.Errorf("expected invalid date error")
+		}
+	})
+
+	t.Run("UserTotalEarnings", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{
+				"/rewards/user/total?date=2025-01-01&signature_type=1": `[{"date":"2025-01-01","asset_address":"a1","maker_address":"m1","earnings":"12","asset_rate":"1"}]`,
+			},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example"), signatureType: auth.SignatureProxy}
+		resp, err := client.UserTotalEarnings(ctx, &clobtypes.UserTotalEarningsRequest{Date: "2025-01-01"})
+		if err != nil || len(resp) != 1 {
+			t.Errorf("UserTotalEarnings failed: %v", err)
+		}
+	})
+
 	t.Run("UserRewardsByMarket", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{
@@ -126,6 +214,34 @@ func TestAccountMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("UserRewardsByMarketAllParams", func(t *testing.T) {
+		doer := &staticDoer{
+			responses: map[string]string{
+				"/rewards/user/by-market?date=2025-02-03&next_cursor=MTA%3D&no_competition=true&order_by=earnings&position=DESC&signature_type=2": `[{"condition_id":"c2"}]`,
+			},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		sigType := 2
+		resp, err := client.UserRewardsByMarket(ctx, &clobtypes.UserRewardsByMarketRequest{
+			Date:          "2025-02-03",
+			OrderBy:       "earnings",
+			Position:      "DESC",
+			NoCompetition: true,
+			SignatureType: &sigType,
+			NextCursor:    "MTA=",
+		})
+		if err != nil || len(resp) != 1 || resp[0].ConditionID != "c2" {
+			t.Errorf("UserRewardsByMarket all params failed: %v", err)
+		}
+	})
+
+	t.Run("UserRewardsByMarketInvalidDate", func(t *testing.T) {
+		client := &clientImpl{httpClient: transport.NewClient(&staticDoer{}, "http://example")}
+		if _, err := client.UserRewardsByMarket(ctx, &clobtypes.UserRewardsByMarketRequest{Date: "2025-2-3"}); err == nil {
+			t.Errorf("expected invalid date error")
+		}
+	})
+
 	t.Run("UpdateBalanceAllowanceEmptyBody", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{"/balance-allowance/update?asset=USDC&signature_type=0": `{"balance":"0","allowances":{}}`},
@@ -215,3 +331,141 @@ func TestAccountMethods(t *testing.T) {
 		}
 	})
 }
+
+type statusResponse struct {
+	status int
+	body   string
+}
+
+type statusDoer struct {
+	responses map[string]statusResponse
+	calls     []string
+}
+
+func (d *statusDoer) Do(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.Path
+	d.calls = append(d.calls, key)
+	res, ok := d.responses[key]
+	if !ok {
+		res = statusResponse{status: http.StatusNotFound, body: `{"error":"not found"}`}
+	}
+	return &http.Response{
+		StatusCode: res.status,
+		Body:       io.NopCloser(strings.NewReader(res.body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestAccountSignatureTypeDefault(t *testing.T) {
+	srv := transporttest.NewServer(t).
+		Handle("GET", "/balance-allowance", http.StatusOK, `{"balance":"1"}`).
+		Handle("GET", "/balance-allowance/update", http.StatusOK, `{"balance":"1"}`).
+		Handle("GET", "/rewards/user", http.StatusOK, `{"data":[],"next_cursor":"LTE="}`).
+		Handle("GET", "/rewards/user/total", http.StatusOK, `[]`).
+		Handle("GET", "/rewards/user/by-market", http.StatusOK, `[]`)
+	client := NewClient(srv.Client()).
+		WithSignatureType(auth.SignatureGnosisSafe).
+		WithFunder(common.HexToAddress("0x1111111111111111111111111111111111111111"))
+	ctx := context.Background()
+
+	calls := map[string]func(sigType *int) error{
+		"/balance-allowance": func(sigType *int) error {
+			_, err := client.BalanceAllowance(ctx, &clobtypes.BalanceAllowanceRequest{AssetType: clobtypes.AssetTypeCollateral, SignatureType: sigType})
+			return err
+		},
+		"/balance-allowance/update": func(sigType *int) error {
+			_, err := client.UpdateBalanceAllowance(ctx, &clobtypes.BalanceAllowanceUpdateRequest{AssetType: clobtypes.AssetTypeCollateral, SignatureType: sigType})
+			return err
+		},
+		"/rewards/user": func(sigType *int) error {
+			_, err := client.UserEarnings(ctx, &clobtypes.UserEarningsRequest{Date: "2025-01-01", SignatureType: sigType})
+			return err
+		},
+		"/rewards/user/total": func(sigType *int) error {
+			_, err := client.UserTotalEarnings(ctx, &clobtypes.UserTotalEarningsRequest{Date: "2025-01-01", SignatureType: sigType})
+			return err
+		},
+		"/rewards/user/by-market": func(sigType *int) error {
+			_, err := client.UserRewardsByMarket(ctx, &clobtypes.UserRewardsByMarketRequest{Date: "2025-01-01", SignatureType: sigType})
+			return err
+		},
+	}
+	eoa := 0
+	for path, call := range calls {
+		if err := call(nil); err != nil {
+			t.Fatalf("%s failed: %v", path, err)
+		}
+		if req, _ := srv.LastRequest("GET", path); req.Query.Get("signature_type") != "2" {
+			t.Errorf("%s signature_type = %q, want client default 2", path, req.Query.Get("signature_type"))
+		}
+		if err := call(&eoa); err != nil {
+			t.Fatalf("%s failed: %v", path, err)
+		}
+		if req, _ := srv.LastRequest("GET", path); req.Query.Get("signature_type") != "0" {
+			t.Errorf("%s signature_type = %q, want explicit 0", path, req.Query.Get("signature_type"))
+		}
+	}
+}
+
+func TestEnsureAPIKey(t *testing.T) {
+	ctx := context.Background()
+	signer := mustSigner(t)
+	secret := "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0LXNlY3JldA=="
+
+	t.Run("CreateWhenDeriveFails", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			"GET /auth/derive-api-key": {status: http.StatusBadRequest, body: `{"error":"no key"}`},
+			"POST /auth/api-key":       {status: http.StatusOK, body: `{"apiKey":"created","secret":"` + secret + `","passphrase":"p"}`},
+		}}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example"), signer: signer}
+		key, err := client.EnsureAPIKey(ctx)
+		if err != nil {
+			t.Fatalf("EnsureAPIKey failed: %v", err)
+		}
+		if key.Key != "created" || client.currentAPIKey() != key {
+			t.Errorf("expected created key installed on client, got %+v", key)
+		}
+	})
+
+	t.Run("KeepsValidCredentials", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			"GET /auth/api-keys": {status: http.StatusOK, body: `{"apiKeys":[]}`},
+		}}
+		existing := &auth.APIKey{Key: "existing", Secret: secret, Passphrase: "p"}
+		httpClient := transport.NewClient(doer, "http://example")
+		httpClient.SetAuth(signer, existing)
+		client := &clientImpl{httpClient: httpClient, signer: signer, creds: newAPICredentials(existing)}
+		key, err := client.EnsureAPIKey(ctx)
+		if err != nil {
+			t.Fatalf("EnsureAPIKey failed: %v", err)
+		}
+		if key != existing || len(doer.calls) != 1 {
+			t.Errorf("expected existing key without derivation, calls=%v", doer.calls)
+		}
+	})
+
+	t.Run("DerivesWhenRejected", func(t *testing.T) {
+		doer := &statusDoer{responses: map[string]statusResponse{
+			"GET /auth/api-keys":       {status: http.StatusUnauthorized, body: `{"error":"Unauthorized"}`},
+			"GET /auth/derive-api-key": {status: http.StatusOK, body: `{"apiKey":"derived","secret":"` + secret + `","passphrase":"p"}`},
+		}}
+		stale := &auth.APIKey{Key: "stale", Secret: secret, Passphrase: "p"}
+		httpClient := transport.NewClient(doer, "http://example")
+		httpClient.SetAuth(signer, stale)
+		client := &clientImpl{httpClient: httpClient, signer: signer, creds: newAPICredentials(stale)}
+		key, err := client.EnsureAPIKey(ctx)
+		if err != nil {
+			t.Fatalf("EnsureAPIKey failed: %v", err)
+		}
+		if key.Key != "derived" {
+			t.Errorf("expected derived key, got %+v", key)
+		}
+	})
+
+	t.Run("MissingSigner", func(t *testing.T) {
+		client := &clientImpl{httpClient: transport.NewClient(&statusDoer{}, "http://example")}
+		if _, err := client.EnsureAPIKey(ctx); err != auth.ErrMissingSigner {
+			t.Errorf("expected ErrMissingSigner, got %v", err)
+		}
+	})
+}
@@ -10,24 +10,13 @@ import (
 )
 
 func (c *clientImpl) Markets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
-	q := url.Values{}
-	if req != nil {
-		if req.Limit > 0 {
-			q.Set("limit", strconv.Itoa(req.Limit))
-		}
-		if req.Cursor != "" {
-			q.Set("cursor", req.Cursor)
-		}
-		if req.Active != nil {
-			q.Set("active", strconv.FormatBool(*req.Active))
-		}
-		if req.AssetID != "" {
-			q.Set("asset_id", req.AssetID)
-		}
+	q, err := c.marketsQuery("markets", req)
+	if err != nil {
+		return clobtypes.MarketsResponse{}, err
 	}
 
 	var resp clobtypes.MarketsResponse
-	err := c.httpClient.Get(ctx, "/markets", q, &resp)
+	err = c.httpClient.Get(ctx, "/markets", q, &resp)
 	return resp, mapError(err)
 }
 
@@ -66,39 +55,61 @@ func (c *clientImpl) Market(ctx context.Context, id string) (clobtypes.MarketRes
 	return resp, mapError(err)
 }
 
-func (c *clientImpl) SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
-	q := url.Values{}
-	if req != nil {
-		if req.Limit > 0 {
-			q.Set("limit", strconv.Itoa(req.Limit))
-		}
-		if req.Cursor != "" {
-			q.Set("cursor", req.Cursor)
-		}
-		if req.Active != nil {
-			q.Set("active", strconv.FormatBool(*req.Active))
-		}
-		if req.AssetID != "" {
-			q.Set("asset_id", req.AssetID)
-		}
+func (c *clientImpl) SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error) {
+	q, err := c.marketsQuery("simplified-markets", req)
+	if err != nil {
+		return clobtypes.SimplifiedMarketsResponse{}, err
 	}
-	var resp clobtypes.MarketsResponse
-	err := c.httpClient.Get(ctx, "/simplified-markets", q, &resp)
+	var resp clobtypes.SimplifiedMarketsResponse
+	err = c.httpClient.Get(ctx, "/simplified-markets", q, &resp)
 	return resp, mapError(err)
 }
 
 func (c *clientImpl) SamplingMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
+	q, err := c.marketsQuery("sampling-markets", req)
+	if err != nil {
+		return clobtypes.MarketsResponse{}, err
+	}
 	var resp clobtypes.MarketsResponse
-	err := c.httpClient.Get(ctx, "/sampling-markets", nil, &resp)
+	err = c.httpClient.Get(ctx, "/sampling-markets", q, &resp)
 	return resp, mapError(err)
 }
 
-func (c *clientImpl) SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error) {
-	var resp clobtypes.MarketsResponse
-	err := c.httpClient.Get(ctx, "/sampling-simplified-markets", nil, &resp)
+func (c *clientImpl) SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error) {
+	q, err := c.marketsQuery("sampling-simplified-markets", req)
+	if err != nil {
+		return clobtypes.SimplifiedMarketsResponse{}, err
+	}
+	var resp clobtypes.SimplifiedMarketsResponse
+	err = c.httpClient.Get(ctx, "/sampling-simplified-markets", q, &resp)
 	return resp, mapError(err)
 }
 
+// marketsQuery builds the shared query parameters for the market list endpoints.
+func (c *clientImpl) marketsQuery(endpoint string, req *clobtypes.MarketsRequest) (url.Values, error) {
+	q := url.Values{}
+	if req == nil {
+		return q, nil
+	}
+	limit, err := c.checkLimit(endpoint, req.Limit, MaxMarketsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if req.Cursor != "" {
+		q.Set("cursor", req.Cursor)
+	}
+	if req.Active != nil {
+		q.Set("active", strconv.FormatBool(*req.Active))
+	}
+	if req.AssetID != "" {
+		q.Set("asset_id", req.AssetID)
+	}
+	return q, nil
+}
+
 func (c *clientImpl) OrderBook(ctx context.Context, req *clobtypes.BookRequest) (clobtypes.OrderBookResponse, error) {
 	q := url.Values{}
 	if req != nil {
@@ -184,8 +195,8 @@ func (c *clientImpl) Prices(ctx context.Context, req *clobtypes.PricesRequest) (
 	return resp, mapError(err)
 }
 
-func (c *clientImpl) AllPrices(ctx context.Context) (clobtypes.PricesResponse, error) {
-	var resp clobtypes.PricesResponse
+func (c *clientImpl) AllPrices(ctx context.Context) (clobtypes.AllPricesResponse, error) {
+	var resp clobtypes.AllPricesResponse
 	err := c.httpClient.Get(ctx, "/prices", nil, &resp)
 	return resp, mapError(err)
 }
@@ -59,14 +59,122 @@ func (d *assertBodyDoer) Do(req *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+func TestMarketListQueryParams(t *testing.T) {
+	active := true
+	req := &clobtypes.MarketsRequest{Limit: 10, Cursor: "MTA=", Active: &active, AssetID: "t1"}
+	query := "?active=true&asset_id=t1&cursor=MTA%3D&limit=10"
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/markets" + query:                     `{"data":[{"id":"m1"}]}`,
+			"/simplified-markets" + query:          `{"data":[{"condition_id":"s1"}]}`,
+			"/sampling-markets" + query:            `{"data":[{"id":"sam1"}]}`,
+			"/sampling-simplified-markets" + query: `{"data":[{"condition_id":"ss1"}]}`,
+		},
+	}
+	client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+	ctx := context.Background()
+
+	calls := map[string]func() (int, error){
+		"Markets": func() (int, error) {
+			resp, err := client.Markets(ctx, req)
+			return len(resp.Data), err
+		},
+		"SimplifiedMarkets": func() (int, error) {
+			resp, err := client.SimplifiedMarkets(ctx, req)
+			return len(resp.Data), err
+		},
+		"SamplingMarkets": func() (int, error) {
+			resp, err := client.SamplingMarkets(ctx, req)
+			return len(resp.Data), err
+		},
+		"SamplingSimplifiedMarkets": func() (int, error) {
+			resp, err := client.SamplingSimplifiedMarkets(ctx, req)
+			return len(resp.Data), err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			n, err := call()
+			if err != nil || n != 1 {
+				t.Errorf("%s did not send expected query: %v", name, err)
+			}
+		})
+	}
+}
+
+func TestSimplifiedMarketsDecoding(t *testing.T) {
+	payload := `{
+		"limit": 1,
+		"count": 1,
+		"next_cursor": "MQ==",
+		"data": [{
+			"condition_id": "0x5f65177b394277fd294cd75650044e32ba009a95022d88a0c1d565897d72f8f1",
+			"rewards": {
+				"rates": [{"asset_address": "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174", "rewards_daily_rate": 25}],
+				"min_size": 100,
+				"max_spread": 3.5
+			},
+			"tokens": [
+				{"token_id": "71321045679252212594626385532706912750332728571942532289631379312455583992563", "outcome": "Yes", "price": 0.515, "winner": false},
+				{"token_id": "52114319501245915516055106046884209969926127482827954674443846427813813222426", "outcome": "No", "price": 0.485, "winner": false}
+			],
+			"active": true,
+			"closed": false,
+			"archived": false,
+			"accepting_orders": true
+		}]
+	}`
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/simplified-markets":          payload,
+			"/sampling-simplified-markets": payload,
+		},
+	}
+	client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+	ctx := context.Background()
+
+	for name, call := range map[string]func(context.Context, *clobtypes.MarketsRequest) (clobtypes.SimplifiedMarketsResponse, error){
+		"SimplifiedMarkets":         client.SimplifiedMarkets,
+		"SamplingSimplifiedMarkets": client.SamplingSimplifiedMarkets,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := call(ctx, nil)
+			if err != nil {
+				t.Fatalf("%s failed: %v", name, err)
+			}
+			if resp.NextCursor != "MQ==" || resp.Count != 1 || len(resp.Data) != 1 {
+				t.Fatalf("unexpected envelope: %+v", resp)
+			}
+			m := resp.Data[0]
+			if m.ConditionID != "0x5f65177b394277fd294cd75650044e32ba009a95022d88a0c1d565897d72f8f1" {
+				t.Errorf("unexpected condition id %q", m.ConditionID)
+			}
+			if !m.Active || m.Closed || m.Archived || !m.AcceptingOrders {
+				t.Errorf("unexpected flags: %+v", m)
+			}
+			if m.Rewards.MinSize != 100 || m.Rewards.MaxSpread != 3.5 {
+				t.Errorf("unexpected rewards: %+v", m.Rewards)
+			}
+			if len(m.Rewards.Rates) != 1 || m.Rewards.Rates[0].RewardsDailyRate != 25 ||
+				m.Rewards.Rates[0].AssetAddress != "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174" {
+				t.Errorf("unexpected reward rates: %+v", m.Rewards.Rates)
+			}
+			if len(m.Tokens) != 2 || m.Tokens[0].Outcome != "Yes" || m.Tokens[0].Price != 0.515 ||
+				m.Tokens[1].TokenID != "52114319501245915516055106046884209969926127482827954674443846427813813222426" {
+				t.Errorf("unexpected tokens: %+v", m.Tokens)
+			}
+		})
+	}
+}
+
 func TestMarketMethods(t *testing.T) {
 	doer := &staticDoer{
 		responses: map[string]string{
 			"/markets":                     `{"data":[{"id":"m1"}],"next_cursor":"LTE="}`,
 			"/markets/m1":                  `{"id":"m1","question":"test?"}`,
-			"/simplified-markets":          `{"data":[{"id":"s1"}]}`,
+			"/simplified-markets":          `{"data":[{"condition_id":"s1"}]}`,
 			"/sampling-markets":            `{"data":[{"id":"sam1"}]}`,
-			"/sampling-simplified-markets": `{"data":[{"id":"ss1"}]}`,
+			"/sampling-simplified-markets": `{"data":[{"condition_id":"ss1"}]}`,
 			"/book?token_id=t1":            `{"market_id":"m1","bids":[],"asks":[]}`,
 			"/midpoint?token_id=t1":        `{"midpoint":"0.5"}`,
 			"/price?token_id=t1":           `{"price":"0.51"}`,
@@ -151,6 +259,11 @@ func TestMarketMethods(t *testing.T) {
 		if resp.MinimumTickSize != 0.02 {
 			t.Errorf("cache failed")
 		}
+		client.InvalidateTickSize("t1")
+		resp, _ = client.TickSize(ctx, &clobtypes.TickSizeRequest{TokenID: "t1"})
+		if resp.MinimumTickSize != 0.01 {
+			t.Errorf("InvalidateTickSize did not refetch, got %v", resp.MinimumTickSize)
+		}
 	})
 
 	t.Run("PricesHistory", func(t *testing.T) {
@@ -161,6 +274,36 @@ func TestMarketMethods(t *testing.T) {
 	})
 }
 
+func TestAllPrices(t *testing.T) {
+	payload := `{
+		"71321045679252212594626385532706912750332728571942532289631379312455583992563": {"BUY": "0.515", "SELL": "0.52"},
+		"52114319501245915516055106046884209969926127482827954674443846427813813222426": {"BUY": "0.48"}
+	}`
+	doer := &staticDoer{responses: map[string]string{"/prices": payload}}
+	client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+
+	resp, err := client.AllPrices(context.Background())
+	if err != nil {
+		t.Fatalf("AllPrices failed: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 tokens, got %d", len(resp))
+	}
+	yes := "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+	if price, ok := resp.Price(yes, "buy"); !ok || price.String() != "0.515" {
+		t.Errorf("unexpected BUY price: %s %v", price, ok)
+	}
+	if price, ok := resp.Price(yes, "SELL"); !ok || price.String() != "0.52" {
+		t.Errorf("unexpected SELL price: %s %v", price, ok)
+	}
+	if _, ok := resp.Price("52114319501245915516055106046884209969926127482827954674443846427813813222426", "SELL"); ok {
+		t.Errorf("expected missing SELL price")
+	}
+	if _, ok := resp.Price("unknown", "BUY"); ok {
+		t.Errorf("expected missing token")
+	}
+}
+
 func TestBatchMethods(t *testing.T) {
 	doer := &staticDoer{
 		responses: map[string]string{
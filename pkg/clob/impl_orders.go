@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -13,8 +14,6 @@ import (
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/common/math"
-	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 // CreateOrder builds and signs an order, then posts it to the CLOB.
@@ -43,12 +42,13 @@ func (c *clientImpl) CreateOrderFromSignable(ctx context.Context, order *clobtyp
 	opts := &clobtypes.OrderOptions{
 		OrderType: order.OrderType,
 		PostOnly:  order.PostOnly,
+		DeferExec: order.DeferExec,
 	}
 	return c.CreateOrderWithOptions(ctx, order.Order, opts)
 }
 
 func (c *clientImpl) signOrder(order *clobtypes.Order) (*clobtypes.SignedOrder, error) {
-	return signOrderWithCreds(c.signer, c.apiKey, order, &c.signatureType, c.funder, c.saltGenerator)
+	return signOrderWithCreds(c.signer, c.currentAPIKey(), order, &c.signatureType, c.funder, c.saltGenerator)
 }
 
 // SignOrder builds an EIP-712 signature for the given order without posting it.
@@ -94,41 +94,6 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 		}
 	}
 
-	domain := &apitypes.TypedDataDomain{
-		Name:              "Polymarket CTF Exchange",
-		Version:           "1",
-		ChainId:           (*math.HexOrDecimal256)(signer.ChainID()),
-		VerifyingContract: "0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E", // Exchange Contract Address (Mainnet)
-	}
-
-	typesDef := apitypes.Types{
-		"EIP712Domain": {
-			{Name: "name", Type: "string"},
-			{Name: "version", Type: "string"},
-			{Name: "chainId", Type: "uint256"},
-			{Name: "verifyingContract", Type: "address"},
-		},
-		"Order": {
-			{Name: "salt", Type: "uint256"},
-			{Name: "maker", Type: "address"},
-			{Name: "signer", Type: "address"},
-			{Name: "taker", Type: "address"},
-			{Name: "tokenId", Type: "uint256"},
-			{Name: "makerAmount", Type: "uint256"},
-			{Name: "takerAmount", Type: "uint256"},
-			{Name: "expiration", Type: "uint256"},
-			{Name: "nonce", Type: "uint256"},
-			{Name: "feeRateBps", Type: "uint256"},
-			{Name: "side", Type: "uint8"},
-			{Name: "signatureType", Type: "uint8"},
-		},
-	}
-
-	sideInt := 0
-	if strings.ToUpper(order.Side) == "SELL" {
-		sideInt = 1
-	}
-
 	if order.Salt.Int == nil || order.Salt.Int.Sign() == 0 {
 		var salt *big.Int
 		var err error
@@ -143,27 +108,8 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 		order.Salt = types.U256{Int: salt}
 	}
 
-	expiration := big.NewInt(0)
-	if order.Expiration.Int != nil {
-		expiration = order.Expiration.Int
-	}
-
-	message := apitypes.TypedDataMessage{
-		"salt":          (*math.HexOrDecimal256)(order.Salt.Int),
-		"maker":         order.Maker.String(),
-		"signer":        signer.Address().String(),
-		"taker":         order.Taker.String(),
-		"tokenId":       (*math.HexOrDecimal256)(order.TokenID.Int),
-		"makerAmount":   (*math.HexOrDecimal256)(order.MakerAmount.BigInt()),
-		"takerAmount":   (*math.HexOrDecimal256)(order.TakerAmount.BigInt()),
-		"expiration":    (*math.HexOrDecimal256)(expiration),
-		"nonce":         (*math.HexOrDecimal256)(order.Nonce.Int),
-		"feeRateBps":    (*math.HexOrDecimal256)(order.FeeRateBps.BigInt()),
-		"side":          (*math.HexOrDecimal256)(big.NewInt(int64(sideInt))),
-		"signatureType": (*math.HexOrDecimal256)(big.NewInt(int64(sigTypeVal))),
-	}
-
-	sig, err := signer.SignTypedData(domain, typesDef, message, "Order")
+	typedData := orderTypedData(order, signer.Address(), sigTypeVal, signer.ChainID(), signingExchange(signer.ChainID()))
+	sig, err := signer.SignTypedData(&typedData.Domain, typedData.Types, typedData.Message, typedData.PrimaryType)
 	if err != nil {
 		return nil, fmt.Errorf("signing failed: %w", err)
 	}
@@ -182,6 +128,9 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 
 func (c *clientImpl) PostOrder(ctx context.Context, req *clobtypes.SignedOrder) (clobtypes.OrderResponse, error) {
 	var resp clobtypes.OrderResponse
+	if err := c.checkGeoblock(ctx); err != nil {
+		return resp, err
+	}
 	payload, err := buildOrderPayload(req)
 	if err != nil {
 		return resp, err
@@ -192,6 +141,9 @@ func (c *clientImpl) PostOrder(ctx context.Context, req *clobtypes.SignedOrder)
 
 func (c *clientImpl) PostOrders(ctx context.Context, req *clobtypes.SignedOrders) (clobtypes.PostOrdersResponse, error) {
 	var resp clobtypes.PostOrdersResponse
+	if err := c.checkGeoblock(ctx); err != nil {
+		return resp, err
+	}
 	payload, err := buildOrdersPayload(req)
 	if err != nil {
 		return resp, err
@@ -212,19 +164,100 @@ func (c *clientImpl) CancelOrder(ctx context.Context, req *clobtypes.CancelOrder
 	return resp, mapError(err)
 }
 
+func (c *clientImpl) CancelAndReplace(ctx context.Context, cancelID string, replacement *clobtypes.SignableOrder) (clobtypes.OrderResponse, error) {
+	cancelID = strings.TrimSpace(cancelID)
+	if cancelID == "" {
+		return clobtypes.OrderResponse{}, fmt.Errorf("cancel id is required")
+	}
+	if replacement == nil || replacement.Order == nil {
+		return clobtypes.OrderResponse{}, fmt.Errorf("order is required")
+	}
+	// Check the geoblock before canceling so a blocked replacement leaves the
+	// original order live.
+	if err := c.checkGeoblock(ctx); err != nil {
+		return clobtypes.OrderResponse{}, err
+	}
+	// Sign before canceling so a signing failure leaves the original order live.
+	signed, err := c.signOrder(replacement.Order)
+	if err != nil {
+		return clobtypes.OrderResponse{}, err
+	}
+	signed.OrderType = replacement.OrderType
+	signed.PostOnly = replacement.PostOnly
+	signed.DeferExec = replacement.DeferExec
+	if _, err := buildOrderPayload(signed); err != nil {
+		return clobtypes.OrderResponse{}, err
+	}
+
+	cancelResp, err := c.CancelOrder(ctx, &clobtypes.CancelOrderRequest{OrderID: cancelID})
+	if err != nil {
+		return clobtypes.OrderResponse{}, &CancelReplaceError{OrderID: cancelID, Err: err}
+	}
+	if reason, ok := cancelResp.NotCanceled[cancelID]; ok {
+		return clobtypes.OrderResponse{}, &CancelReplaceError{OrderID: cancelID, Err: fmt.Errorf("%w: %s", ErrCancelNotConfirmed, reason)}
+	}
+	// Only an explicit confirmation counts: posting after an empty or
+	// ambiguous response could leave both orders live.
+	if !containsString(cancelResp.Canceled, cancelID) {
+		return clobtypes.OrderResponse{}, &CancelReplaceError{OrderID: cancelID, Err: ErrCancelNotConfirmed}
+	}
+
+	resp, err := c.PostOrder(ctx, signed)
+	if err != nil {
+		return resp, &CancelReplaceError{OrderID: cancelID, Canceled: true, Err: err}
+	}
+	// A rejected order can still come back with HTTP 200.
+	if !resp.Success || resp.ErrorMsg != "" {
+		reason := resp.ErrorMsg
+		if reason == "" {
+			reason = "success=false"
+		}
+		return resp, &CancelReplaceError{OrderID: cancelID, Canceled: true, Err: fmt.Errorf("%w: %s", ErrReplacementRejected, reason)}
+	}
+	return resp, nil
+}
+
+func containsString(values []string, want string) bool {
+	for _, v := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *clientImpl) CancelOrders(ctx context.Context, req *clobtypes.CancelOrdersRequest) (clobtypes.CancelResponse, error) {
 	var resp clobtypes.CancelResponse
-	var body interface{}
+	var ids []string
 	if req != nil {
-		ids := req.OrderIDs
-		if len(ids) > 0 {
-			body = ids
-		}
+		ids = normalizeOrderIDs(req.OrderIDs)
 	}
-	err := c.httpClient.Delete(ctx, "/orders", body, &resp)
+	if len(ids) == 0 {
+		// An empty DELETE /orders is accepted by the server and cancels nothing.
+		return resp, fmt.Errorf("order ids are required")
+	}
+	err := c.httpClient.Delete(ctx, "/orders", ids, &resp)
 	return resp, mapError(err)
 }
 
+// normalizeOrderIDs trims ids and drops blanks and duplicates, keeping order.
+func normalizeOrderIDs(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
 func (c *clientImpl) CancelAll(ctx context.Context) (clobtypes.CancelAllResponse, error) {
 	var resp clobtypes.CancelAllResponse
 	err := c.httpClient.Delete(ctx, "/cancel-all", nil, &resp)
@@ -235,13 +268,18 @@ func (c *clientImpl) CancelMarketOrders(ctx context.Context, req *clobtypes.Canc
 	var resp clobtypes.CancelMarketOrdersResponse
 	var body interface{}
 	if req != nil {
-		market := req.Market
+		// The legacy MarketID is sent under the canonical "market" key and
+		// only when Market is empty.
+		market := strings.TrimSpace(req.Market)
+		if market == "" {
+			market = strings.TrimSpace(req.MarketID)
+		}
 		payload := map[string]string{}
 		if market != "" {
 			payload["market"] = market
 		}
-		if req.AssetID != "" {
-			payload["asset_id"] = req.AssetID
+		if assetID := strings.TrimSpace(req.AssetID); assetID != "" {
+			payload["asset_id"] = assetID
 		}
 		if len(payload) > 0 {
 			body = payload
@@ -251,6 +289,14 @@ func (c *clientImpl) CancelMarketOrders(ctx context.Context, req *clobtypes.Canc
 	return resp, mapError(err)
 }
 
+func (c *clientImpl) CancelOrdersForAsset(ctx context.Context, assetID string) (clobtypes.CancelMarketOrdersResponse, error) {
+	assetID = strings.TrimSpace(assetID)
+	if assetID == "" {
+		return clobtypes.CancelMarketOrdersResponse{}, fmt.Errorf("asset id is required")
+	}
+	return c.CancelMarketOrders(ctx, &clobtypes.CancelMarketOrdersRequest{AssetID: assetID})
+}
+
 func (c *clientImpl) Order(ctx context.Context, id string) (clobtypes.OrderResponse, error) {
 	var resp clobtypes.OrderResponse
 	err := c.httpClient.Get(ctx, fmt.Sprintf("/data/order/%s", id), nil, &resp)
@@ -269,8 +315,12 @@ func (c *clientImpl) Orders(ctx context.Context, req *clobtypes.OrdersRequest) (
 		if req.AssetID != "" {
 			q.Set("asset_id", req.AssetID)
 		}
-		if req.Limit > 0 {
-			q.Set("limit", strconv.Itoa(req.Limit))
+		limit, err := c.checkLimit("orders", req.Limit, MaxOrdersLimit)
+		if err != nil {
+			return clobtypes.OrdersResponse{}, err
+		}
+		if limit > 0 {
+			q.Set("limit", strconv.Itoa(limit))
 		}
 		nextCursor := req.NextCursor
 		if nextCursor == "" {
@@ -309,8 +359,12 @@ func (c *clientImpl) Trades(ctx context.Context, req *clobtypes.TradesRequest) (
 		if req.After > 0 {
 			q.Set("after", strconv.FormatInt(req.After, 10))
 		}
-		if req.Limit > 0 {
-			q.Set("limit", strconv.Itoa(req.Limit))
+		limit, err := c.checkLimit("trades", req.Limit, MaxTradesLimit)
+		if err != nil {
+			return clobtypes.TradesResponse{}, err
+		}
+		if limit > 0 {
+			q.Set("limit", strconv.Itoa(limit))
 		}
 		nextCursor := req.NextCursor
 		if nextCursor == "" {
@@ -361,6 +415,38 @@ func (c *clientImpl) OrdersAll(ctx context.Context, req *clobtypes.OrdersRequest
 	return results, nil
 }
 
+func (c *clientImpl) OpenOrdersByMarket(ctx context.Context, market string) ([]clobtypes.OrderResponse, error) {
+	market = strings.TrimSpace(market)
+	if market == "" {
+		return nil, fmt.Errorf("market is required")
+	}
+	orders, err := c.OrdersAll(ctx, &clobtypes.OrdersRequest{Market: market})
+	if err != nil {
+		return nil, err
+	}
+	sortOpenOrders(orders)
+	return orders, nil
+}
+
+// sortOpenOrders groups orders by asset, then side (BUY before SELL), with the
+// most aggressive price first: highest bid, lowest ask. Ties keep server order.
+func sortOpenOrders(orders []clobtypes.OrderResponse) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		a, b := orders[i], orders[j]
+		if a.AssetID != b.AssetID {
+			return a.AssetID < b.AssetID
+		}
+		sideA, sideB := strings.ToUpper(a.Side), strings.ToUpper(b.Side)
+		if sideA != sideB {
+			return sideA < sideB
+		}
+		if sideA == "BUY" {
+			return a.Price.GreaterThan(b.Price)
+		}
+		return a.Price.LessThan(b.Price)
+	})
+}
+
 func (c *clientImpl) TradesAll(ctx context.Context, req *clobtypes.TradesRequest) ([]clobtypes.Trade, error) {
 	var results []clobtypes.Trade
 	cursor := clobtypes.InitialCursor
@@ -474,8 +560,12 @@ func (c *clientImpl) BuilderTrades(ctx context.Context, req *clobtypes.BuilderTr
 		if req.After > 0 {
 			q.Set("after", strconv.FormatInt(req.After, 10))
 		}
-		if req.Limit > 0 {
-			q.Set("limit", strconv.Itoa(req.Limit))
+		limit, err := c.checkLimit("builder-trades", req.Limit, MaxTradesLimit)
+		if err != nil {
+			return clobtypes.BuilderTradesResponse{}, err
+		}
+		if limit > 0 {
+			q.Set("limit", strconv.Itoa(limit))
 		}
 		nextCursor := req.NextCursor
 		if nextCursor == "" {
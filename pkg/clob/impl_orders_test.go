@@ -1,8 +1,13 @@
 package clob
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"math/big"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -26,7 +31,7 @@ func TestOrderManagementMethods(t *testing.T) {
 		client := &clientImpl{
 			httpClient: transport.NewClient(doer, "http://example"),
 			signer:     signer,
-			apiKey:     apiKey,
+			creds:      newAPICredentials(apiKey),
 		}
 		order := &clobtypes.SignedOrder{
 			Order:     clobtypes.Order{Side: "BUY"},
@@ -78,6 +83,26 @@ func TestOrderManagementMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("CancelOrdersNormalizesIDs", func(t *testing.T) {
+		doer := &assertBodyDoer{
+			t:         t,
+			expected:  map[string]string{"/orders": `["o1","o2"]`},
+			responses: map[string]string{"/orders": `{"status":"OK"}`},
+		}
+		client := &clientImpl{
+			httpClient: transport.NewClient(doer, "http://example"),
+		}
+		if _, err := client.CancelOrders(ctx, &clobtypes.CancelOrdersRequest{OrderIDs: []string{" o1 ", "", "o2", "o1"}}); err != nil {
+			t.Fatalf("CancelOrders failed: %v", err)
+		}
+		if _, err := client.CancelOrders(ctx, &clobtypes.CancelOrdersRequest{OrderIDs: []string{" "}}); err == nil {
+			t.Error("expected error for empty order ids")
+		}
+		if _, err := client.CancelOrders(ctx, nil); err == nil {
+			t.Error("expected error for nil request")
+		}
+	})
+
 	t.Run("CancelMarketOrders", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{"/cancel-market-orders": `{"status":"OK"}`},
@@ -91,6 +116,48 @@ func TestOrderManagementMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("CancelMarketOrdersFieldPrecedence", func(t *testing.T) {
+		cases := []struct {
+			name string
+			req  *clobtypes.CancelMarketOrdersRequest
+			want string
+		}{
+			{"canonical", &clobtypes.CancelMarketOrdersRequest{Market: "m1", AssetID: "a1"}, `{"market":"m1","asset_id":"a1"}`},
+			{"canonical wins over legacy", &clobtypes.CancelMarketOrdersRequest{Market: "m1", MarketID: "legacy"}, `{"market":"m1"}`},
+			{"legacy sent as market", &clobtypes.CancelMarketOrdersRequest{MarketID: "legacy"}, `{"market":"legacy"}`},
+			{"asset only", &clobtypes.CancelMarketOrdersRequest{AssetID: " a1 "}, `{"asset_id":"a1"}`},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				doer := &assertBodyDoer{
+					t:         t,
+					expected:  map[string]string{"/cancel-market-orders": tc.want},
+					responses: map[string]string{"/cancel-market-orders": `{"status":"OK"}`},
+				}
+				client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+				if _, err := client.CancelMarketOrders(ctx, tc.req); err != nil {
+					t.Fatalf("CancelMarketOrders failed: %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("CancelOrdersForAsset", func(t *testing.T) {
+		doer := &assertBodyDoer{
+			t:         t,
+			expected:  map[string]string{"/cancel-market-orders": `{"asset_id":"a1"}`},
+			responses: map[string]string{"/cancel-market-orders": `{"status":"OK"}`},
+		}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		resp, err := client.CancelOrdersForAsset(ctx, "a1")
+		if err != nil || resp.Status != "OK" {
+			t.Fatalf("CancelOrdersForAsset failed: %v", err)
+		}
+		if _, err := client.CancelOrdersForAsset(ctx, " "); err == nil {
+			t.Error("expected error for empty asset id")
+		}
+	})
+
 	t.Run("BuilderTrades", func(t *testing.T) {
 		doer := &staticDoer{
 			responses: map[string]string{"/builder/trades": `{"data":[]}`},
@@ -109,7 +176,7 @@ func TestOrderManagementMethods(t *testing.T) {
 
 	t.Run("OrderLookup", func(t *testing.T) {
 		doer := &staticDoer{
-			responses: map[string]string{"/data/order/o1": `{"id":"o1","status":"OK"}`},
+			responses: map[string]string{"/data/order/o1": `{"id":"o1","status":"OK","side":"SELL","price":"0.7","original_size":"10","size_matched":"2"}`},
 		}
 		client := &clientImpl{
 			httpClient: transport.NewClient(doer, "http://example"),
@@ -118,6 +185,9 @@ func TestOrderManagementMethods(t *testing.T) {
 		if err != nil || resp.ID != "o1" {
 			t.Errorf("Order lookup failed: %v", err)
 		}
+		if resp.Side != "SELL" || resp.Price.String() != "0.7" || resp.RemainingSize().String() != "8" {
+			t.Errorf("unexpected order detail: %+v", resp)
+		}
 	})
 
 	t.Run("OrdersList", func(t *testing.T) {
@@ -167,7 +237,7 @@ func TestSignOrderDefaults(t *testing.T) {
 	funder := common.HexToAddress("0x3333333333333333333333333333333333333333")
 	client := &clientImpl{
 		signer:        signer,
-		apiKey:        apiKey,
+		creds:         newAPICredentials(apiKey),
 		signatureType: auth.SignatureProxy,
 		funder:        &funder,
 		saltGenerator: func() (*big.Int, error) { return big.NewInt(7), nil },
@@ -199,3 +269,135 @@ func TestSignOrderDefaults(t *testing.T) {
 		t.Fatalf("salt mismatch: got %v", signed.Order.Salt.Int)
 	}
 }
+
+// methodDoer answers by method and path and records the calls it sees.
+type methodDoer struct {
+	responses map[string]string
+	calls     []string
+}
+
+func (d *methodDoer) Do(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.Path
+	d.calls = append(d.calls, key)
+	payload, ok := d.responses[key]
+	status := http.StatusOK
+	if !ok {
+		status, payload = http.StatusBadRequest, `{"error":"unexpected request"}`
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewBufferString(payload)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestCancelAndReplace(t *testing.T) {
+	signer := mustSigner(t)
+	apiKey := &auth.APIKey{Key: "k1", Secret: "s1", Passphrase: "p1"}
+	ctx := context.Background()
+	replacement := func() *clobtypes.SignableOrder {
+		return &clobtypes.SignableOrder{
+			Order: &clobtypes.Order{
+				Side:        "BUY",
+				TokenID:     types.U256{Int: big.NewInt(1)},
+				MakerAmount: decimal.NewFromInt(10),
+				TakerAmount: decimal.NewFromInt(20),
+				FeeRateBps:  decimal.NewFromInt(0),
+				Nonce:       types.U256{Int: big.NewInt(0)},
+				Expiration:  types.U256{Int: big.NewInt(0)},
+				Signer:      signer.Address(),
+			},
+			OrderType: clobtypes.OrderTypeGTC,
+		}
+	}
+	newClient := func(responses map[string]string) (*clientImpl, *methodDoer) {
+		doer := &methodDoer{responses: responses}
+		return &clientImpl{
+			httpClient: transport.NewClient(doer, "http://example"),
+			signer:     signer,
+			creds:      newAPICredentials(apiKey),
+		}, doer
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		client, doer := newClient(map[string]string{
+			"DELETE /order": `{"canceled":["old"],"not_canceled":{}}`,
+			"POST /order":   `{"orderID":"new","status":"live","success":true}`,
+		})
+		resp, err := client.CancelAndReplace(ctx, "old", replacement())
+		if err != nil || resp.ID != "new" {
+			t.Fatalf("CancelAndReplace = %+v, %v", resp, err)
+		}
+		if len(doer.calls) != 2 || doer.calls[0] != "DELETE /order" || doer.calls[1] != "POST /order" {
+			t.Errorf("unexpected calls %v", doer.calls)
+		}
+	})
+
+	t.Run("CancelRejected", func(t *testing.T) {
+		client, doer := newClient(map[string]string{
+			"DELETE /order": `{"canceled":[],"not_canceled":{"old":"order already matched"}}`,
+		})
+		_, err := client.CancelAndReplace(ctx, "old", replacement())
+		var crErr *CancelReplaceError
+		if !errors.As(err, &crErr) || crErr.Canceled || !errors.Is(err, ErrCancelNotConfirmed) {
+			t.Fatalf("expected unconfirmed cancel error, got %v", err)
+		}
+		if len(doer.calls) != 1 {
+			t.Errorf("replacement must not be posted, calls %v", doer.calls)
+		}
+	})
+
+	t.Run("EmptyCancelResponse", func(t *testing.T) {
+		client, doer := newClient(map[string]string{
+			"DELETE /order": `{"canceled":[],"not_canceled":{}}`,
+		})
+		_, err := client.CancelAndReplace(ctx, "old", replacement())
+		var crErr *CancelReplaceError
+		if !errors.As(err, &crErr) || crErr.Canceled || !errors.Is(err, ErrCancelNotConfirmed) {
+			t.Fatalf("expected unconfirmed cancel error, got %v", err)
+		}
+		if len(doer.calls) != 1 {
+			t.Errorf("replacement must not be posted, calls %v", doer.calls)
+		}
+	})
+
+	t.Run("ReplacementRejected", func(t *testing.T) {
+		client, doer := newClient(map[string]string{
+			"DELETE /order": `{"canceled":["old"],"not_canceled":{}}`,
+			"POST /order":   `{"success":false,"errorMsg":"INVALID_ORDER_NOT_ENOUGH_BALANCE"}`,
+		})
+		_, err := client.CancelAndReplace(ctx, "old", replacement())
+		var crErr *CancelReplaceError
+		if !errors.As(err, &crErr) || !crErr.Canceled || !errors.Is(err, ErrReplacementRejected) {
+			t.Fatalf("expected rejected replacement error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "INVALID_ORDER_NOT_ENOUGH_BALANCE") {
+			t.Errorf("error does not carry errorMsg: %v", err)
+		}
+		if len(doer.calls) != 2 {
+			t.Errorf("unexpected calls %v", doer.calls)
+		}
+	})
+
+	t.Run("PostFailsAfterCancel", func(t *testing.T) {
+		client, _ := newClient(map[string]string{
+			"DELETE /order": `{"canceled":["old"]}`,
+		})
+		_, err := client.CancelAndReplace(ctx, "old", replacement())
+		var crErr *CancelReplaceError
+		if !errors.As(err, &crErr) || !crErr.Canceled || crErr.OrderID != "old" {
+			t.Fatalf("expected post-stage error, got %v", err)
+		}
+	})
+
+	t.Run("SignFailureKeepsOriginal", func(t *testing.T) {
+		client, doer := newClient(nil)
+		client.creds = nil
+		if _, err := client.CancelAndReplace(ctx, "old", replacement()); !errors.Is(err, auth.ErrMissingCreds) {
+			t.Fatalf("expected missing creds error, got %v", err)
+		}
+		if len(doer.calls) != 0 {
+			t.Errorf("nothing should be sent, calls %v", doer.calls)
+		}
+	})
+}
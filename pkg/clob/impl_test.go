@@ -2,11 +2,20 @@ package clob
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"net/http"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/ws"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport/transporttest"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 )
 
 func TestClientInitializationAndOptions(t *testing.T) {
@@ -100,3 +109,126 @@ func TestClientInitializationAndOptions(t *testing.T) {
 		client.InvalidateCaches()
 	})
 }
+
+type authRecordingWS struct {
+	ws.Client
+	keys []*auth.APIKey
+}
+
+func (w *authRecordingWS) UpdateAuth(apiKey *auth.APIKey) error {
+	w.keys = append(w.keys, apiKey)
+	return nil
+}
+
+func TestUpdateCredentials(t *testing.T) {
+	srv := transporttest.NewServer(t).Handle("GET", "/auth/api-keys", http.StatusOK, `{"apiKeys":[]}`)
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wsClient := &authRecordingWS{}
+	client := NewClient(srv.Client()).
+		WithAuth(signer, &auth.APIKey{Key: "old", Secret: "c2VjcmV0", Passphrase: "pass"}).
+		WithWS(wsClient)
+
+	rotated := &auth.APIKey{Key: "new", Secret: "c2VjcmV0Mg==", Passphrase: "pass2"}
+	if err := client.UpdateCredentials(rotated); err != nil {
+		t.Fatalf("UpdateCredentials failed: %v", err)
+	}
+	if _, err := client.ListAPIKeys(context.Background()); err != nil {
+		t.Fatalf("ListAPIKeys failed: %v", err)
+	}
+	srv.AssertHeader(t, "GET", "/auth/api-keys", auth.HeaderPolyAPIKey, "new")
+	srv.AssertHeader(t, "GET", "/auth/api-keys", auth.HeaderPolyPassphrase, "pass2")
+	if len(wsClient.keys) != 1 || wsClient.keys[0] != rotated {
+		t.Errorf("websocket was not re-authenticated: %v", wsClient.keys)
+	}
+
+	if err := client.UpdateCredentials(&auth.APIKey{Key: "partial"}); err == nil {
+		t.Error("expected error for incomplete credentials")
+	}
+}
+
+func TestWithAuthReportsInvalidSecret(t *testing.T) {
+	srv := transporttest.NewServer(t)
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(srv.Client()).
+		WithAuth(signer, &auth.APIKey{Key: "k", Secret: "not base64!", Passphrase: "p"})
+	if !errors.Is(client.AuthError(), auth.ErrInvalidAPISecret) {
+		t.Fatalf("AuthError = %v, want ErrInvalidAPISecret", client.AuthError())
+	}
+	if _, err := client.ListAPIKeys(context.Background()); !errors.Is(err, auth.ErrInvalidAPISecret) {
+		t.Errorf("ListAPIKeys error = %v, want ErrInvalidAPISecret", err)
+	}
+	if len(srv.Requests()) != 0 {
+		t.Error("request was sent with an invalid secret")
+	}
+
+	if err := client.UpdateCredentials(&auth.APIKey{Key: "k", Secret: "c2VjcmV0", Passphrase: "p"}); err != nil {
+		t.Fatalf("UpdateCredentials failed: %v", err)
+	}
+	if err := client.AuthError(); err != nil {
+		t.Errorf("AuthError after valid secret = %v", err)
+	}
+}
+
+func TestUpdateCredentialsReachesClones(t *testing.T) {
+	srv := transporttest.NewServer(t).Handle("POST", "/order", http.StatusOK, `{"orderID":"o1"}`)
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := NewClient(srv.Client()).
+		WithAuth(signer, &auth.APIKey{Key: "old", Secret: "c2VjcmV0", Passphrase: "pass"})
+	clone := parent.
+		WithSignatureType(auth.SignatureProxy).
+		WithFunder(common.HexToAddress("0x3333333333333333333333333333333333333333"))
+	builder := NewOrderBuilder(clone, signer)
+
+	if err := parent.UpdateCredentials(&auth.APIKey{Key: "new", Secret: "c2VjcmV0Mg==", Passphrase: "pass2"}); err != nil {
+		t.Fatalf("UpdateCredentials failed: %v", err)
+	}
+
+	order := &clobtypes.Order{
+		Side:        "BUY",
+		TokenID:     types.U256{Int: big.NewInt(1)},
+		MakerAmount: decimal.NewFromInt(10),
+		TakerAmount: decimal.NewFromInt(5),
+		FeeRateBps:  decimal.NewFromInt(0),
+		Nonce:       types.U256{Int: big.NewInt(1)},
+		Expiration:  types.U256{Int: big.NewInt(0)},
+		Signer:      signer.Address(),
+	}
+	signed, err := clone.(*clientImpl).signOrder(order)
+	if err != nil {
+		t.Fatalf("signOrder failed: %v", err)
+	}
+	if _, err := clone.PostOrder(context.Background(), signed); err != nil {
+		t.Fatalf("PostOrder failed: %v", err)
+	}
+	req, ok := srv.LastRequest("POST", "/order")
+	if !ok {
+		t.Fatal("order was not posted")
+	}
+	var body struct {
+		Owner string `json:"owner"`
+	}
+	if err := req.DecodeJSON(&body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body.Owner != "new" {
+		t.Errorf("owner = %q, want rotated key", body.Owner)
+	}
+	srv.AssertHeader(t, "POST", "/order", auth.HeaderPolyAPIKey, "new")
+
+	built, err := builder.sign(&clobtypes.SignableOrder{Order: order})
+	if err != nil {
+		t.Fatalf("builder sign failed: %v", err)
+	}
+	if built.Owner != "new" {
+		t.Errorf("builder owner = %q, want rotated key", built.Owner)
+	}
+}
@@ -0,0 +1,49 @@
+package clob
+
+import (
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/logger"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// Upper bounds applied to the paginated list endpoints under LimitClamp and
+// LimitReject. The CLOB API does not publish these maximums; the values are
+// the caps the SDK has been exercised against and may drift from the server,
+// which is why the default LimitPassThrough policy never consults them.
+const (
+	MaxMarketsLimit       = 1000
+	MaxOrdersLimit        = 500
+	MaxTradesLimit        = 500
+	MaxNotificationsLimit = 100
+)
+
+// LimitPolicy controls how a request limit above the endpoint maximum is handled.
+type LimitPolicy int
+
+const (
+	// LimitPassThrough sends the limit unchanged and leaves enforcement to the server.
+	LimitPassThrough LimitPolicy = iota
+	// LimitClamp rewrites the limit down to the endpoint maximum and logs a warning.
+	LimitClamp
+	// LimitReject fails the call with a BoundedIntError before sending it.
+	LimitReject
+)
+
+// BoundedIntError indicates a numeric parameter is outside allowed bounds.
+type BoundedIntError = types.BoundedIntError
+
+// checkLimit applies the client's LimitPolicy to limit for the named endpoint.
+// Non-positive limits are returned unchanged and leave the server default in place.
+func (c *clientImpl) checkLimit(endpoint string, limit, max int) (int, error) {
+	if limit <= max {
+		return limit, nil
+	}
+	switch c.limitPolicy {
+	case LimitReject:
+		return 0, BoundedIntError{Value: limit, Min: 1, Max: max, ParamName: "limit"}
+	case LimitClamp:
+		logger.Warn("%s limit %d exceeds server maximum, clamping to %d", endpoint, limit, max)
+		return max, nil
+	default:
+		return limit, nil
+	}
+}
@@ -0,0 +1,70 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+func TestLimitPolicyClamp(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			buildKey("/markets", url.Values{"limit": {"1000"}}):      `{"data":[],"next_cursor":"LTE="}`,
+			buildKey("/data/trades", url.Values{"limit": {"500"}}):   `{"data":[],"next_cursor":"LTE="}`,
+			buildKey("/notifications", url.Values{"limit": {"100"}}): `[]`,
+		},
+	}
+	base := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+	client := base.WithLimitPolicy(LimitClamp)
+	ctx := context.Background()
+
+	if _, err := client.Markets(ctx, &clobtypes.MarketsRequest{Limit: 5000}); err != nil {
+		t.Fatalf("Markets failed: %v", err)
+	}
+	if _, err := client.Trades(ctx, &clobtypes.TradesRequest{Limit: 1000}); err != nil {
+		t.Fatalf("Trades failed: %v", err)
+	}
+	if _, err := client.Notifications(ctx, &clobtypes.NotificationsRequest{Limit: 1000}); err != nil {
+		t.Fatalf("Notifications failed: %v", err)
+	}
+}
+
+func TestLimitPolicyPassThroughByDefault(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			buildKey("/markets", url.Values{"limit": {"5000"}}): `{"data":[],"next_cursor":"LTE="}`,
+		},
+	}
+	client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+
+	if _, err := client.Markets(context.Background(), &clobtypes.MarketsRequest{Limit: 5000}); err != nil {
+		t.Fatalf("Markets failed: %v", err)
+	}
+}
+
+func TestLimitPolicyReject(t *testing.T) {
+	doer := &staticDoer{responses: map[string]string{}}
+	base := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+	client := base.WithLimitPolicy(LimitReject)
+	ctx := context.Background()
+
+	_, err := client.Orders(ctx, &clobtypes.OrdersRequest{Limit: MaxOrdersLimit + 1})
+	var boundErr BoundedIntError
+	if !errors.As(err, &boundErr) {
+		t.Fatalf("expected BoundedIntError, got %v", err)
+	}
+	if boundErr.Max != MaxOrdersLimit || boundErr.Value != MaxOrdersLimit+1 {
+		t.Errorf("unexpected error fields: %+v", boundErr)
+	}
+
+	if _, err := client.SamplingMarkets(ctx, &clobtypes.MarketsRequest{Limit: MaxMarketsLimit + 1}); !errors.As(err, &boundErr) {
+		t.Errorf("expected BoundedIntError from SamplingMarkets, got %v", err)
+	}
+	if _, err := client.BuilderTrades(ctx, &clobtypes.BuilderTradesRequest{Limit: MaxTradesLimit + 1}); !errors.As(err, &boundErr) {
+		t.Errorf("expected BoundedIntError from BuilderTrades, got %v", err)
+	}
+}
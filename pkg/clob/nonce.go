@@ -0,0 +1,107 @@
+package clob
+
+import (
+	"math/big"
+	"sync"
+)
+
+// NonceManager hands out the nonce for new orders and tracks how many
+// orders were signed with it. Attach one to a client with WithNonceManager
+// and opt orders in with OrderBuilder.AutoNonce. It is safe for concurrent
+// use, so many goroutines can build orders from the same client.
+//
+// The CTF exchange only fills an order whose nonce equals the maker's
+// current on-chain nonce, so every open order shares that one value; a
+// nonce is not a per-order counter. Calling incrementNonce on the exchange
+// contract invalidates every order signed with the previous nonce at once.
+// That on-chain call is the only cancel-by-nonce primitive: the CLOB API has
+// no endpoint for it, and it is not sent by this SDK. Once the transaction
+// is mined, call Advance so that new orders carry the new nonce.
+type NonceManager struct {
+	mu      sync.Mutex
+	current *big.Int
+	issued  int
+}
+
+// NewNonceManager returns a manager whose current nonce is start, which
+// should match the maker's nonce on the exchange (zero for accounts that
+// never called incrementNonce). A nil start means zero.
+func NewNonceManager(start *big.Int) *NonceManager {
+	current := new(big.Int)
+	if start != nil {
+		current.Set(start)
+	}
+	return &NonceManager{current: current}
+}
+
+// Next returns the nonce for a new order and counts it as issued.
+func (m *NonceManager) Next() *big.Int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.issued++
+	return new(big.Int).Set(m.current)
+}
+
+// Current returns the nonce new orders are signed with, without issuing it.
+func (m *NonceManager) Current() *big.Int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return new(big.Int).Set(m.current)
+}
+
+// Issued returns how many orders have been given the current nonce, i.e.
+// how many orders an incrementNonce call would invalidate at most.
+func (m *NonceManager) Issued() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.issued
+}
+
+// Advance moves to the next nonce after incrementNonce has been mined on the
+// exchange and returns it. Orders issued with the previous nonce can no
+// longer be filled.
+func (m *NonceManager) Advance() *big.Int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.current = new(big.Int).Add(m.current, big.NewInt(1))
+	m.issued = 0
+	return new(big.Int).Set(m.current)
+}
+
+// Set resynchronizes the manager with the maker's nonce read from the
+// exchange contract. The issued count restarts when the nonce changes.
+func (m *NonceManager) Set(nonce *big.Int) {
+	if nonce == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.current.Cmp(nonce) != 0 {
+		m.issued = 0
+	}
+	m.current = new(big.Int).Set(nonce)
+}
+
+// WithNonceManager sets the nonce manager used by OrderBuilder.AutoNonce.
+func (c *clientImpl) WithNonceManager(m *NonceManager) Client {
+	return &clientImpl{
+		httpClient:        c.httpClient,
+		signer:            c.signer,
+		creds:             c.creds,
+		builderCfg:        c.builderCfg,
+		signatureType:     c.signatureType,
+		authNonce:         c.authNonce,
+		funder:            c.funder,
+		saltGenerator:     c.saltGenerator,
+		nonces:            m,
+		geoguard:          c.geoguard,
+		cache:             c.cache,
+		geoblockHost:      c.geoblockHost,
+		geoblockClient:    c.geoblockClient,
+		rfq:               c.rfq,
+		ws:                c.ws,
+		heartbeat:         c.heartbeat,
+		heartbeatInterval: c.heartbeatInterval,
+		limitPolicy:       c.limitPolicy,
+	}
+}
@@ -0,0 +1,74 @@
+package clob
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestNonceManager(t *testing.T) {
+	m := NewNonceManager(big.NewInt(3))
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if n := m.Next(); n.Int64() != 3 {
+				t.Errorf("Next = %s, want 3", n)
+			}
+		}()
+	}
+	wg.Wait()
+	if m.Issued() != 20 {
+		t.Errorf("Issued = %d, want 20", m.Issued())
+	}
+
+	m.Next().SetInt64(99) // callers get copies
+	if m.Current().Int64() != 3 {
+		t.Fatalf("Current = %s after mutating a returned nonce", m.Current())
+	}
+	if n := m.Advance(); n.Int64() != 4 || m.Issued() != 0 {
+		t.Errorf("Advance = %s with %d issued, want 4 with 0", n, m.Issued())
+	}
+	m.Next()
+	m.Set(big.NewInt(4))
+	if m.Issued() != 1 {
+		t.Errorf("Set to the same nonce reset the issued count")
+	}
+	m.Set(big.NewInt(7))
+	if m.Current().Int64() != 7 || m.Issued() != 0 {
+		t.Errorf("Set = %s with %d issued, want 7 with 0", m.Current(), m.Issued())
+	}
+}
+
+func TestOrderBuilderAutoNonce(t *testing.T) {
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	signer := mustSigner(t)
+	limit := func(client Client) *OrderBuilder {
+		return NewOrderBuilder(client, signer).TokenID("123").Side("BUY").Price(0.5).Size(10)
+	}
+
+	if _, err := limit(stub).AutoNonce().Build(); !errors.Is(err, ErrNoNonceManager) {
+		t.Fatalf("expected ErrNoNonceManager, got %v", err)
+	}
+
+	nonces := NewNonceManager(big.NewInt(5))
+	stub.clientImpl = stub.clientImpl.WithNonceManager(nonces).(*clientImpl)
+	order, err := limit(stub).AutoNonce().Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if order.Nonce.Int.Int64() != 5 || nonces.Issued() != 1 {
+		t.Errorf("nonce %s with %d issued, want 5 with 1", order.Nonce.Int, nonces.Issued())
+	}
+
+	order, err = limit(stub).AutoNonce().Nonce(big.NewInt(9)).Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if order.Nonce.Int.Int64() != 9 || nonces.Issued() != 1 {
+		t.Errorf("explicit Nonce should win over AutoNonce, got %s", order.Nonce.Int)
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/rfq"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 )
 
@@ -20,6 +22,7 @@ import (
 type OrderBuilder struct {
 	client Client
 	signer auth.Signer
+	creds  *apiCredentials
 
 	tokenID    string
 	side       string
@@ -34,9 +37,13 @@ type OrderBuilder struct {
 	funder        *common.Address
 	taker         *common.Address
 	nonce         *big.Int
+	autoNonce     bool
+	nonces        *NonceManager
 	expiration    *big.Int
 	signatureType *auth.SignatureType
 	postOnly      *bool
+	deferExec     *bool
+	reduceOnly    bool
 
 	saltGenerator SaltGenerator
 
@@ -58,6 +65,13 @@ const (
 	lotSizeScale = int32(2)
 )
 
+// GTDExpirationSkew is the local clock skew tolerated when checking that a
+// GTD expiration is in the future.
+const GTDExpirationSkew = 5 * time.Second
+
+// maxExpirationUnix (year 5138) bounds expirations given in seconds.
+const maxExpirationUnix = 100_000_000_000
+
 // SaltGenerator generates salts for new orders.
 type SaltGenerator func() (*big.Int, error)
 
@@ -69,28 +83,91 @@ func NewOrderBuilder(client Client, signer auth.Signer) *OrderBuilder {
 	}
 	if provider, ok := client.(interface{ orderDefaults() orderDefaults }); ok {
 		defaults := provider.orderDefaults()
+		builder.creds = defaults.creds
 		sigType := defaults.signatureType
 		builder.signatureType = &sigType
 		if defaults.funder != nil {
 			builder.funder = defaults.funder
 		}
 		builder.saltGenerator = defaults.saltGenerator
+		builder.nonces = defaults.nonces
 	}
 	return builder
 }
 
-// TokenID sets the token ID to trade.
+// TokenID sets the token ID to trade. Surrounding whitespace is trimmed.
 func (b *OrderBuilder) TokenID(tokenID string) *OrderBuilder {
-	b.tokenID = tokenID
+	b.tokenID = strings.TrimSpace(tokenID)
 	return b
 }
 
-// Side sets the trade side ("BUY" or "SELL").
+// Side sets the trade side ("BUY" or "SELL"). Case and surrounding
+// whitespace are ignored, so "buy" and " Buy " are accepted. Invalid values
+// are kept as given (trimmed) and reported by Validate and the build methods.
 func (b *OrderBuilder) Side(side string) *OrderBuilder {
+	side = strings.TrimSpace(side)
+	if parsed, err := clobtypes.ParseSide(side); err == nil {
+		side = string(parsed)
+	}
 	b.side = side
 	return b
 }
 
+// SideEnum sets the trade side from a typed clobtypes.Side.
+func (b *OrderBuilder) SideEnum(side clobtypes.Side) *OrderBuilder {
+	return b.Side(string(side))
+}
+
+// SetSide is Side for callers that want a bad side reported where it is
+// set: it normalizes side like Side but returns an InvalidSideError, leaving
+// the builder unchanged, when side is not BUY or SELL.
+func (b *OrderBuilder) SetSide(side string) error {
+	parsed, err := clobtypes.ParseSide(side)
+	if err != nil {
+		return &InvalidSideError{Side: strings.TrimSpace(side)}
+	}
+	b.side = string(parsed)
+	return nil
+}
+
+// Validate checks the fields that can be validated without contacting the
+// API: the token ID, the side, and either a positive price and size (limit
+// orders) or a positive amount (market orders). Build methods run the same
+// checks, so calling Validate is only needed to surface misuse early.
+func (b *OrderBuilder) Validate() error {
+	if b.tokenID == "" {
+		return ErrTokenIDRequired
+	}
+	if _, ok := new(big.Int).SetString(b.tokenID, 10); !ok {
+		return ErrInvalidTokenID
+	}
+	if _, err := b.validSide(); err != nil {
+		return err
+	}
+	if b.amount != nil {
+		if b.amount.value.Sign() <= 0 {
+			return &NotPositiveError{Field: "amount", Value: b.amount.value}
+		}
+		return nil
+	}
+	if b.price.Sign() <= 0 {
+		return &NotPositiveError{Field: "price", Value: b.price}
+	}
+	if b.size.Sign() <= 0 {
+		return &NotPositiveError{Field: "size", Value: b.size}
+	}
+	return nil
+}
+
+// validSide returns the normalized side or an InvalidSideError.
+func (b *OrderBuilder) validSide() (string, error) {
+	side := strings.ToUpper(strings.TrimSpace(b.side))
+	if !clobtypes.Side(side).Valid() {
+		return "", &InvalidSideError{Side: b.side}
+	}
+	return side, nil
+}
+
 // Price sets the price per share using a float64.
 func (b *OrderBuilder) Price(price float64) *OrderBuilder {
 	b.price = decimal.NewFromFloat(price)
@@ -136,9 +213,34 @@ func (b *OrderBuilder) TickSize(tickSize float64) *OrderBuilder {
 // Nonce overrides the order nonce.
 func (b *OrderBuilder) Nonce(nonce *big.Int) *OrderBuilder {
 	b.nonce = nonce
+	b.autoNonce = false
+	return b
+}
+
+// AutoNonce takes the order nonce from the client's NonceManager (see
+// Client.WithNonceManager) when the order is built. Build methods return
+// ErrNoNonceManager if the client has none.
+func (b *OrderBuilder) AutoNonce() *OrderBuilder {
+	b.autoNonce = true
+	b.nonce = nil
 	return b
 }
 
+// orderNonce returns the nonce to sign the order with: the explicit Nonce,
+// the next one from the NonceManager with AutoNonce, or zero.
+func (b *OrderBuilder) orderNonce() (*big.Int, error) {
+	if b.autoNonce {
+		if b.nonces == nil {
+			return nil, ErrNoNonceManager
+		}
+		return b.nonces.Next(), nil
+	}
+	if b.nonce != nil {
+		return b.nonce, nil
+	}
+	return big.NewInt(0), nil
+}
+
 // Maker overrides the maker address.
 func (b *OrderBuilder) Maker(maker common.Address) *OrderBuilder {
 	b.maker = &maker
@@ -163,12 +265,50 @@ func (b *OrderBuilder) PostOnly(postOnly bool) *OrderBuilder {
 	return b
 }
 
+// DeferExec sets the deferExec flag sent with the order. When true the server
+// accepts the order and defers its execution instead of matching it within
+// the POST /order request, so the response does not carry fill results.
+// Unset, the flag is omitted and the server default (immediate execution)
+// applies.
+func (b *OrderBuilder) DeferExec(deferExec bool) *OrderBuilder {
+	b.deferExec = &deferExec
+	return b
+}
+
+// ReduceOnly restricts the order to reducing an existing position.
+//
+// The CLOB has no server-side reduce-only flag, so this is enforced client-side:
+// only SELL orders are allowed, and the size is clamped to the conditional token
+// balance reported by BalanceAllowance when the order is built.
+func (b *OrderBuilder) ReduceOnly(reduceOnly bool) *OrderBuilder {
+	b.reduceOnly = reduceOnly
+	return b
+}
+
 // ExpirationUnix sets the expiration timestamp (seconds since epoch) for GTD orders.
 func (b *OrderBuilder) ExpirationUnix(timestamp int64) *OrderBuilder {
 	b.expiration = big.NewInt(timestamp)
 	return b
 }
 
+// ExpirationAt sets the expiration of a GTD order to t, truncated to whole
+// seconds. The exchange treats orders expiring within about a minute as
+// already expired, so leave at least that much headroom. A zero t clears the
+// expiration.
+func (b *OrderBuilder) ExpirationAt(t time.Time) *OrderBuilder {
+	if t.IsZero() {
+		b.expiration = nil
+		return b
+	}
+	b.expiration = big.NewInt(t.Unix())
+	return b
+}
+
+// ExpirationIn sets the expiration of a GTD order to d from now.
+func (b *OrderBuilder) ExpirationIn(d time.Duration) *OrderBuilder {
+	return b.ExpirationAt(time.Now().Add(d))
+}
+
 // AmountUSDC sets the amount for a market order in USDC.
 func (b *OrderBuilder) AmountUSDC(amount float64) *OrderBuilder {
 	b.amount = &marketAmount{
@@ -217,8 +357,10 @@ func (b *OrderBuilder) BuildSignableWithContext(ctx context.Context) (*clobtypes
 	if b.expiration != nil && b.expiration.Sign() > 0 && orderType != clobtypes.OrderTypeGTD {
 		return nil, fmt.Errorf("expiration is only supported for GTD orders")
 	}
-	if orderType == clobtypes.OrderTypeGTD && (b.expiration == nil || b.expiration.Sign() == 0) {
-		return nil, fmt.Errorf("GTD orders require a non-zero expiration")
+	if orderType == clobtypes.OrderTypeGTD {
+		if err := validateGTDExpiration(b.expiration, time.Now()); err != nil {
+			return nil, err
+		}
 	}
 	if b.postOnly != nil && *b.postOnly && orderType != clobtypes.OrderTypeGTC && orderType != clobtypes.OrderTypeGTD {
 		return nil, fmt.Errorf("postOnly is only supported for GTC and GTD orders")
@@ -228,9 +370,147 @@ func (b *OrderBuilder) BuildSignableWithContext(ctx context.Context) (*clobtypes
 		Order:     order,
 		OrderType: orderType,
 		PostOnly:  b.postOnly,
+		DeferExec: b.deferExec,
 	}, nil
 }
 
+// validateGTDExpiration checks that expiration is a Unix timestamp in seconds
+// that has not passed, allowing GTDExpirationSkew of clock skew.
+func validateGTDExpiration(expiration *big.Int, now time.Time) error {
+	if expiration == nil || expiration.Sign() == 0 {
+		return fmt.Errorf("GTD orders require a non-zero expiration")
+	}
+	if expiration.Sign() < 0 {
+		return fmt.Errorf("expiration must be non-negative")
+	}
+	// The CLOB documents no maximum expiry; values this large are almost
+	// always milliseconds passed where seconds are expected.
+	if !expiration.IsInt64() || expiration.Int64() >= maxExpirationUnix {
+		return fmt.Errorf("expiration %s is too large: it must be in seconds, not milliseconds", expiration)
+	}
+	if at := time.Unix(expiration.Int64(), 0); at.Before(now.Add(-GTDExpirationSkew)) {
+		return fmt.Errorf("expiration %s is in the past", at.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// SubmitLimit builds a limit order, signs it, and posts it using the builder's client.
+// Errors are wrapped with the stage that failed (build, sign, or post).
+func (b *OrderBuilder) SubmitLimit(ctx context.Context) (clobtypes.OrderResponse, error) {
+	signable, err := b.BuildSignableWithContext(ctx)
+	if err != nil {
+		return clobtypes.OrderResponse{}, fmt.Errorf("build order: %w", err)
+	}
+	return b.submit(ctx, signable)
+}
+
+// SubmitMarket builds a market order, signs it, and posts it using the builder's client.
+// Errors are wrapped with the stage that failed (build, sign, or post).
+func (b *OrderBuilder) SubmitMarket(ctx context.Context) (clobtypes.OrderResponse, error) {
+	signable, err := b.BuildMarketWithContext(ctx)
+	if err != nil {
+		return clobtypes.OrderResponse{}, fmt.Errorf("build order: %w", err)
+	}
+	return b.submit(ctx, signable)
+}
+
+// WouldCross reports whether the limit order described by the builder would
+// match resting orders on arrival, i.e. take liquidity instead of making it: a
+// BUY at or above the best ask, or a SELL at or below the best bid. Post-only
+// orders that would cross are rejected by the exchange. The price is validated
+// against the (cached) tick size before the order book is fetched.
+func (b *OrderBuilder) WouldCross(ctx context.Context) (bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if b.tokenID == "" {
+		return false, ErrTokenIDRequired
+	}
+	side, err := b.validSide()
+	if err != nil {
+		return false, err
+	}
+	if b.price.Sign() <= 0 {
+		return false, &NotPositiveError{Field: "price", Value: b.price}
+	}
+	tickSize, err := b.resolveTickSize(ctx, b.tokenID)
+	if err != nil {
+		return false, err
+	}
+	if decimalPlaces(b.price) > decimalPlaces(tickSize) {
+		return false, &TooManyDecimalsError{Field: "price", Value: b.price, Max: decimalPlaces(tickSize), TickSize: tickSize}
+	}
+
+	book, err := b.orderBook(ctx)
+	if err != nil {
+		return false, err
+	}
+	if side == "BUY" {
+		ask, ok := clobtypes.OrderBook(book).BestAsk()
+		return ok && b.price.GreaterThanOrEqual(ask), nil
+	}
+	bid, ok := clobtypes.OrderBook(book).BestBid()
+	return ok && b.price.LessThanOrEqual(bid), nil
+}
+
+func (b *OrderBuilder) submit(ctx context.Context, signable *clobtypes.SignableOrder) (clobtypes.OrderResponse, error) {
+	if b.client == nil {
+		return clobtypes.OrderResponse{}, fmt.Errorf("post order: client is required")
+	}
+	signed, err := b.sign(signable)
+	if err != nil {
+		return clobtypes.OrderResponse{}, err
+	}
+	resp, err := b.client.PostOrder(ctx, signed)
+	if err != nil {
+		return resp, fmt.Errorf("post order: %w", err)
+	}
+	return resp, nil
+}
+
+func (b *OrderBuilder) sign(signable *clobtypes.SignableOrder) (*clobtypes.SignedOrder, error) {
+	signed, err := signOrderWithCreds(b.signer, b.creds.get(), signable.Order, b.signatureType, b.funder, b.saltGenerator)
+	if err != nil {
+		return nil, fmt.Errorf("sign order: %w", err)
+	}
+	signed.OrderType = signable.OrderType
+	signed.PostOnly = signable.PostOnly
+	signed.DeferExec = signable.DeferExec
+	return signed, nil
+}
+
+// BuildRFQAccept builds and signs the limit order described by the builder and
+// maps it into an accept request for the given RFQ request and quote. The
+// order's amounts, side and token must match the quote being accepted.
+func (b *OrderBuilder) BuildRFQAccept(ctx context.Context, requestID, quoteID string) (*rfq.RFQAcceptRequest, error) {
+	signed, err := b.buildRFQSigned(ctx, requestID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	return rfq.BuildRFQAcceptRequestFromSignedOrder(requestID, quoteID, signed)
+}
+
+// BuildRFQApprove is like BuildRFQAccept but returns the quote approval
+// payload sent by the quoter.
+func (b *OrderBuilder) BuildRFQApprove(ctx context.Context, requestID, quoteID string) (*rfq.RFQApproveQuote, error) {
+	signed, err := b.buildRFQSigned(ctx, requestID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	return rfq.BuildRFQApproveQuoteFromSignedOrder(requestID, quoteID, signed)
+}
+
+func (b *OrderBuilder) buildRFQSigned(ctx context.Context, requestID, quoteID string) (*clobtypes.SignedOrder, error) {
+	if strings.TrimSpace(requestID) == "" || strings.TrimSpace(quoteID) == "" {
+		return nil, fmt.Errorf("requestID and quoteID are required")
+	}
+	signable, err := b.BuildSignableWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("build order: %w", err)
+	}
+	return b.sign(signable)
+}
+
 // BuildMarket constructs a market order and returns it with order type metadata.
 func (b *OrderBuilder) BuildMarket() (*clobtypes.SignableOrder, error) {
 	return b.BuildMarketWithContext(context.Background())
@@ -242,27 +522,27 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 		ctx = context.Background()
 	}
 	if b.tokenID == "" {
-		return nil, fmt.Errorf("token_id is required")
+		return nil, ErrTokenIDRequired
 	}
-	side := strings.ToUpper(strings.TrimSpace(b.side))
-	if side != "BUY" && side != "SELL" {
-		return nil, fmt.Errorf("side must be BUY or SELL")
+	side, err := b.validSide()
+	if err != nil {
+		return nil, err
 	}
 	if b.amount == nil {
 		return nil, fmt.Errorf("amount is required for market orders")
 	}
 	if b.amount.value.Sign() <= 0 {
-		return nil, fmt.Errorf("amount must be positive")
+		return nil, &NotPositiveError{Field: "amount", Value: b.amount.value}
 	}
 	amountScale := decimalPlaces(b.amount.value)
 	switch b.amount.kind {
 	case amountShares:
 		if amountScale > lotSizeScale {
-			return nil, fmt.Errorf("amount has too many decimal places (max %d)", lotSizeScale)
+			return nil, &TooManyDecimalsError{Field: "amount", Value: b.amount.value, Max: lotSizeScale}
 		}
 	case amountUSDC:
 		if amountScale > usdcDecimals {
-			return nil, fmt.Errorf("amount has too many decimal places (max %d)", usdcDecimals)
+			return nil, &TooManyDecimalsError{Field: "amount", Value: b.amount.value, Max: usdcDecimals}
 		}
 	default:
 		return nil, fmt.Errorf("unsupported market order amount")
@@ -282,7 +562,7 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 
 	tokenIDInt, ok := new(big.Int).SetString(b.tokenID, 10)
 	if !ok {
-		return nil, fmt.Errorf("invalid token_id format")
+		return nil, ErrInvalidTokenID
 	}
 
 	tickSize, err := b.resolveTickSize(ctx, b.tokenID)
@@ -293,12 +573,12 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 
 	var price decimal.Decimal
 	if b.price.Sign() < 0 {
-		return nil, fmt.Errorf("price must be positive")
+		return nil, &NotPositiveError{Field: "price", Value: b.price}
 	}
 	if b.price.Sign() > 0 {
 		price = b.price
 		if decimalPlaces(price) > tickScale {
-			return nil, fmt.Errorf("price has too many decimal places for tick size %s", tickSize.String())
+			return nil, &TooManyDecimalsError{Field: "price", Value: price, Max: tickScale, TickSize: tickSize}
 		}
 	} else {
 		var err error
@@ -310,7 +590,7 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 	price = price.Truncate(tickScale)
 	one := decimal.NewFromInt(1)
 	if price.LessThan(tickSize) || price.GreaterThan(one.Sub(tickSize)) {
-		return nil, fmt.Errorf("price %s is out of bounds for tick size %s", price.String(), tickSize.String())
+		return nil, &PriceOutOfBoundsError{Price: price, TickSize: tickSize}
 	}
 
 	feeRateBps, err := b.resolveFeeRateBps(ctx, b.tokenID)
@@ -320,6 +600,11 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 
 	truncScale := tickScale + lotSizeScale
 	rawAmount := b.amount.value
+	// USDC amounts are BUY-only, which reduce-only rejects, so only share amounts are clamped.
+	rawAmount, err = b.applyReduceOnly(ctx, side, rawAmount)
+	if err != nil {
+		return nil, err
+	}
 	var makerAmount, takerAmount decimal.Decimal
 
 	switch {
@@ -368,9 +653,9 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 		taker = *b.taker
 	}
 
-	nonce := big.NewInt(0)
-	if b.nonce != nil {
-		nonce = b.nonce
+	nonce, err := b.orderNonce()
+	if err != nil {
+		return nil, err
 	}
 
 	salt, err := b.generateSalt()
@@ -396,6 +681,7 @@ func (b *OrderBuilder) BuildMarketWithContext(ctx context.Context) (*clobtypes.S
 	return &clobtypes.SignableOrder{
 		Order:     order,
 		OrderType: orderType,
+		DeferExec: b.deferExec,
 	}, nil
 }
 
@@ -404,22 +690,22 @@ func (b *OrderBuilder) buildLimit(ctx context.Context) (*clobtypes.Order, error)
 		ctx = context.Background()
 	}
 	if b.tokenID == "" {
-		return nil, fmt.Errorf("token_id is required")
+		return nil, ErrTokenIDRequired
 	}
-	side := strings.ToUpper(strings.TrimSpace(b.side))
-	if side != "BUY" && side != "SELL" {
-		return nil, fmt.Errorf("side must be BUY or SELL")
+	side, err := b.validSide()
+	if err != nil {
+		return nil, err
 	}
 	if b.price.Sign() <= 0 {
-		return nil, fmt.Errorf("price must be positive")
+		return nil, &NotPositiveError{Field: "price", Value: b.price}
 	}
 	if b.size.Sign() <= 0 {
-		return nil, fmt.Errorf("size must be positive")
+		return nil, &NotPositiveError{Field: "size", Value: b.size}
 	}
 
 	tokenIDInt, ok := new(big.Int).SetString(b.tokenID, 10)
 	if !ok {
-		return nil, fmt.Errorf("invalid token_id format")
+		return nil, ErrInvalidTokenID
 	}
 
 	tickSize, err := b.resolveTickSize(ctx, b.tokenID)
@@ -430,38 +716,31 @@ func (b *OrderBuilder) buildLimit(ctx context.Context) (*clobtypes.Order, error)
 
 	price := b.price
 	if decimalPlaces(price) > tickScale {
-		return nil, fmt.Errorf("price has too many decimal places for tick size %s", tickSize.String())
+		return nil, &TooManyDecimalsError{Field: "price", Value: price, Max: tickScale, TickSize: tickSize}
 	}
 	one := decimal.NewFromInt(1)
 	if price.LessThan(tickSize) || price.GreaterThan(one.Sub(tickSize)) {
-		return nil, fmt.Errorf("price %s is out of bounds for tick size %s", price.String(), tickSize.String())
+		return nil, &PriceOutOfBoundsError{Price: price, TickSize: tickSize}
 	}
 
 	size := b.size
 	if decimalPlaces(size) > lotSizeScale {
-		return nil, fmt.Errorf("size has too many decimal places (max %d)", lotSizeScale)
+		return nil, &TooManyDecimalsError{Field: "size", Value: size, Max: lotSizeScale}
 	}
 	if size.Sign() <= 0 {
-		return nil, fmt.Errorf("size must be positive")
+		return nil, &NotPositiveError{Field: "size", Value: size}
 	}
-
-	feeRateBps, err := b.resolveFeeRateBps(ctx, b.tokenID)
+	size, err = b.applyReduceOnly(ctx, side, size)
 	if err != nil {
 		return nil, err
 	}
 
-	truncScale := tickScale + lotSizeScale
-	var makerAmount, takerAmount decimal.Decimal
-	if side == "BUY" {
-		takerAmount = size
-		makerAmount = size.Mul(price).Truncate(truncScale)
-	} else {
-		makerAmount = size
-		takerAmount = size.Mul(price).Truncate(truncScale)
+	feeRateBps, err := b.resolveFeeRateBps(ctx, b.tokenID)
+	if err != nil {
+		return nil, err
 	}
 
-	makerFixed := toFixedDecimal(makerAmount)
-	takerFixed := toFixedDecimal(takerAmount)
+	makerFixed, takerFixed := limitAmounts(side, size, price, tickScale)
 
 	sigType := int(auth.SignatureEOA)
 	if b.signatureType != nil {
@@ -492,9 +771,9 @@ func (b *OrderBuilder) buildLimit(ctx context.Context) (*clobtypes.Order, error)
 		taker = *b.taker
 	}
 
-	nonce := big.NewInt(0)
-	if b.nonce != nil {
-		nonce = b.nonce
+	nonce, err := b.orderNonce()
+	if err != nil {
+		return nil, err
 	}
 
 	salt, err := b.generateSalt()
@@ -599,10 +878,7 @@ func (b *OrderBuilder) resolveMarketPrice(ctx context.Context, side string, orde
 	if amount == nil {
 		return decimal.Decimal{}, fmt.Errorf("amount is required")
 	}
-	if b.client == nil || !clientHasTransport(b.client) {
-		return decimal.Decimal{}, fmt.Errorf("client is required to fetch order book")
-	}
-	book, err := b.client.OrderBook(ctx, &clobtypes.BookRequest{TokenID: b.tokenID})
+	book, err := b.orderBook(ctx)
 	if err != nil {
 		return decimal.Decimal{}, err
 	}
@@ -614,14 +890,14 @@ func (b *OrderBuilder) resolveMarketPrice(ctx context.Context, side string, orde
 	case "SELL":
 		levels = book.Bids
 	default:
-		return decimal.Decimal{}, fmt.Errorf("invalid side %q", side)
+		return decimal.Decimal{}, &InvalidSideError{Side: side}
 	}
 
 	if len(levels) == 0 {
-		return decimal.Decimal{}, fmt.Errorf("no opposing orders")
+		return decimal.Decimal{}, &InsufficientLiquidityError{Requested: amount.value}
 	}
 
-	firstPrice, err := decimal.NewFromString(levels[0].Price)
+	firstPrice, err := levels[0].PriceDec()
 	if err != nil {
 		return decimal.Decimal{}, fmt.Errorf("invalid price level: %w", err)
 	}
@@ -630,11 +906,11 @@ func (b *OrderBuilder) resolveMarketPrice(ctx context.Context, side string, orde
 	var cutoff *decimal.Decimal
 	for i := len(levels) - 1; i >= 0; i-- {
 		level := levels[i]
-		levelPrice, err := decimal.NewFromString(level.Price)
+		levelPrice, err := level.PriceDec()
 		if err != nil {
 			return decimal.Decimal{}, fmt.Errorf("invalid price level: %w", err)
 		}
-		levelSize, err := decimal.NewFromString(level.Size)
+		levelSize, err := level.SizeDec()
 		if err != nil {
 			return decimal.Decimal{}, fmt.Errorf("invalid size level: %w", err)
 		}
@@ -655,11 +931,56 @@ func (b *OrderBuilder) resolveMarketPrice(ctx context.Context, side string, orde
 		return *cutoff, nil
 	}
 	if orderType == clobtypes.OrderTypeFOK {
-		return decimal.Decimal{}, fmt.Errorf("insufficient liquidity to fill order")
+		return decimal.Decimal{}, &InsufficientLiquidityError{Requested: amount.value, Available: sum}
 	}
 	return firstPrice, nil
 }
 
+func (b *OrderBuilder) orderBook(ctx context.Context) (clobtypes.OrderBookResponse, error) {
+	if b.client == nil || !clientHasTransport(b.client) {
+		return clobtypes.OrderBookResponse{}, fmt.Errorf("client is required to fetch order book")
+	}
+	return b.client.OrderBook(ctx, &clobtypes.BookRequest{TokenID: b.tokenID})
+}
+
+// applyReduceOnly clamps a SELL size to the held conditional token balance when
+// reduce-only is enabled.
+func (b *OrderBuilder) applyReduceOnly(ctx context.Context, side string, size decimal.Decimal) (decimal.Decimal, error) {
+	if !b.reduceOnly {
+		return size, nil
+	}
+	if side != "SELL" {
+		return decimal.Zero, fmt.Errorf("reduce-only orders must be SELL orders")
+	}
+	if !clientHasTransport(b.client) {
+		return decimal.Zero, fmt.Errorf("reduce-only orders require a client to fetch the position balance")
+	}
+	req := &clobtypes.BalanceAllowanceRequest{
+		AssetType: clobtypes.AssetTypeConditional,
+		TokenID:   b.tokenID,
+	}
+	if b.signatureType != nil {
+		sigType := int(*b.signatureType)
+		req.SignatureType = &sigType
+	}
+	resp, err := b.client.BalanceAllowance(ctx, req)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("reduce-only balance lookup failed: %w", err)
+	}
+	balance, err := decimal.NewFromString(strings.TrimSpace(resp.Balance))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid balance %q: %w", resp.Balance, err)
+	}
+	position := balance.Shift(-usdcDecimals).Truncate(lotSizeScale)
+	if position.Sign() <= 0 {
+		return decimal.Zero, fmt.Errorf("reduce-only order has no position to reduce")
+	}
+	if size.GreaterThan(position) {
+		return position, nil
+	}
+	return size, nil
+}
+
 func clientHasTransport(client Client) bool {
 	if client == nil {
 		return false
@@ -673,6 +994,48 @@ func clientHasTransport(client Client) bool {
 	return true
 }
 
+// limitAmounts returns the fixed-point maker and taker amounts of a limit
+// order. BUY orders pay size*price USDC for size shares; SELL orders do the
+// reverse.
+func limitAmounts(side string, size, price decimal.Decimal, tickScale int32) (maker, taker decimal.Decimal) {
+	truncScale := tickScale + lotSizeScale
+	if side == "BUY" {
+		taker = size
+		maker = size.Mul(price).Truncate(truncScale)
+	} else {
+		maker = size
+		taker = size.Mul(price).Truncate(truncScale)
+	}
+	return toFixedDecimal(maker), toFixedDecimal(taker)
+}
+
+// SizeForNotional returns the order size, in shares, that spends (BUY) or
+// receives (SELL) at most notionalUSDC at price, together with the fixed-point
+// maker and taker amounts the order builder would sign. The price is truncated
+// to tickSize and the size to the exchange lot size, using the same rounding as
+// OrderBuilder. Invalid inputs (unknown side, non-positive amounts, or a price
+// outside the tick bounds) yield a zero size and nil amounts.
+func SizeForNotional(side string, notionalUSDC, price, tickSize decimal.Decimal) (size decimal.Decimal, maker, taker *big.Int) {
+	side = strings.ToUpper(strings.TrimSpace(side))
+	if side != "BUY" && side != "SELL" {
+		return decimal.Zero, nil, nil
+	}
+	if notionalUSDC.Sign() <= 0 || tickSize.Sign() <= 0 {
+		return decimal.Zero, nil, nil
+	}
+	tickScale := decimalPlaces(tickSize)
+	price = price.Truncate(tickScale)
+	if price.LessThan(tickSize) || price.GreaterThan(decimal.NewFromInt(1).Sub(tickSize)) {
+		return decimal.Zero, nil, nil
+	}
+	size = notionalUSDC.Div(price).Truncate(lotSizeScale)
+	if size.Sign() <= 0 {
+		return decimal.Zero, nil, nil
+	}
+	makerFixed, takerFixed := limitAmounts(side, size, price, tickScale)
+	return size, makerFixed.BigInt(), takerFixed.BigInt()
+}
+
 func decimalPlaces(d decimal.Decimal) int32 {
 	exp := d.Exponent()
 	if exp < 0 {
@@ -741,8 +1104,10 @@ func deriveMakerFromSignature(signer auth.Signer, sigType int) (common.Address,
 			return common.Address{}, fmt.Errorf("failed to derive safe wallet: %w", err)
 		}
 		return safe, nil
-	default:
+	case int(auth.SignatureEOA):
 		return signer.Address(), nil
+	default:
+		return common.Address{}, fmt.Errorf("%w: %d", ErrUnsupportedSignatureType, sigType)
 	}
 }
 
@@ -753,6 +1118,14 @@ func (b *OrderBuilder) UseProxy() *OrderBuilder {
 	return b
 }
 
+// UseMagic sets the order to use the user's Magic.link (email) wallet. Magic
+// wallets are Polymarket Proxy wallets, so this is equivalent to UseProxy.
+func (b *OrderBuilder) UseMagic() *OrderBuilder {
+	t := auth.SignatureMagic
+	b.signatureType = &t
+	return b
+}
+
 // UseSafe sets the order to use the user's Gnosis Safe.
 func (b *OrderBuilder) UseSafe() *OrderBuilder {
 	t := auth.SignatureGnosisSafe
@@ -2,6 +2,8 @@ package clob
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 	"testing"
@@ -124,6 +126,148 @@ func TestBuildMarketFOKInsufficientLiquidity(t *testing.T) {
 	if err == nil || !strings.Contains(err.Error(), "insufficient liquidity") {
 		t.Fatalf("expected insufficient liquidity error, got %v", err)
 	}
+	var liqErr *InsufficientLiquidityError
+	if !errors.As(err, &liqErr) || !errors.Is(err, ErrInsufficientLiquidity) {
+		t.Fatalf("expected InsufficientLiquidityError, got %T", err)
+	}
+	if liqErr.Requested.String() != "100" || liqErr.Available.String() != "0.6" {
+		t.Errorf("unexpected liquidity values: requested=%s available=%s", liqErr.Requested, liqErr.Available)
+	}
+}
+
+func TestOrderBuilderTypedErrors(t *testing.T) {
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	signer := mustSigner(t)
+
+	limit := func() *OrderBuilder {
+		return NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").Price(0.5).Size(10)
+	}
+	tests := []struct {
+		name    string
+		build   func() error
+		target  error
+		message string
+	}{
+		{"side", func() error { _, err := limit().Side("HOLD").Build(); return err }, ErrInvalidSide, "side must be BUY or SELL"},
+		{"token", func() error { _, err := limit().TokenID("").Build(); return err }, ErrTokenIDRequired, "token_id is required"},
+		{"price decimals", func() error { _, err := limit().Price(0.505).Build(); return err }, ErrTooManyDecimals, "price has too many decimal places for tick size 0.01"},
+		{"size decimals", func() error { _, err := limit().Size(1.234).Build(); return err }, ErrTooManyDecimals, "size has too many decimal places (max 2)"},
+		{"price bounds", func() error { _, err := limit().Price(1).Build(); return err }, ErrPriceOutOfBounds, "price 1 is out of bounds for tick size 0.01"},
+		{"size positive", func() error { _, err := limit().Size(-1).Build(); return err }, ErrNotPositive, "size must be positive"},
+	}
+	for _, tt := range tests {
+		err := tt.build()
+		if !errors.Is(err, tt.target) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.target, err)
+			continue
+		}
+		if tt.message != "" && err.Error() != tt.message {
+			t.Errorf("%s: message = %q, want %q", tt.name, err.Error(), tt.message)
+		}
+	}
+
+	_, err := limit().Price(0.505).Build()
+	var decErr *TooManyDecimalsError
+	if !errors.As(err, &decErr) || decErr.Field != "price" || decErr.Value.String() != "0.505" {
+		t.Errorf("expected price TooManyDecimalsError carrying the value, got %#v", err)
+	}
+	_, err = limit().Side("hold").Build()
+	var sideErr *InvalidSideError
+	if !errors.As(err, &sideErr) || sideErr.Side != "hold" {
+		t.Errorf("expected InvalidSideError carrying the side, got %#v", err)
+	}
+}
+
+func TestOrderBuilderValidate(t *testing.T) {
+	stub := newStubClient()
+	signer := mustSigner(t)
+
+	b := NewOrderBuilder(stub, signer).TokenID(" 123\n").Side(" Buy ").Price(0.5).Size(10)
+	if b.side != "BUY" || b.tokenID != "123" {
+		t.Fatalf("side/token not normalized: %q/%q", b.side, b.tokenID)
+	}
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		b      *OrderBuilder
+		target error
+	}{
+		{"side", NewOrderBuilder(stub, signer).TokenID("123").Side("long").Price(0.5).Size(10), ErrInvalidSide},
+		{"missing side", NewOrderBuilder(stub, signer).TokenID("123").Price(0.5).Size(10), ErrInvalidSide},
+		{"token", NewOrderBuilder(stub, signer).Side("SELL").Price(0.5).Size(10), ErrTokenIDRequired},
+		{"token digits", NewOrderBuilder(stub, signer).TokenID("abc").Side("SELL").Price(0.5).Size(10), ErrInvalidTokenID},
+		{"price", NewOrderBuilder(stub, signer).TokenID("123").Side("SELL").Size(10), ErrNotPositive},
+		{"amount", NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").AmountUSDC(0), ErrNotPositive},
+	}
+	for _, tt := range tests {
+		if err := tt.b.Validate(); !errors.Is(err, tt.target) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.target, err)
+		}
+	}
+	if err := NewOrderBuilder(stub, signer).TokenID("123").Side("sell").AmountShares(5).Validate(); err != nil {
+		t.Errorf("market Validate failed: %v", err)
+	}
+}
+
+func TestOrderBuilderSetSide(t *testing.T) {
+	b := NewOrderBuilder(newStubClient(), mustSigner(t))
+	if err := b.SetSide(" sell "); err != nil || b.side != "SELL" {
+		t.Fatalf("SetSide = %v, side %q; want SELL", err, b.side)
+	}
+	err := b.SetSide("hold")
+	var sideErr *InvalidSideError
+	if !errors.As(err, &sideErr) || sideErr.Side != "hold" || !errors.Is(err, ErrInvalidSide) {
+		t.Fatalf("expected InvalidSideError for hold, got %#v", err)
+	}
+	if b.side != "SELL" {
+		t.Errorf("a rejected side changed the builder to %q", b.side)
+	}
+}
+
+func TestOrderBuilderGTDExpiration(t *testing.T) {
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	signer := mustSigner(t)
+
+	gtd := func() *OrderBuilder {
+		return NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").Price(0.5).Size(10).OrderType(clobtypes.OrderTypeGTD)
+	}
+
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+	signable, err := gtd().ExpirationAt(at).BuildSignable()
+	if err != nil {
+		t.Fatalf("ExpirationAt: %v", err)
+	}
+	if signable.Order.Expiration.Int.Int64() != at.Unix() {
+		t.Errorf("expiration = %s, want %d", signable.Order.Expiration.Int, at.Unix())
+	}
+	if _, err := gtd().ExpirationIn(10 * time.Minute).BuildSignable(); err != nil {
+		t.Errorf("ExpirationIn: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		b       *OrderBuilder
+		message string
+	}{
+		{"missing", gtd(), "non-zero expiration"},
+		{"cleared", gtd().ExpirationIn(time.Hour).ExpirationAt(time.Time{}), "non-zero expiration"},
+		{"past", gtd().ExpirationIn(-time.Minute), "in the past"},
+		{"milliseconds", gtd().ExpirationUnix(time.Now().Add(time.Hour).UnixMilli()), "not milliseconds"},
+	}
+	for _, tt := range tests {
+		if _, err := tt.b.BuildSignable(); err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.message, err)
+		}
+	}
+
+	if _, err := gtd().ExpirationIn(-GTDExpirationSkew / 2).BuildSignable(); err != nil {
+		t.Errorf("expiration within skew tolerance rejected: %v", err)
+	}
 }
 
 func TestBuildMarketFAKUsesTopPriceWhenInsufficient(t *testing.T) {
@@ -184,6 +328,21 @@ func TestBuildLimitOrder(t *testing.T) {
 		}
 	})
 
+	t.Run("SideEnum", func(t *testing.T) {
+		order, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			SideEnum(clobtypes.SideSell).
+			Price(0.5).
+			Size(100).
+			BuildWithContext(ctx)
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+		if order.Side != string(clobtypes.SideSell) {
+			t.Errorf("side = %q, want SELL", order.Side)
+		}
+	})
+
 	t.Run("SignableLimit", func(t *testing.T) {
 		postOnly := true
 		signable, err := NewOrderBuilder(stub, signer).
@@ -231,6 +390,22 @@ func TestBuildLimitOrder(t *testing.T) {
 		if signable.Order.SignatureType == nil || *signable.Order.SignatureType != 2 {
 			t.Errorf("safe type mismatch")
 		}
+
+		// Magic wallets are proxy wallets
+		signable, err = builder.UseMagic().BuildMarketWithContext(ctx)
+		if err != nil {
+			t.Fatalf("Magic derivation failed: %v", err)
+		}
+		proxy, _ := auth.DeriveProxyWallet(signer.Address())
+		if signable.Order.SignatureType == nil || *signable.Order.SignatureType != 1 || signable.Order.Maker != proxy {
+			t.Errorf("magic wallet mismatch: %+v", signable.Order)
+		}
+
+		unsupported := auth.SignatureType(7)
+		builder.signatureType = &unsupported
+		if _, err := builder.BuildMarketWithContext(ctx); !errors.Is(err, ErrUnsupportedSignatureType) {
+			t.Errorf("expected ErrUnsupportedSignatureType, got %v", err)
+		}
 	})
 }
 
@@ -287,3 +462,322 @@ func TestOrderBuilderFunderRequiresSignature(t *testing.T) {
 		t.Fatalf("expected funder signature error, got %v", err)
 	}
 }
+
+func TestOrderBuilderSubmit(t *testing.T) {
+	ctx := context.Background()
+	signer := mustSigner(t)
+	apiKey := &auth.APIKey{Key: "owner-key", Secret: "secret", Passphrase: "pass"}
+
+	t.Run("SubmitLimit", func(t *testing.T) {
+		stub := newStubClient()
+		stub.clientImpl.creds = newAPICredentials(apiKey)
+		stub.tickSize = 0.01
+		resp, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			Size(10).
+			SubmitLimit(ctx)
+		if err != nil {
+			t.Fatalf("SubmitLimit failed: %v", err)
+		}
+		if resp.ID != "posted" || len(stub.posted) != 1 {
+			t.Fatalf("expected one posted order, got %d", len(stub.posted))
+		}
+		posted := stub.posted[0]
+		if posted.Owner != "owner-key" || posted.Signature == "" {
+			t.Errorf("unexpected signed order: %+v", posted)
+		}
+		if posted.OrderType != clobtypes.OrderTypeGTC {
+			t.Errorf("expected GTC order type, got %q", posted.OrderType)
+		}
+	})
+
+	t.Run("SubmitLimitDeferExec", func(t *testing.T) {
+		stub := newStubClient()
+		stub.clientImpl.creds = newAPICredentials(apiKey)
+		stub.tickSize = 0.01
+		signable, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			Size(10).
+			DeferExec(true).
+			BuildSignableWithContext(ctx)
+		if err != nil {
+			t.Fatalf("BuildSignable failed: %v", err)
+		}
+		if signable.DeferExec == nil || !*signable.DeferExec {
+			t.Fatalf("expected DeferExec on signable order, got %v", signable.DeferExec)
+		}
+		if _, err := NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").Price(0.5).Size(10).DeferExec(true).SubmitLimit(ctx); err != nil {
+			t.Fatalf("SubmitLimit failed: %v", err)
+		}
+		if len(stub.posted) != 1 || stub.posted[0].DeferExec == nil || !*stub.posted[0].DeferExec {
+			t.Fatalf("expected DeferExec on posted order, got %+v", stub.posted)
+		}
+	})
+
+	t.Run("SubmitMarket", func(t *testing.T) {
+		stub := newStubClient()
+		stub.clientImpl.creds = newAPICredentials(apiKey)
+		stub.tickSize = 0.01
+		_, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			AmountUSDC(5).
+			SubmitMarket(ctx)
+		if err != nil {
+			t.Fatalf("SubmitMarket failed: %v", err)
+		}
+		if len(stub.posted) != 1 || stub.posted[0].OrderType != clobtypes.OrderTypeFAK {
+			t.Fatalf("expected one FAK order posted, got %+v", stub.posted)
+		}
+	})
+
+	t.Run("BuildStageError", func(t *testing.T) {
+		stub := newStubClient()
+		_, err := NewOrderBuilder(stub, signer).Side("BUY").SubmitLimit(ctx)
+		if err == nil || !strings.HasPrefix(err.Error(), "build order:") {
+			t.Fatalf("expected build stage error, got %v", err)
+		}
+	})
+
+	t.Run("SignStageError", func(t *testing.T) {
+		stub := newStubClient()
+		stub.tickSize = 0.01
+		_, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			Size(10).
+			SubmitLimit(ctx)
+		if err == nil || !strings.HasPrefix(err.Error(), "sign order:") {
+			t.Fatalf("expected sign stage error, got %v", err)
+		}
+	})
+
+	t.Run("PostStageError", func(t *testing.T) {
+		stub := newStubClient()
+		stub.clientImpl.creds = newAPICredentials(apiKey)
+		stub.tickSize = 0.01
+		stub.postErr = fmt.Errorf("rejected")
+		_, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			Size(10).
+			SubmitLimit(ctx)
+		if err == nil || !strings.HasPrefix(err.Error(), "post order:") {
+			t.Fatalf("expected post stage error, got %v", err)
+		}
+	})
+}
+
+func TestOrderBuilderReduceOnly(t *testing.T) {
+	ctx := context.Background()
+	signer := mustSigner(t)
+
+	t.Run("ClampsLimitSize", func(t *testing.T) {
+		stub := newStubClient()
+		stub.tickSize = 0.01
+		stub.balance = "4250000" // 4.25 shares
+		order, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("SELL").
+			Price(0.5).
+			Size(10).
+			ReduceOnly(true).
+			BuildWithContext(ctx)
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+		if got := decimal.Decimal(order.MakerAmount).String(); got != "4250000" {
+			t.Errorf("expected maker amount clamped to 4250000, got %s", got)
+		}
+		if len(stub.balanceReqs) != 1 || stub.balanceReqs[0].AssetType != clobtypes.AssetTypeConditional || stub.balanceReqs[0].TokenID != "123" {
+			t.Errorf("unexpected balance request: %+v", stub.balanceReqs)
+		}
+	})
+
+	t.Run("KeepsSmallerSize", func(t *testing.T) {
+		stub := newStubClient()
+		stub.tickSize = 0.01
+		stub.balance = "100000000"
+		signable, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("SELL").
+			Price(0.5).
+			AmountShares(3).
+			ReduceOnly(true).
+			BuildMarketWithContext(ctx)
+		if err != nil {
+			t.Fatalf("BuildMarket failed: %v", err)
+		}
+		if got := decimal.Decimal(signable.Order.MakerAmount).String(); got != "3000000" {
+			t.Errorf("expected maker amount 3000000, got %s", got)
+		}
+	})
+
+	t.Run("RejectsBuy", func(t *testing.T) {
+		stub := newStubClient()
+		stub.tickSize = 0.01
+		_, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("BUY").
+			Price(0.5).
+			Size(10).
+			ReduceOnly(true).
+			BuildWithContext(ctx)
+		if err == nil || !strings.Contains(err.Error(), "SELL") {
+			t.Fatalf("expected reduce-only BUY rejection, got %v", err)
+		}
+	})
+
+	t.Run("NoPosition", func(t *testing.T) {
+		stub := newStubClient()
+		stub.tickSize = 0.01
+		stub.balance = "0"
+		_, err := NewOrderBuilder(stub, signer).
+			TokenID("123").
+			Side("SELL").
+			Price(0.5).
+			Size(10).
+			ReduceOnly(true).
+			BuildWithContext(ctx)
+		if err == nil || !strings.Contains(err.Error(), "no position") {
+			t.Fatalf("expected no position error, got %v", err)
+		}
+	})
+}
+
+func TestSizeForNotional(t *testing.T) {
+	tick := decimal.RequireFromString("0.01")
+	notional := decimal.NewFromInt(10)
+
+	size, maker, taker := SizeForNotional("buy", notional, decimal.RequireFromString("0.333"), tick)
+	if size.String() != "30.3" || maker.String() != "9999000" || taker.String() != "30300000" {
+		t.Fatalf("BUY = %s/%s/%s, want 30.3/9999000/30300000", size, maker, taker)
+	}
+
+	size, maker, taker = SizeForNotional("SELL", notional, decimal.RequireFromString("0.33"), tick)
+	if size.String() != "30.3" || maker.String() != "30300000" || taker.String() != "9999000" {
+		t.Fatalf("SELL = %s/%s/%s, want 30.3/30300000/9999000", size, maker, taker)
+	}
+
+	// The amounts match what the builder signs for the same size and price.
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	order, err := NewOrderBuilder(stub, mustSigner(t)).
+		TokenID("123").
+		Side("BUY").
+		PriceDec(decimal.RequireFromString("0.33")).
+		SizeDec(decimal.RequireFromString("30.3")).
+		BuildWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if decimal.Decimal(order.MakerAmount).String() != "9999000" || decimal.Decimal(order.TakerAmount).String() != "30300000" {
+		t.Errorf("builder amounts = %s/%s", decimal.Decimal(order.MakerAmount), decimal.Decimal(order.TakerAmount))
+	}
+
+	invalid := []struct {
+		side            string
+		notional, price decimal.Decimal
+	}{
+		{"HOLD", notional, decimal.RequireFromString("0.5")},
+		{"BUY", decimal.Zero, decimal.RequireFromString("0.5")},
+		{"BUY", notional, decimal.RequireFromString("0.004")},
+		{"BUY", decimal.RequireFromString("0.001"), decimal.RequireFromString("0.5")},
+	}
+	for _, tt := range invalid {
+		size, maker, taker := SizeForNotional(tt.side, tt.notional, tt.price, tick)
+		if !size.IsZero() || maker != nil || taker != nil {
+			t.Errorf("SizeForNotional(%s, %s, %s) = %s/%v/%v, want zero", tt.side, tt.notional, tt.price, size, maker, taker)
+		}
+	}
+}
+
+func TestOrderBuilderWouldCross(t *testing.T) {
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	stub.book = clobtypes.OrderBookResponse{
+		Bids: []clobtypes.PriceLevel{{Price: "0.40", Size: "10"}, {Price: "0.45", Size: "5"}},
+		Asks: []clobtypes.PriceLevel{{Price: "0.60", Size: "7"}, {Price: "0.55", Size: "2"}},
+	}
+	signer := mustSigner(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		side  string
+		price float64
+		want  bool
+	}{
+		{"BUY", 0.50, false},
+		{"BUY", 0.55, true},
+		{"BUY", 0.58, true},
+		{"SELL", 0.50, false},
+		{"SELL", 0.45, true},
+		{"sell", 0.41, true},
+	}
+	for _, tt := range tests {
+		got, err := NewOrderBuilder(stub, signer).TokenID("123").Side(tt.side).Price(tt.price).Size(10).WouldCross(ctx)
+		if err != nil {
+			t.Fatalf("%s@%v: %v", tt.side, tt.price, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s@%v: WouldCross = %v, want %v", tt.side, tt.price, got, tt.want)
+		}
+	}
+
+	if _, err := NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").Price(0.555).WouldCross(ctx); !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("expected tick size validation error, got %v", err)
+	}
+
+	stub.book = clobtypes.OrderBookResponse{}
+	if got, err := NewOrderBuilder(stub, signer).TokenID("123").Side("BUY").Price(0.99).WouldCross(ctx); err != nil || got {
+		t.Errorf("empty book: WouldCross = %v, %v", got, err)
+	}
+}
+
+func TestOrderBuilderBuildRFQ(t *testing.T) {
+	ctx := context.Background()
+	signer := mustSigner(t)
+	stub := newStubClient()
+	stub.clientImpl.creds = newAPICredentials(&auth.APIKey{Key: "owner-key", Secret: "secret", Passphrase: "pass"})
+	stub.tickSize = 0.01
+	builder := func() *OrderBuilder {
+		return NewOrderBuilder(stub, signer).TokenID("123").Side("SELL").Price(0.4).Size(25)
+	}
+
+	accept, err := builder().BuildRFQAccept(ctx, "req-1", "quote-1")
+	if err != nil {
+		t.Fatalf("BuildRFQAccept failed: %v", err)
+	}
+	if accept.RequestID != "req-1" || accept.QuoteIDV2 != "quote-1" || accept.Owner != "owner-key" {
+		t.Errorf("unexpected ids/owner: %+v", accept)
+	}
+	// SELL 25 @ 0.4: maker gives 25 shares, taker pays 10 USDC (6 decimals).
+	if accept.TokenID != "123" || accept.Side != "SELL" || accept.MakerAmount != "25000000" || accept.TakerAmount != "10000000" {
+		t.Errorf("unexpected order fields: %+v", accept)
+	}
+	if accept.Signer != signer.Address().Hex() || accept.Signature == "" || accept.Salt == "" {
+		t.Errorf("missing signature fields: %+v", accept)
+	}
+
+	approve, err := builder().BuildRFQApprove(ctx, "req-1", "quote-1")
+	if err != nil {
+		t.Fatalf("BuildRFQApprove failed: %v", err)
+	}
+	if approve.QuoteIDV2 != "quote-1" || approve.MakerAmount != "25000000" || approve.Signature == "" {
+		t.Errorf("unexpected approve payload: %+v", approve)
+	}
+
+	if _, err := builder().BuildRFQAccept(ctx, "", "quote-1"); err == nil {
+		t.Error("expected error for missing request id")
+	}
+	if _, err := NewOrderBuilder(stub, signer).Side("SELL").BuildRFQAccept(ctx, "req-1", "quote-1"); err == nil || !strings.HasPrefix(err.Error(), "build order:") {
+		t.Errorf("expected build stage error, got %v", err)
+	}
+}
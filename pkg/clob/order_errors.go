@@ -0,0 +1,124 @@
+package clob
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Order validation errors returned by OrderBuilder. The typed errors below
+// carry the offending values and match these sentinels with errors.Is.
+var (
+	ErrTokenIDRequired       = errors.New("token_id is required")
+	ErrInvalidTokenID        = errors.New("invalid token_id format")
+	ErrInvalidSide           = errors.New("side must be BUY or SELL")
+	ErrNotPositive           = errors.New("value must be positive")
+	ErrTooManyDecimals       = errors.New("too many decimal places")
+	ErrPriceOutOfBounds      = errors.New("price out of bounds")
+	ErrInsufficientLiquidity = errors.New("insufficient liquidity to fill order")
+	// ErrUnsupportedSignatureType is returned when the maker cannot be derived
+	// for a signature type, rather than signing with the wrong maker.
+	ErrUnsupportedSignatureType = errors.New("unsupported signature type")
+	// ErrNoNonceManager is returned when AutoNonce is used on a builder
+	// whose client has no NonceManager.
+	ErrNoNonceManager = errors.New("auto nonce requires a client NonceManager")
+)
+
+// InvalidSideError reports a side other than BUY or SELL.
+type InvalidSideError struct {
+	Side string
+}
+
+func (e *InvalidSideError) Error() string { return ErrInvalidSide.Error() }
+
+func (e *InvalidSideError) Is(target error) bool { return target == ErrInvalidSide }
+
+// NotPositiveError reports a price, size or amount that is zero or negative.
+type NotPositiveError struct {
+	Field string
+	Value decimal.Decimal
+}
+
+func (e *NotPositiveError) Error() string { return e.Field + " must be positive" }
+
+func (e *NotPositiveError) Is(target error) bool { return target == ErrNotPositive }
+
+// TooManyDecimalsError reports a value with more decimal places than allowed.
+// For prices the limit comes from TickSize; otherwise Max is the limit.
+type TooManyDecimalsError struct {
+	Field    string
+	Value    decimal.Decimal
+	Max      int32
+	TickSize decimal.Decimal
+}
+
+func (e *TooManyDecimalsError) Error() string {
+	if !e.TickSize.IsZero() {
+		return fmt.Sprintf("%s has too many decimal places for tick size %s", e.Field, e.TickSize.String())
+	}
+	return fmt.Sprintf("%s has too many decimal places (max %d)", e.Field, e.Max)
+}
+
+func (e *TooManyDecimalsError) Is(target error) bool { return target == ErrTooManyDecimals }
+
+// PriceOutOfBoundsError reports a price outside [TickSize, 1-TickSize].
+type PriceOutOfBoundsError struct {
+	Price    decimal.Decimal
+	TickSize decimal.Decimal
+}
+
+func (e *PriceOutOfBoundsError) Error() string {
+	return fmt.Sprintf("price %s is out of bounds for tick size %s", e.Price.String(), e.TickSize.String())
+}
+
+func (e *PriceOutOfBoundsError) Is(target error) bool { return target == ErrPriceOutOfBounds }
+
+// InsufficientLiquidityError reports that the opposing side of the book cannot
+// fill a market order. Requested and Available are in the unit of the order
+// amount (USDC or shares); an empty book has Available of zero.
+type InsufficientLiquidityError struct {
+	Requested decimal.Decimal
+	Available decimal.Decimal
+}
+
+func (e *InsufficientLiquidityError) Error() string {
+	if e.Available.IsZero() {
+		return "no opposing orders"
+	}
+	return ErrInsufficientLiquidity.Error()
+}
+
+func (e *InsufficientLiquidityError) Is(target error) bool {
+	return target == ErrInsufficientLiquidity
+}
+
+// ErrCancelNotConfirmed is returned (wrapped in a CancelReplaceError) when the
+// server response does not confirm the cancellation of the original order.
+var ErrCancelNotConfirmed = errors.New("cancel not confirmed")
+
+// ErrReplacementRejected is returned (wrapped in a CancelReplaceError) when
+// the CLOB answers the replacement order with success=false or an errorMsg.
+var ErrReplacementRejected = errors.New("replacement order rejected")
+
+// CancelReplaceError reports a failed CancelAndReplace. When Canceled is
+// false the cancel step failed or was not confirmed, nothing was posted and
+// the original order may still be live. When Canceled is true the original
+// order is gone but the replacement was not accepted; there is no rollback,
+// so the caller is flat and must decide whether to re-post.
+type CancelReplaceError struct {
+	OrderID  string
+	Canceled bool
+	Err      error
+}
+
+func (e *CancelReplaceError) Error() string {
+	if e.Canceled {
+		return fmt.Sprintf("order %s canceled but replacement failed: %v", e.OrderID, e.Err)
+	}
+	return fmt.Sprintf("cancel order %s: %v", e.OrderID, e.Err)
+}
+
+func (e *CancelReplaceError) Unwrap() error {
+	return e.Err
+}
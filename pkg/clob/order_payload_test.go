@@ -1,7 +1,7 @@
 package clob
 
 import (
-	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"encoding/json"
 	"math/big"
 	"strings"
 	"testing"
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 )
 
@@ -62,6 +63,53 @@ func TestBuildOrderPayloadCasingAndOptions(t *testing.T) {
 	}
 }
 
+func TestBuildOrderPayloadDeferExec(t *testing.T) {
+	order := clobtypes.SignedOrder{
+		Order: clobtypes.Order{
+			Salt:        types.U256{Int: big.NewInt(1)},
+			TokenID:     types.U256{Int: big.NewInt(123)},
+			MakerAmount: decimal.NewFromInt(100),
+			TakerAmount: decimal.NewFromInt(50),
+			Side:        "BUY",
+			Expiration:  types.U256{Int: big.NewInt(0)},
+			FeeRateBps:  decimal.NewFromInt(0),
+			Nonce:       types.U256{Int: big.NewInt(0)},
+		},
+		Signature: "0xsig",
+		Owner:     "owner",
+	}
+
+	for _, tc := range []struct {
+		name      string
+		deferExec *bool
+		want      string
+	}{
+		{name: "unset"},
+		{name: "true", deferExec: boolPtr(true), want: `"deferExec":true`},
+		{name: "false", deferExec: boolPtr(false), want: `"deferExec":false`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			order.DeferExec = tc.deferExec
+			payload, err := buildOrderPayload(&order)
+			if err != nil {
+				t.Fatalf("buildOrderPayload failed: %v", err)
+			}
+			data, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("marshal failed: %v", err)
+			}
+			body := string(data)
+			if tc.want == "" {
+				if strings.Contains(body, "deferExec") {
+					t.Errorf("expected no deferExec field, got %s", body)
+				}
+			} else if !strings.Contains(body, tc.want) {
+				t.Errorf("expected %s in %s", tc.want, body)
+			}
+		})
+	}
+}
+
 func TestBuildOrderPayloadPostOnlyValidation(t *testing.T) {
 	sigType := 0
 	order := clobtypes.SignedOrder{
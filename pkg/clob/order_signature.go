@@ -0,0 +1,182 @@
+package clob
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/config"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// signingExchange returns the verifying contract used when signing orders
+// on chainID, falling back to the Polygon exchange for unknown chains.
+func signingExchange(chainID *big.Int) common.Address {
+	if chainID != nil {
+		if cfg, ok := config.Lookup(chainID.Int64()); ok {
+			return cfg.Exchange
+		}
+	}
+	return config.Polygon.Exchange
+}
+
+var orderTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Order": {
+		{Name: "salt", Type: "uint256"},
+		{Name: "maker", Type: "address"},
+		{Name: "signer", Type: "address"},
+		{Name: "taker", Type: "address"},
+		{Name: "tokenId", Type: "uint256"},
+		{Name: "makerAmount", Type: "uint256"},
+		{Name: "takerAmount", Type: "uint256"},
+		{Name: "expiration", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "feeRateBps", Type: "uint256"},
+		{Name: "side", Type: "uint8"},
+		{Name: "signatureType", Type: "uint8"},
+	},
+}
+
+// orderTypedData returns the EIP-712 payload of order as signed by signer for
+// the given exchange contract.
+func orderTypedData(order *clobtypes.Order, signer common.Address, sigType int, chainID *big.Int, exchange common.Address) apitypes.TypedData {
+	sideInt := 0
+	if strings.ToUpper(order.Side) == "SELL" {
+		sideInt = 1
+	}
+	return apitypes.TypedData{
+		Types:       orderTypes,
+		PrimaryType: "Order",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Polymarket CTF Exchange",
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: exchange.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"salt":          (*math.HexOrDecimal256)(u256OrZero(order.Salt)),
+			"maker":         order.Maker.String(),
+			"signer":        signer.String(),
+			"taker":         order.Taker.String(),
+			"tokenId":       (*math.HexOrDecimal256)(u256OrZero(order.TokenID)),
+			"makerAmount":   (*math.HexOrDecimal256)(order.MakerAmount.BigInt()),
+			"takerAmount":   (*math.HexOrDecimal256)(order.TakerAmount.BigInt()),
+			"expiration":    (*math.HexOrDecimal256)(u256OrZero(order.Expiration)),
+			"nonce":         (*math.HexOrDecimal256)(u256OrZero(order.Nonce)),
+			"feeRateBps":    (*math.HexOrDecimal256)(order.FeeRateBps.BigInt()),
+			"side":          (*math.HexOrDecimal256)(big.NewInt(int64(sideInt))),
+			"signatureType": (*math.HexOrDecimal256)(big.NewInt(int64(sigType))),
+		},
+	}
+}
+
+func u256OrZero(value types.U256) *big.Int {
+	if value.Int == nil {
+		return big.NewInt(0)
+	}
+	return value.Int
+}
+
+// orderExchanges returns the contracts an order on chainID may be signed for.
+func orderExchanges(chainID int64) ([]common.Address, bool) {
+	cfg, ok := config.Lookup(chainID)
+	if !ok {
+		return nil, false
+	}
+	return cfg.Exchanges(), true
+}
+
+// VerifyOrderSignature reports whether order carries a valid EIP-712
+// signature by expectedSigner for chainID. The order hash is rebuilt the same
+// way SignOrder builds it and checked against both the CTF exchange and the
+// neg-risk exchange domains. When the order's Signer field is set it must
+// equal expectedSigner.
+//
+// Only ECDSA signatures are checked; the funder (maker) of a proxy or Safe
+// order is not verified on-chain. An error is returned for malformed input
+// or an unsupported chain, and (false, nil) for a well-formed signature by
+// someone else.
+func VerifyOrderSignature(order *clobtypes.SignedOrder, expectedSigner common.Address, chainID int64) (bool, error) {
+	if order == nil {
+		return false, fmt.Errorf("order is required")
+	}
+	sig, err := hexutil.Decode(order.Signature)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return false, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	exchanges, ok := orderExchanges(chainID)
+	if !ok {
+		return false, fmt.Errorf("unsupported chain id %d", chainID)
+	}
+
+	signer := order.Order.Signer
+	if signer == (common.Address{}) {
+		signer = expectedSigner
+	}
+	if signer != expectedSigner {
+		return false, nil
+	}
+	sigType := 0
+	if order.Order.SignatureType != nil {
+		sigType = *order.Order.SignatureType
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	for _, exchange := range exchanges {
+		typedData := orderTypedData(&order.Order, signer, sigType, big.NewInt(chainID), exchange)
+		hash, _, err := apitypes.TypedDataAndHash(typedData)
+		if err != nil {
+			return false, fmt.Errorf("hash order: %w", err)
+		}
+		pub, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			return false, fmt.Errorf("recover signer: %w", err)
+		}
+		if crypto.PubkeyToAddress(*pub) == expectedSigner {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// VerifyOrder reports whether signature is a valid signature of order by
+// order.Signer on chainID. It is the maker-side check for orders received
+// from a counterparty, e.g. when approving an RFQ quote.
+//
+// For EOA orders (signature type 0) the maker must also be the signer. For
+// proxy and Safe orders the exchange checks an ECDSA signature by the
+// owner's EOA, never an ERC-1271 contract signature, so the same recovery
+// applies; whether the signer actually controls the maker wallet is an
+// on-chain fact this helper does not check.
+func VerifyOrder(order *clobtypes.Order, signature string, chainID int64) (bool, error) {
+	if order == nil {
+		return false, fmt.Errorf("order is required")
+	}
+	if order.Signer == (common.Address{}) {
+		return false, fmt.Errorf("order signer is required")
+	}
+	eoa := order.SignatureType == nil || *order.SignatureType == int(auth.SignatureEOA)
+	if eoa && order.Maker != (common.Address{}) && order.Maker != order.Signer {
+		return false, nil
+	}
+	return VerifyOrderSignature(&clobtypes.SignedOrder{Order: *order, Signature: signature}, order.Signer, chainID)
+}
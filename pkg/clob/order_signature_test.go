@@ -0,0 +1,166 @@
+package clob
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/config"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/ctf"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+func TestVerifyOrderSignature(t *testing.T) {
+	signer := mustSigner(t)
+	order := &clobtypes.Order{
+		Side:        "SELL",
+		Signer:      signer.Address(),
+		TokenID:     types.U256{Int: big.NewInt(42)},
+		MakerAmount: decimal.NewFromInt(10),
+		TakerAmount: decimal.NewFromInt(5),
+		FeeRateBps:  decimal.NewFromInt(0),
+		Nonce:       types.U256{Int: big.NewInt(3)},
+	}
+	signed, err := SignOrder(signer, &auth.APIKey{Key: "k"}, order)
+	if err != nil {
+		t.Fatalf("SignOrder failed: %v", err)
+	}
+
+	ok, err := VerifyOrderSignature(signed, signer.Address(), ctf.PolygonChainID)
+	if err != nil || !ok {
+		t.Fatalf("expected valid signature, got %v, %v", ok, err)
+	}
+
+	other := common.HexToAddress("0x0000000000000000000000000000000000000009")
+	if ok, err := VerifyOrderSignature(signed, other, ctf.PolygonChainID); err != nil || ok {
+		t.Errorf("expected mismatch for other signer, got %v, %v", ok, err)
+	}
+	if ok, _ := VerifyOrderSignature(signed, signer.Address(), ctf.AmoyChainID); ok {
+		t.Error("signature must not verify on another chain")
+	}
+
+	amoySigner := signer.(*auth.PrivateKeySigner).WithChainID(ctf.AmoyChainID)
+	amoySigned, err := SignOrder(amoySigner, &auth.APIKey{Key: "k"}, order)
+	if err != nil {
+		t.Fatalf("SignOrder on Amoy failed: %v", err)
+	}
+	if ok, err := VerifyOrderSignature(amoySigned, signer.Address(), ctf.AmoyChainID); err != nil || !ok {
+		t.Errorf("expected valid Amoy signature, got %v, %v", ok, err)
+	}
+
+	tampered := *signed
+	tampered.Order.TakerAmount = decimal.NewFromInt(6)
+	if ok, err := VerifyOrderSignature(&tampered, signer.Address(), ctf.PolygonChainID); err != nil || ok {
+		t.Errorf("expected tampered order to fail, got %v, %v", ok, err)
+	}
+
+	if _, err := VerifyOrderSignature(signed, signer.Address(), 1); err == nil {
+		t.Error("expected error for unsupported chain")
+	}
+	bad := *signed
+	bad.Signature = "0x1234"
+	if _, err := VerifyOrderSignature(&bad, signer.Address(), ctf.PolygonChainID); err == nil {
+		t.Error("expected error for short signature")
+	}
+}
+
+func TestVerifyOrderSignatureNegRiskDomain(t *testing.T) {
+	key, err := crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerAddr := crypto.PubkeyToAddress(key.PublicKey)
+	order := clobtypes.Order{
+		Side:        "BUY",
+		Signer:      signerAddr,
+		Maker:       signerAddr,
+		TokenID:     types.U256{Int: big.NewInt(7)},
+		MakerAmount: decimal.NewFromInt(50),
+		TakerAmount: decimal.NewFromInt(100),
+		FeeRateBps:  decimal.NewFromInt(0),
+		Salt:        types.U256{Int: big.NewInt(5)},
+	}
+	typedData := orderTypedData(&order, signerAddr, 0, big.NewInt(ctf.PolygonChainID), ctf.PolygonNegRiskExchange)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27
+
+	ok, err := VerifyOrderSignature(&clobtypes.SignedOrder{Order: order, Signature: hexutil.Encode(sig)}, signerAddr, ctf.PolygonChainID)
+	if err != nil || !ok {
+		t.Fatalf("expected neg-risk signature to verify, got %v, %v", ok, err)
+	}
+}
+
+func TestVerifyOrder(t *testing.T) {
+	signer := mustSigner(t)
+	order := &clobtypes.Order{
+		Side:        "BUY",
+		Maker:       signer.Address(),
+		Signer:      signer.Address(),
+		TokenID:     types.U256{Int: big.NewInt(7)},
+		MakerAmount: decimal.NewFromInt(5),
+		TakerAmount: decimal.NewFromInt(10),
+		FeeRateBps:  decimal.NewFromInt(0),
+		Nonce:       types.U256{Int: big.NewInt(1)},
+	}
+	signed, err := SignOrder(signer, &auth.APIKey{Key: "k"}, order)
+	if err != nil {
+		t.Fatalf("SignOrder failed: %v", err)
+	}
+
+	if ok, err := VerifyOrder(&signed.Order, signed.Signature, ctf.PolygonChainID); err != nil || !ok {
+		t.Fatalf("expected valid order, got %v, %v", ok, err)
+	}
+
+	otherMaker := signed.Order
+	otherMaker.Maker = common.HexToAddress("0x0000000000000000000000000000000000000009")
+	if ok, err := VerifyOrder(&otherMaker, signed.Signature, ctf.PolygonChainID); err != nil || ok {
+		t.Errorf("EOA order with a different maker must not verify, got %v, %v", ok, err)
+	}
+
+	otherSigner := signed.Order
+	otherSigner.Signer = otherMaker.Maker
+	otherSigner.Maker = otherMaker.Maker
+	if ok, err := VerifyOrder(&otherSigner, signed.Signature, ctf.PolygonChainID); err != nil || ok {
+		t.Errorf("order claiming another signer must not verify, got %v, %v", ok, err)
+	}
+
+	noSigner := signed.Order
+	noSigner.Signer = common.Address{}
+	if _, err := VerifyOrder(&noSigner, signed.Signature, ctf.PolygonChainID); err == nil {
+		t.Error("expected error for missing signer")
+	}
+}
+
+func TestExchangeAddressConstants(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hex  string
+		want common.Address
+	}{
+		{"polygon exchange", ExchangeAddressPolygon, config.Polygon.Exchange},
+		{"polygon neg-risk exchange", NegRiskExchangeAddressPolygon, config.Polygon.NegRiskExchange},
+		{"amoy exchange", ExchangeAddressAmoy, config.Amoy.Exchange},
+		{"amoy neg-risk exchange", NegRiskExchangeAddressAmoy, config.Amoy.NegRiskExchange},
+	} {
+		if got := common.HexToAddress(tc.hex); got != tc.want {
+			t.Errorf("%s = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+	if got := signingExchange(big.NewInt(config.AmoyChainID)); got != common.HexToAddress(ExchangeAddressAmoy) {
+		t.Errorf("Amoy orders are signed for %s", got)
+	}
+}
@@ -0,0 +1,105 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+const (
+	// DefaultOrderPollInterval is the polling interval used by
+	// WaitForOrderStatus when none is given.
+	DefaultOrderPollInterval = time.Second
+	// maxOrderPollDelay caps the delay between polls after failed lookups.
+	maxOrderPollDelay = 30 * time.Second
+)
+
+// DefaultTerminalOrderStatuses are the statuses WaitForOrderStatus waits for
+// when no terminal set is given: the order can no longer change.
+var DefaultTerminalOrderStatuses = []string{
+	string(clobtypes.OrderStatusMatched),
+	string(clobtypes.OrderStatusCanceled),
+	string(clobtypes.OrderStatusUnmatched),
+}
+
+func (c *clientImpl) WaitForOrderStatus(ctx context.Context, id string, terminal []string, interval time.Duration) (clobtypes.OrderResponse, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return clobtypes.OrderResponse{}, errors.New("order id is required")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if interval <= 0 {
+		interval = DefaultOrderPollInterval
+	}
+	if len(terminal) == 0 {
+		terminal = DefaultTerminalOrderStatuses
+	}
+	want := make(map[string]struct{}, len(terminal))
+	for _, status := range terminal {
+		want[strings.ToUpper(strings.TrimSpace(status))] = struct{}{}
+	}
+
+	// Failed lookups back off from interval; a successful poll resets it.
+	backoff := transport.NewBackoff(interval, max(interval, maxOrderPollDelay), 2, 0)
+	var last clobtypes.OrderResponse
+	for {
+		resp, err := c.Order(ctx, id)
+		delay := interval
+		switch {
+		case err != nil:
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return last, ctxErr
+			}
+			if !retryableOrderPollError(err) {
+				return last, err
+			}
+			delay = backoff.Next()
+		case resp.ID == "" && resp.Status == "":
+			// The order endpoint answers unknown IDs with an empty body.
+			return last, sdkerrors.ErrOrderNotFound
+		default:
+			last = resp
+			backoff.Reset()
+			if _, ok := want[strings.ToUpper(resp.Status)]; ok {
+				return resp, nil
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryableOrderPollError reports whether a failed order lookup is worth
+// polling again. Rate limits, server errors, maintenance and network
+// failures are; client errors such as a 404 for an unknown order are not.
+func retryableOrderPollError(err error) bool {
+	var apiErr *types.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status == 429 || apiErr.Status >= 500
+	}
+	for _, permanent := range []error{
+		sdkerrors.ErrOrderNotFound,
+		sdkerrors.ErrUnauthorized,
+		sdkerrors.ErrGeoblocked,
+		sdkerrors.ErrBadRequest,
+	} {
+		if errors.Is(err, permanent) {
+			return false
+		}
+	}
+	return true
+}
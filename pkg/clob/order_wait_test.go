@@ -0,0 +1,141 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// sequenceDoer answers each request with the next response, repeating the
+// last one once the sequence is exhausted.
+type sequenceDoer struct {
+	mu        sync.Mutex
+	responses []statusResponse
+	calls     int
+}
+
+func (d *sequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	res := d.responses[min(d.calls, len(d.responses)-1)]
+	d.calls++
+	return &http.Response{
+		StatusCode: res.status,
+		Body:       io.NopCloser(strings.NewReader(res.body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}, nil
+}
+
+func (d *sequenceDoer) callCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls
+}
+
+func orderBody(status string) string {
+	return fmt.Sprintf(`{"id":"o1","status":%q,"original_size":"10","size_matched":"0"}`, status)
+}
+
+func TestWaitForOrderStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PollsUntilTerminal", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []statusResponse{
+			{status: http.StatusOK, body: orderBody("LIVE")},
+			{status: http.StatusOK, body: orderBody("LIVE")},
+			{status: http.StatusOK, body: orderBody("matched")},
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		resp, err := client.WaitForOrderStatus(ctx, "o1", nil, 5*time.Millisecond)
+		if err != nil {
+			t.Fatalf("WaitForOrderStatus failed: %v", err)
+		}
+		if resp.Status != "matched" || doer.callCount() != 3 {
+			t.Errorf("status %q after %d polls, want matched after 3", resp.Status, doer.callCount())
+		}
+	})
+
+	t.Run("CustomTerminalSet", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []statusResponse{{status: http.StatusOK, body: orderBody("LIVE")}}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		resp, err := client.WaitForOrderStatus(ctx, "o1", []string{"live"}, 5*time.Millisecond)
+		if err != nil || resp.Status != "LIVE" {
+			t.Fatalf("WaitForOrderStatus = %q, %v; want LIVE", resp.Status, err)
+		}
+	})
+
+	t.Run("StopsOnNotFound", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []statusResponse{
+			{status: http.StatusOK, body: orderBody("LIVE")},
+			{status: http.StatusNotFound, body: `{"error":"not found"}`},
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		resp, err := client.WaitForOrderStatus(ctx, "o1", nil, 5*time.Millisecond)
+		if err == nil {
+			t.Fatal("expected an error for a missing order")
+		}
+		if resp.Status != "LIVE" || doer.callCount() != 2 {
+			t.Errorf("returned %q after %d polls, want last seen LIVE after 2", resp.Status, doer.callCount())
+		}
+	})
+
+	t.Run("EmptyBodyIsNotFound", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []statusResponse{{status: http.StatusOK, body: `null`}}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		if _, err := client.WaitForOrderStatus(ctx, "o1", nil, 5*time.Millisecond); !errors.Is(err, sdkerrors.ErrOrderNotFound) {
+			t.Fatalf("err = %v, want ErrOrderNotFound", err)
+		}
+	})
+
+	t.Run("ContextExpiry", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []statusResponse{{status: http.StatusOK, body: orderBody("LIVE")}}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+		short, cancel := context.WithTimeout(ctx, 30*time.Millisecond)
+		defer cancel()
+		resp, err := client.WaitForOrderStatus(short, "o1", nil, 5*time.Millisecond)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("err = %v, want deadline exceeded", err)
+		}
+		if resp.Status != "LIVE" {
+			t.Errorf("expected the last seen order, got %+v", resp)
+		}
+	})
+
+	t.Run("RequiresID", func(t *testing.T) {
+		client := NewClient(transport.NewClient(&sequenceDoer{}, "http://example"))
+		if _, err := client.WaitForOrderStatus(ctx, " ", nil, 0); err == nil {
+			t.Fatal("expected an error for an empty id")
+		}
+	})
+}
+
+func TestRetryableOrderPollError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{&types.Error{Status: http.StatusNotFound}, false},
+		{&types.Error{Status: http.StatusTooManyRequests}, true},
+		{&types.Error{Status: http.StatusBadGateway}, true},
+		{fmt.Errorf("%w: gone", sdkerrors.ErrOrderNotFound), false},
+		{fmt.Errorf("%w: bad key", sdkerrors.ErrUnauthorized), false},
+		{sdkerrors.ErrRateLimitExceeded, true},
+		{fmt.Errorf("%w: down", sdkerrors.ErrInternalServerError), true},
+		{errors.New("connection reset by peer"), true},
+	}
+	for _, tc := range cases {
+		if got := retryableOrderPollError(tc.err); got != tc.want {
+			t.Errorf("retryableOrderPollError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
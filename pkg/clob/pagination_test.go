@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
@@ -30,6 +32,49 @@ func TestOrdersAllPagination(t *testing.T) {
 	}
 }
 
+func TestOpenOrdersByMarket(t *testing.T) {
+	page1 := `{"data":[` +
+		`{"id":"s1","market":"m1","asset_id":"a1","side":"SELL","price":"0.60"},` +
+		`{"id":"b1","market":"m1","asset_id":"a1","side":"BUY","price":"0.40"},` +
+		`{"id":"b2","market":"m1","asset_id":"a1","side":"BUY","price":"0.45"}` +
+		`],"next_cursor":"NEXT"}`
+	page2 := `{"data":[` +
+		`{"id":"s2","market":"m1","asset_id":"a1","side":"SELL","price":"0.55"},` +
+		`{"id":"c1","market":"m1","asset_id":"a0","side":"BUY","price":"0.10"}` +
+		`],"next_cursor":"LTE="}`
+	doer := &staticDoer{
+		responses: map[string]string{
+			buildKey("/data/orders", url.Values{"market": {"m1"}, "next_cursor": {clobtypes.InitialCursor}}): page1,
+			buildKey("/data/orders", url.Values{"market": {"m1"}, "next_cursor": {"NEXT"}}):                  page2,
+		},
+	}
+	client := &clientImpl{
+		httpClient: transport.NewClient(doer, "http://example"),
+		cache:      newClientCache(),
+	}
+
+	orders, err := client.OpenOrdersByMarket(context.Background(), " m1 ")
+	if err != nil {
+		t.Fatalf("OpenOrdersByMarket failed: %v", err)
+	}
+	want := []string{"c1", "b2", "b1", "s2", "s1"}
+	if len(orders) != len(want) {
+		t.Fatalf("expected %d orders, got %d", len(want), len(orders))
+	}
+	for i, id := range want {
+		if orders[i].ID != id {
+			t.Errorf("orders[%d].ID = %q, want %q", i, orders[i].ID, id)
+		}
+	}
+	if !orders[1].Price.Equal(decimal.RequireFromString("0.45")) {
+		t.Errorf("unexpected price: %s", orders[1].Price)
+	}
+
+	if _, err := client.OpenOrdersByMarket(context.Background(), ""); err == nil {
+		t.Fatal("expected error for empty market")
+	}
+}
+
 func TestTradesAllPagination(t *testing.T) {
 	doer := &staticDoer{
 		responses: map[string]string{
@@ -0,0 +1,61 @@
+package clob
+
+import "github.com/shopspring/decimal"
+
+var defaultQuoteTick = decimal.RequireFromString("0.01")
+
+// SkewedQuotes returns two-sided quotes around fair with a total width of
+// spread, shifted against the current inventory so that a long position
+// quotes lower (selling more eagerly) and a short position quotes higher.
+//
+// The shift is inventory/maxInventory, clamped to [-1, 1], times half the
+// spread; at maxInventory the bid sits a full spread below fair and the ask at
+// fair. A non-positive maxInventory disables skewing. The bid is rounded down
+// and the ask up to tickSize (default 0.01), both are kept within
+// [tickSize, 1-tickSize] (0.01/0.99 for the default tick), and the ask is
+// always at least one tick above the bid.
+func SkewedQuotes(fair, spread decimal.Decimal, inventory, maxInventory decimal.Decimal, tickSize decimal.Decimal) (bid, ask decimal.Decimal) {
+	if tickSize.Sign() <= 0 {
+		tickSize = defaultQuoteTick
+	}
+	if spread.Sign() < 0 {
+		spread = spread.Neg()
+	}
+	half := spread.Div(decimal.NewFromInt(2))
+
+	center := fair
+	if maxInventory.Sign() > 0 {
+		one := decimal.NewFromInt(1)
+		ratio := inventory.Div(maxInventory)
+		if ratio.GreaterThan(one) {
+			ratio = one
+		} else if ratio.LessThan(one.Neg()) {
+			ratio = one.Neg()
+		}
+		center = center.Sub(ratio.Mul(half))
+	}
+
+	bid = floorToTick(center.Sub(half), tickSize)
+	ask = ceilToTick(center.Add(half), tickSize)
+
+	lower := tickSize
+	upper := decimal.NewFromInt(1).Sub(tickSize)
+	bid = decimal.Min(decimal.Max(bid, lower), upper.Sub(tickSize))
+	ask = decimal.Max(decimal.Min(ask, upper), lower.Add(tickSize))
+	if ask.LessThanOrEqual(bid) {
+		if bid.Add(tickSize).LessThanOrEqual(upper) {
+			ask = bid.Add(tickSize)
+		} else {
+			bid = ask.Sub(tickSize)
+		}
+	}
+	return bid, ask
+}
+
+func floorToTick(price, tick decimal.Decimal) decimal.Decimal {
+	return price.Div(tick).Floor().Mul(tick)
+}
+
+func ceilToTick(price, tick decimal.Decimal) decimal.Decimal {
+	return price.Div(tick).Ceil().Mul(tick)
+}
@@ -0,0 +1,40 @@
+package clob
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSkewedQuotes(t *testing.T) {
+	d := decimal.RequireFromString
+	tick := d("0.01")
+	max := d("100")
+
+	tests := []struct {
+		name              string
+		fair, spread, inv string
+		wantBid, wantAsk  string
+	}{
+		{"flat", "0.50", "0.04", "0", "0.48", "0.52"},
+		{"long half", "0.50", "0.04", "50", "0.47", "0.51"},
+		{"long max", "0.50", "0.04", "100", "0.46", "0.5"},
+		{"long beyond max", "0.50", "0.04", "500", "0.46", "0.5"},
+		{"short max", "0.50", "0.04", "-100", "0.5", "0.54"},
+		{"snaps outward", "0.503", "0.01", "0", "0.49", "0.51"},
+		{"low bound", "0.01", "0.04", "0", "0.01", "0.03"},
+		{"high bound", "0.99", "0.04", "0", "0.97", "0.99"},
+		{"zero spread", "0.50", "0", "0", "0.5", "0.51"},
+	}
+	for _, tt := range tests {
+		bid, ask := SkewedQuotes(d(tt.fair), d(tt.spread), d(tt.inv), max, tick)
+		if !bid.Equal(d(tt.wantBid)) || !ask.Equal(d(tt.wantAsk)) {
+			t.Errorf("%s: got %s/%s, want %s/%s", tt.name, bid, ask, tt.wantBid, tt.wantAsk)
+		}
+	}
+
+	bid, ask := SkewedQuotes(d("0.5"), d("0.004"), d("10"), decimal.Zero, d("0.001"))
+	if !bid.Equal(d("0.498")) || !ask.Equal(d("0.502")) {
+		t.Errorf("no skew with zero maxInventory: got %s/%s", bid, ask)
+	}
+}
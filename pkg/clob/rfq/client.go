@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
@@ -20,6 +21,7 @@ type Client interface {
 	RFQRequestAccept(ctx context.Context, req *RFQAcceptRequest) (RFQAcceptResponse, error)
 	RFQQuoteApprove(ctx context.Context, req *RFQApproveQuote) (RFQApproveResponse, error)
 	RFQConfig(ctx context.Context) (RFQConfigResponse, error)
+	WatchRequests(ctx context.Context, query *RFQRequestsQuery, interval time.Duration) <-chan RFQRequestItem
 }
 
 type clientImpl struct {
@@ -0,0 +1,30 @@
+package rfq
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MinSizeDec parses MinSize as a decimal. An empty MinSize is zero.
+func (c RFQConfigResponse) MinSizeDec() (decimal.Decimal, error) {
+	raw := strings.TrimSpace(c.MinSize)
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(raw)
+}
+
+// ValidateSize checks a request or quote size in shares against MinSize.
+// Any size is accepted when the config has no minimum.
+func (c RFQConfigResponse) ValidateSize(size decimal.Decimal) error {
+	min, err := c.MinSizeDec()
+	if err != nil {
+		return fmt.Errorf("parse min_size %q: %w", c.MinSize, err)
+	}
+	if min.IsPositive() && size.LessThan(min) {
+		return fmt.Errorf("size %s is below the minimum of %s", size, min)
+	}
+	return nil
+}
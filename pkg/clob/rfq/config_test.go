@@ -0,0 +1,48 @@
+package rfq
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+	"github.com/shopspring/decimal"
+)
+
+// testdata/rfq_config.json is a /rfq/config body in the shape
+// RFQConfigResponse models. It was written by hand, not recorded from the API.
+func TestRFQConfigFixture(t *testing.T) {
+	body, err := os.ReadFile("testdata/rfq_config.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	client := NewClient(transport.NewClient(&staticDoer{
+		responses: map[string]string{"/rfq/config": string(body)},
+	}, "http://example"))
+	cfg, err := client.RFQConfig(context.Background())
+	if err != nil {
+		t.Fatalf("RFQConfig failed: %v", err)
+	}
+	if cfg.MinSize != "5" {
+		t.Errorf("MinSize = %q, want 5", cfg.MinSize)
+	}
+	min, err := cfg.MinSizeDec()
+	if err != nil || !min.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("MinSizeDec = %s, %v", min, err)
+	}
+	if err := cfg.ValidateSize(decimal.NewFromInt(4)); err == nil {
+		t.Error("expected size below minimum to fail")
+	}
+	if err := cfg.ValidateSize(decimal.NewFromInt(5)); err != nil {
+		t.Errorf("minimum size rejected: %v", err)
+	}
+}
+
+func TestRFQConfigValidateSize(t *testing.T) {
+	if err := (RFQConfigResponse{}).ValidateSize(decimal.NewFromInt(1)); err != nil {
+		t.Errorf("missing minimum should not limit size: %v", err)
+	}
+	if err := (RFQConfigResponse{MinSize: "lots"}).ValidateSize(decimal.NewFromInt(1)); err == nil {
+		t.Error("expected error for non-numeric min_size")
+	}
+}
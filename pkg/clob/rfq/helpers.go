@@ -132,6 +132,58 @@ func BuildRFQApproveQuoteFromSignedOrder(requestID, quoteID string, signed *clob
 	return req, nil
 }
 
+// BuildRFQAcceptFromQuote builds an RFQ accept payload for quote, taking the
+// request and quote IDs from the quote and the order fields from signed. The
+// signed order must trade the quote's token (or its complement).
+func BuildRFQAcceptFromQuote(quote RFQQuoteItem, signed *clobtypes.SignedOrder) (*RFQAcceptRequest, error) {
+	requestID, quoteID, err := quoteIDs(quote, signed)
+	if err != nil {
+		return nil, err
+	}
+	return BuildRFQAcceptRequestFromSignedOrder(requestID, quoteID, signed)
+}
+
+// BuildRFQApproveFromQuote builds an RFQ approve payload for quote, taking the
+// request and quote IDs from the quote and the order fields from signed. The
+// signed order must trade the quote's token (or its complement).
+func BuildRFQApproveFromQuote(quote RFQQuoteItem, signed *clobtypes.SignedOrder) (*RFQApproveQuote, error) {
+	requestID, quoteID, err := quoteIDs(quote, signed)
+	if err != nil {
+		return nil, err
+	}
+	return BuildRFQApproveQuoteFromSignedOrder(requestID, quoteID, signed)
+}
+
+// quoteIDs returns the request and quote IDs of quote after checking that
+// signed trades one of the quote's tokens.
+func quoteIDs(quote RFQQuoteItem, signed *clobtypes.SignedOrder) (string, string, error) {
+	quoteID := quote.QuoteID
+	if quoteID == "" {
+		quoteID = quote.ID
+	}
+	if signed == nil || signed.Order.TokenID.Int == nil || quote.Token == "" {
+		// Missing fields are reported by the payload builders.
+		return quote.RequestID, quoteID, nil
+	}
+	tokenID := signed.Order.TokenID.Int.String()
+	for _, raw := range []string{quote.Token, quote.Complement} {
+		if raw == "" {
+			continue
+		}
+		want, err := parseBigIntString(raw)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid quote token: %w", err)
+		}
+		if want.String() == tokenID {
+			return quote.RequestID, quoteID, nil
+		}
+	}
+	return "", "", fmt.Errorf("order token %s does not match quote token %s", tokenID, quote.Token)
+}
+
+// ToDetail parses the request's addresses, token IDs and decimal amounts.
+// Empty fields are left zero; malformed fields return an error naming the
+// field.
 func (r RFQRequestItem) ToDetail() (RFQRequestDetail, error) {
 	requestID := r.RequestID
 	if requestID == "" {
@@ -139,31 +191,31 @@ func (r RFQRequestItem) ToDetail() (RFQRequestDetail, error) {
 	}
 	user, err := parseAddress(r.UserAddress)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("userAddress: %w", err)
 	}
 	proxy, err := parseAddress(r.ProxyAddress)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("proxyAddress: %w", err)
 	}
 	tokenID, err := parseBigIntString(r.Token)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("token: %w", err)
 	}
 	complement, err := parseBigIntString(r.Complement)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("complement: %w", err)
 	}
 	sizeIn, err := parseDecimalString(r.SizeIn)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("sizeIn: %w", err)
 	}
 	sizeOut, err := parseDecimalString(r.SizeOut)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("sizeOut: %w", err)
 	}
 	price, err := parseDecimalString(r.Price)
 	if err != nil {
-		return RFQRequestDetail{}, err
+		return RFQRequestDetail{}, fmt.Errorf("price: %w", err)
 	}
 
 	return RFQRequestDetail{
@@ -181,6 +233,9 @@ func (r RFQRequestItem) ToDetail() (RFQRequestDetail, error) {
 	}, nil
 }
 
+// ToDetail parses the quote's addresses, token IDs and decimal amounts.
+// Empty fields are left zero; malformed fields return an error naming the
+// field.
 func (r RFQQuoteItem) ToDetail() (RFQQuoteDetail, error) {
 	quoteID := r.QuoteID
 	if quoteID == "" {
@@ -188,31 +243,31 @@ func (r RFQQuoteItem) ToDetail() (RFQQuoteDetail, error) {
 	}
 	user, err := parseAddress(r.UserAddress)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("userAddress: %w", err)
 	}
 	proxy, err := parseAddress(r.ProxyAddress)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("proxyAddress: %w", err)
 	}
 	tokenID, err := parseBigIntString(r.Token)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("token: %w", err)
 	}
 	complement, err := parseBigIntString(r.Complement)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("complement: %w", err)
 	}
 	sizeIn, err := parseDecimalString(r.SizeIn)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("sizeIn: %w", err)
 	}
 	sizeOut, err := parseDecimalString(r.SizeOut)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("sizeOut: %w", err)
 	}
 	price, err := parseDecimalString(r.Price)
 	if err != nil {
-		return RFQQuoteDetail{}, err
+		return RFQQuoteDetail{}, fmt.Errorf("price: %w", err)
 	}
 
 	return RFQQuoteDetail{
@@ -3,6 +3,7 @@ package rfq
 import (
 	"math/big"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
@@ -419,6 +420,9 @@ func TestRFQRequestItem_ToDetail_InvalidSizeIn(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for invalid sizeIn")
 	}
+	if !strings.HasPrefix(err.Error(), "sizeIn: ") {
+		t.Errorf("error should name the field, got %v", err)
+	}
 }
 
 func TestRFQRequestItem_ToDetail_InvalidSizeOut(t *testing.T) {
@@ -679,3 +683,48 @@ func TestApplyRFQFilters_EmptyFields(t *testing.T) {
 		t.Fatalf("expected empty query, got %v", q)
 	}
 }
+
+func TestBuildRFQFromQuote(t *testing.T) {
+	signed := &clobtypes.SignedOrder{
+		Order: clobtypes.Order{
+			Salt:        types.U256{Int: big.NewInt(1)},
+			Maker:       common.HexToAddress("0x0000000000000000000000000000000000000001"),
+			Signer:      common.HexToAddress("0x0000000000000000000000000000000000000001"),
+			TokenID:     types.U256{Int: big.NewInt(456)},
+			MakerAmount: decimal.NewFromInt(100),
+			TakerAmount: decimal.NewFromInt(50),
+			Side:        "BUY",
+			FeeRateBps:  decimal.NewFromInt(0),
+			Nonce:       types.U256{Int: big.NewInt(2)},
+		},
+		Signature: "0xsig",
+		Owner:     "owner",
+	}
+	quote := RFQQuoteItem{ID: "quote-1", RequestID: "req-1", Token: "123", Complement: "456"}
+
+	accept, err := BuildRFQAcceptFromQuote(quote, signed)
+	if err != nil {
+		t.Fatalf("BuildRFQAcceptFromQuote failed: %v", err)
+	}
+	if accept.RequestID != "req-1" || accept.QuoteID != "quote-1" || accept.QuoteIDV2 != "quote-1" {
+		t.Errorf("unexpected IDs: %+v", accept)
+	}
+	if accept.TokenID != "456" || accept.MakerAmount != "100" || accept.Side != "BUY" {
+		t.Errorf("unexpected order fields: %+v", accept)
+	}
+
+	approve, err := BuildRFQApproveFromQuote(quote, signed)
+	if err != nil {
+		t.Fatalf("BuildRFQApproveFromQuote failed: %v", err)
+	}
+	if approve.RequestID != "req-1" || approve.QuoteID != "quote-1" {
+		t.Errorf("unexpected IDs: %+v", approve)
+	}
+
+	if _, err := BuildRFQAcceptFromQuote(RFQQuoteItem{QuoteID: "q", RequestID: "r", Token: "789"}, signed); err == nil {
+		t.Error("expected error for token mismatch")
+	}
+	if _, err := BuildRFQAcceptFromQuote(RFQQuoteItem{QuoteID: "q", Token: "456"}, signed); err == nil {
+		t.Error("expected error for missing request ID")
+	}
+}
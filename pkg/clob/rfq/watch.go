@@ -0,0 +1,100 @@
+package rfq
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultWatchInterval is the polling interval used by WatchRequests
+	// when none is given.
+	DefaultWatchInterval = time.Second
+
+	watchBuffer = 16
+)
+
+// ExpiresAt returns the request expiry, which the API reports in Unix
+// seconds or milliseconds. A request without an expiry yields the zero time.
+func (r RFQRequestItem) ExpiresAt() time.Time {
+	switch {
+	case r.Expiry <= 0:
+		return time.Time{}
+	case r.Expiry > 1e12:
+		return time.UnixMilli(r.Expiry)
+	default:
+		return time.Unix(r.Expiry, 0)
+	}
+}
+
+// WatchRequests polls RFQRequests with query every interval and emits each
+// request the first time it is seen. Requests are deduplicated by request ID
+// until they expire, and requests that have already expired are never
+// emitted. The CLOB has no RFQ WebSocket channel, so polling is the only
+// transport.
+//
+// The channel is buffered. When the consumer falls behind, polling pauses
+// until there is room instead of queueing without bound, and requests that
+// expire while waiting are dropped. Fetch errors are retried on the next
+// tick. The channel is closed when ctx is done.
+func (c *clientImpl) WatchRequests(ctx context.Context, query *RFQRequestsQuery, interval time.Duration) <-chan RFQRequestItem {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	out := make(chan RFQRequestItem, watchBuffer)
+	go func() {
+		defer close(out)
+		c.watchRequests(ctx, query, interval, out)
+	}()
+	return out
+}
+
+func (c *clientImpl) watchRequests(ctx context.Context, query *RFQRequestsQuery, interval time.Duration, out chan<- RFQRequestItem) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// seen maps request IDs to their expiry; entries are pruned once the
+	// request expires so long-running watches stay bounded.
+	seen := make(map[string]time.Time)
+	for {
+		requests, err := c.RFQRequests(ctx, query)
+		if err == nil {
+			now := time.Now()
+			for id, expiry := range seen {
+				if !expiry.IsZero() && !now.Before(expiry) {
+					delete(seen, id)
+				}
+			}
+			for _, req := range requests {
+				id := req.RequestID
+				if id == "" {
+					id = req.ID
+				}
+				if id == "" {
+					continue
+				}
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				expiry := req.ExpiresAt()
+				if !expiry.IsZero() && !time.Now().Before(expiry) {
+					continue
+				}
+				seen[id] = expiry
+				select {
+				case out <- req:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package rfq
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+// sequenceDoer returns bodies in order, repeating the last one.
+type sequenceDoer struct {
+	mu     sync.Mutex
+	bodies []string
+	calls  int
+}
+
+func (d *sequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	i := d.calls
+	d.calls++
+	if i >= len(d.bodies) {
+		i = len(d.bodies) - 1
+	}
+	body := d.bodies[i]
+	d.mu.Unlock()
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestWatchRequests(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Minute).UnixMilli()
+	doer := &sequenceDoer{bodies: []string{
+		fmt.Sprintf(`[{"requestId":"r1","expiry":%d},{"requestId":"old","expiry":%d}]`, future, past),
+		fmt.Sprintf(`[{"requestId":"r1","expiry":%d},{"id":"r2"}]`, future),
+		fmt.Sprintf(`[{"requestId":"r2"},{"requestId":"r3","expiry":%d}]`, future),
+	}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	requests := client.WatchRequests(ctx, &RFQRequestsQuery{State: RFQStateActive}, 10*time.Millisecond)
+
+	var got []string
+	for len(got) < 3 {
+		select {
+		case req := <-requests:
+			id := req.RequestID
+			if id == "" {
+				id = req.ID
+			}
+			got = append(got, id)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if got[0] != "r1" || got[1] != "r2" || got[2] != "r3" {
+		t.Errorf("requests = %v, want [r1 r2 r3]", got)
+	}
+
+	select {
+	case req := <-requests:
+		t.Errorf("unexpected duplicate request: %+v", req)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range requests {
+	}
+}
+
+func TestRFQRequestItemExpiresAt(t *testing.T) {
+	if !(RFQRequestItem{}).ExpiresAt().IsZero() {
+		t.Error("expected zero time without expiry")
+	}
+	if got := (RFQRequestItem{Expiry: 1700000000}).ExpiresAt(); got.Unix() != 1700000000 {
+		t.Errorf("seconds expiry = %v", got)
+	}
+	if got := (RFQRequestItem{Expiry: 1700000000123}).ExpiresAt(); got.UnixMilli() != 1700000000123 {
+		t.Errorf("milliseconds expiry = %v", got)
+	}
+}
@@ -0,0 +1,208 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/rfq"
+)
+
+const (
+	// DefaultRFQWatchInterval is the polling interval used by WatchRFQRequest
+	// when none is given.
+	DefaultRFQWatchInterval = 2 * time.Second
+	// RFQQuotePollInterval is how often SubscribeRFQQuotes polls for quotes.
+	// The CLOB has no RFQ WebSocket channel, so quotes are long-polled at a
+	// sub-second interval to keep maker latency low.
+	RFQQuotePollInterval = 500 * time.Millisecond
+
+	rfqWatchBuffer = 16
+	// rfqQuotePageSize is the page size used when walking the quotes list.
+	rfqQuotePageSize = 100
+)
+
+func (c *clientImpl) WatchRFQRequest(ctx context.Context, requestID string, interval time.Duration) (<-chan rfq.RFQQuoteItem, error) {
+	requestID = strings.TrimSpace(requestID)
+	if requestID == "" {
+		return nil, errors.New("requestID is required")
+	}
+	if c.rfq == nil {
+		return nil, errors.New("rfq client is not configured")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if interval <= 0 {
+		interval = DefaultRFQWatchInterval
+	}
+
+	reqs, err := c.rfq.RFQRequests(ctx, &rfq.RFQRequestsQuery{RequestIDs: []string{requestID}})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	var request *rfq.RFQRequestItem
+	for i := range reqs {
+		if reqs[i].RequestID == requestID || reqs[i].ID == requestID {
+			request = &reqs[i]
+			break
+		}
+	}
+	if request == nil {
+		return nil, errors.New("rfq request not found: " + requestID)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	if expiry := request.ExpiresAt(); !expiry.IsZero() {
+		if !time.Now().Before(expiry) {
+			cancel()
+			return nil, errors.New("rfq request has expired: " + requestID)
+		}
+		runCtx, cancel = context.WithDeadline(ctx, expiry)
+	}
+
+	out := make(chan rfq.RFQQuoteItem, rfqWatchBuffer)
+	go func() {
+		defer close(out)
+		defer cancel()
+		// Polling errors are transient from the caller's point of view; the
+		// next tick retries until the request expires or ctx is done.
+		c.pollRFQQuotes(runCtx, []string{requestID}, interval, func(res StreamResult[rfq.RFQQuoteItem]) bool {
+			if res.Err != nil {
+				return true
+			}
+			select {
+			case out <- res.Item:
+				return true
+			case <-runCtx.Done():
+				return false
+			}
+		})
+	}()
+	return out, nil
+}
+
+func (c *clientImpl) SubscribeRFQQuotes(ctx context.Context, requestIDs []string) (<-chan StreamResult[rfq.RFQQuoteItem], error) {
+	if c.rfq == nil {
+		return nil, errors.New("rfq client is not configured")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ids := make([]string, 0, len(requestIDs))
+	for _, id := range requestIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	out := make(chan StreamResult[rfq.RFQQuoteItem], rfqWatchBuffer)
+	go func() {
+		defer close(out)
+		c.pollRFQQuotes(ctx, ids, RFQQuotePollInterval, func(res StreamResult[rfq.RFQQuoteItem]) bool {
+			select {
+			case out <- res:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return out, nil
+}
+
+// pollRFQQuotes fetches every page of quotes for requestIDs (all visible
+// quotes when empty) every interval and hands each quote not seen in the
+// previous poll to send, along with any fetch error. It returns when ctx is done or send
+// returns false. Quotes are deduplicated for the lifetime of the poll; when
+// polling every quote the seen set only holds the latest poll, so memory
+// stays bounded on long-running subscriptions.
+func (c *clientImpl) pollRFQQuotes(ctx context.Context, requestIDs []string, interval time.Duration, send func(StreamResult[rfq.RFQQuoteItem]) bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := make(map[string]struct{})
+	for {
+		quotes, err := c.rfqQuotesAll(ctx, requestIDs)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if !send(StreamResult[rfq.RFQQuoteItem]{Err: mapError(err)}) {
+				return
+			}
+		} else {
+			current := make(map[string]struct{}, len(quotes))
+			for _, quote := range quotes {
+				id := quote.QuoteID
+				if id == "" {
+					id = quote.ID
+				}
+				if id == "" {
+					continue
+				}
+				current[id] = struct{}{}
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				if !send(StreamResult[rfq.RFQQuoteItem]{Item: quote}) {
+					return
+				}
+			}
+			if len(requestIDs) == 0 {
+				seen = current
+			} else {
+				for id := range current {
+					seen[id] = struct{}{}
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rfqQuotesAll fetches every page of quotes for requestIDs. The endpoint
+// returns a bare array, so the offset advances by the page length until a
+// short page comes back. A page with no unseen quote also ends the walk, in
+// case the server ignores the offset and keeps returning the first page.
+func (c *clientImpl) rfqQuotesAll(ctx context.Context, requestIDs []string) ([]rfq.RFQQuoteItem, error) {
+	var all []rfq.RFQQuoteItem
+	seen := make(map[string]struct{})
+	offset := 0
+	for {
+		page, err := c.rfq.RFQQuotes(ctx, &rfq.RFQQuotesQuery{
+			RequestIDs: requestIDs,
+			Limit:      rfqQuotePageSize,
+			Offset:     strconv.Itoa(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		fresh := false
+		for _, quote := range page {
+			id := quote.QuoteID
+			if id == "" {
+				id = quote.ID
+			}
+			if id != "" {
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+				fresh = true
+			}
+			all = append(all, quote)
+		}
+		if len(page) < rfqQuotePageSize || !fresh {
+			return all, nil
+		}
+		offset += len(page)
+	}
+}
@@ -0,0 +1,253 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/rfq"
+)
+
+type fakeRFQ struct {
+	rfq.Client
+
+	mu      sync.Mutex
+	request rfq.RFQRequestItem
+	polls   [][]rfq.RFQQuoteItem
+	errs    []error
+	queries []*rfq.RFQQuotesQuery
+	calls   int
+}
+
+func (f *fakeRFQ) RFQRequests(ctx context.Context, req *rfq.RFQRequestsQuery) (rfq.RFQRequestsResponse, error) {
+	return rfq.RFQRequestsResponse{f.request}, nil
+}
+
+func (f *fakeRFQ) RFQQuotes(ctx context.Context, req *rfq.RFQQuotesQuery) (rfq.RFQQuotesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.calls
+	f.calls++
+	f.queries = append(f.queries, req)
+	if i < len(f.errs) && f.errs[i] != nil {
+		return nil, f.errs[i]
+	}
+	if i >= len(f.polls) {
+		i = len(f.polls) - 1
+	}
+	return f.polls[i], nil
+}
+
+func TestWatchRFQRequest(t *testing.T) {
+	t.Run("DedupesUntilExpiry", func(t *testing.T) {
+		fake := &fakeRFQ{
+			request: rfq.RFQRequestItem{RequestID: "r1", Expiry: time.Now().Add(300 * time.Millisecond).UnixMilli()},
+			polls: [][]rfq.RFQQuoteItem{
+				{{QuoteID: "q1", RequestID: "r1"}},
+				{{QuoteID: "q1", RequestID: "r1"}, {ID: "q2", RequestID: "r1"}},
+				{{QuoteID: "q2", RequestID: "r1"}, {QuoteID: "q3", RequestID: "r1"}},
+			},
+		}
+		client := &clientImpl{rfq: fake}
+
+		quotes, err := client.WatchRFQRequest(context.Background(), "r1", 20*time.Millisecond)
+		if err != nil {
+			t.Fatalf("WatchRFQRequest failed: %v", err)
+		}
+		var got []string
+		timeout := time.After(2 * time.Second)
+		for done := false; !done; {
+			select {
+			case quote, ok := <-quotes:
+				if !ok {
+					done = true
+					break
+				}
+				id := quote.QuoteID
+				if id == "" {
+					id = quote.ID
+				}
+				got = append(got, id)
+			case <-timeout:
+				t.Fatal("channel was not closed at expiry")
+			}
+		}
+		if len(got) != 3 || got[0] != "q1" || got[1] != "q2" || got[2] != "q3" {
+			t.Errorf("quotes = %v, want [q1 q2 q3]", got)
+		}
+	})
+
+	t.Run("StopsOnCancel", func(t *testing.T) {
+		fake := &fakeRFQ{
+			request: rfq.RFQRequestItem{RequestID: "r1"},
+			polls:   [][]rfq.RFQQuoteItem{{}},
+		}
+		client := &clientImpl{rfq: fake}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		quotes, err := client.WatchRFQRequest(ctx, "r1", 10*time.Millisecond)
+		if err != nil {
+			t.Fatalf("WatchRFQRequest failed: %v", err)
+		}
+		cancel()
+		select {
+		case _, ok := <-quotes:
+			if ok {
+				t.Fatal("unexpected quote")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("channel was not closed after cancel")
+		}
+	})
+
+	t.Run("RejectsExpired", func(t *testing.T) {
+		fake := &fakeRFQ{request: rfq.RFQRequestItem{RequestID: "r1", Expiry: time.Now().Add(-time.Minute).Unix()}}
+		client := &clientImpl{rfq: fake}
+		if _, err := client.WatchRFQRequest(context.Background(), "r1", 0); err == nil {
+			t.Fatal("expected error for expired request")
+		}
+	})
+}
+
+func TestSubscribeRFQQuotes(t *testing.T) {
+	fake := &fakeRFQ{
+		errs: []error{errors.New("boom")},
+		polls: [][]rfq.RFQQuoteItem{
+			nil,
+			{{QuoteID: "q1", RequestID: "r1"}, {QuoteID: "q2", RequestID: "r2"}},
+		},
+	}
+	client := &clientImpl{rfq: fake}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err := client.SubscribeRFQQuotes(ctx, []string{" r1 ", "", "r2"})
+	if err != nil {
+		t.Fatalf("SubscribeRFQQuotes failed: %v", err)
+	}
+
+	first := <-results
+	if first.Err == nil {
+		t.Fatalf("expected fetch error first, got %+v", first)
+	}
+	var got []string
+	for len(got) < 2 {
+		select {
+		case res := <-results:
+			if res.Err != nil {
+				t.Fatalf("unexpected error: %v", res.Err)
+			}
+			got = append(got, res.Item.QuoteID)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if got[0] != "q1" || got[1] != "q2" {
+		t.Errorf("quotes = %v, want [q1 q2]", got)
+	}
+
+	fake.mu.Lock()
+	ids := fake.queries[0].RequestIDs
+	fake.mu.Unlock()
+	if len(ids) != 2 || ids[0] != "r1" || ids[1] != "r2" {
+		t.Errorf("request ids = %v, want [r1 r2]", ids)
+	}
+
+	cancel()
+	for range results {
+	}
+}
+
+// pagedRFQ serves quotes in offset-addressed pages like /rfq/data/quotes.
+type pagedRFQ struct {
+	rfq.Client
+
+	mu      sync.Mutex
+	quotes  []rfq.RFQQuoteItem
+	offsets []string
+}
+
+func (p *pagedRFQ) RFQQuotes(ctx context.Context, req *rfq.RFQQuotesQuery) (rfq.RFQQuotesResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.offsets = append(p.offsets, req.Offset)
+	start, err := strconv.Atoi(req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	if start > len(p.quotes) {
+		start = len(p.quotes)
+	}
+	end := start + req.Limit
+	if end > len(p.quotes) {
+		end = len(p.quotes)
+	}
+	return p.quotes[start:end], nil
+}
+
+func TestSubscribeRFQQuotesFollowsPages(t *testing.T) {
+	fake := &pagedRFQ{}
+	for i := 0; i < rfqQuotePageSize+1; i++ {
+		fake.quotes = append(fake.quotes, rfq.RFQQuoteItem{QuoteID: fmt.Sprintf("q%d", i)})
+	}
+	client := &clientImpl{rfq: fake}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err := client.SubscribeRFQQuotes(ctx, nil)
+	if err != nil {
+		t.Fatalf("SubscribeRFQQuotes failed: %v", err)
+	}
+
+	got := make(map[string]bool)
+	for len(got) < len(fake.quotes) {
+		select {
+		case res := <-results:
+			if res.Err != nil {
+				t.Fatalf("unexpected error: %v", res.Err)
+			}
+			got[res.Item.QuoteID] = true
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out after %d quotes", len(got))
+		}
+	}
+	if !got[fmt.Sprintf("q%d", rfqQuotePageSize)] {
+		t.Error("quote from the second page was not delivered")
+	}
+
+	fake.mu.Lock()
+	offsets := append([]string(nil), fake.offsets[:2]...)
+	fake.mu.Unlock()
+	if offsets[0] != "0" || offsets[1] != strconv.Itoa(rfqQuotePageSize) {
+		t.Errorf("offsets = %v, want [0 %d]", offsets, rfqQuotePageSize)
+	}
+
+	cancel()
+	for range results {
+	}
+}
+
+func TestRFQQuotesAllStopsOnRepeatedPage(t *testing.T) {
+	page := make([]rfq.RFQQuoteItem, rfqQuotePageSize)
+	for i := range page {
+		page[i] = rfq.RFQQuoteItem{QuoteID: fmt.Sprintf("q%d", i)}
+	}
+	// The fake ignores the offset, as a server without paging would.
+	fake := &fakeRFQ{polls: [][]rfq.RFQQuoteItem{page}}
+	client := &clientImpl{rfq: fake}
+
+	quotes, err := client.rfqQuotesAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("rfqQuotesAll failed: %v", err)
+	}
+	if len(quotes) != rfqQuotePageSize {
+		t.Errorf("got %d quotes, want %d", len(quotes), rfqQuotePageSize)
+	}
+	if fake.calls != 2 {
+		t.Errorf("expected 2 fetches, got %d", fake.calls)
+	}
+}
@@ -13,9 +13,15 @@ type stubClient struct {
 	tickSize      float64
 	feeRate       int64
 	book          clobtypes.OrderBookResponse
+	history       clobtypes.PricesHistoryResponse
+	historyReqs   []*clobtypes.PricesHistoryRequest
 	orders        map[string]clobtypes.OrdersResponse
 	trades        map[string]clobtypes.TradesResponse
 	builderTrades map[string]clobtypes.BuilderTradesResponse
+	balance       string
+	balanceReqs   []*clobtypes.BalanceAllowanceRequest
+	posted        []*clobtypes.SignedOrder
+	postErr       error
 }
 
 func newStubClient() *stubClient {
@@ -31,6 +37,11 @@ func (s *stubClient) OrderBook(ctx context.Context, req *clobtypes.BookRequest)
 	return s.book, nil
 }
 
+func (s *stubClient) PricesHistory(ctx context.Context, req *clobtypes.PricesHistoryRequest) (clobtypes.PricesHistoryResponse, error) {
+	s.historyReqs = append(s.historyReqs, req)
+	return s.history, nil
+}
+
 func (s *stubClient) TickSize(ctx context.Context, req *clobtypes.TickSizeRequest) (clobtypes.TickSizeResponse, error) {
 	return clobtypes.TickSizeResponse{MinimumTickSize: s.tickSize}, nil
 }
@@ -39,6 +50,19 @@ func (s *stubClient) FeeRate(ctx context.Context, req *clobtypes.FeeRateRequest)
 	return clobtypes.FeeRateResponse{BaseFee: int(s.feeRate)}, nil
 }
 
+func (s *stubClient) BalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceRequest) (clobtypes.BalanceAllowanceResponse, error) {
+	s.balanceReqs = append(s.balanceReqs, req)
+	return clobtypes.BalanceAllowanceResponse{Balance: s.balance}, nil
+}
+
+func (s *stubClient) PostOrder(ctx context.Context, req *clobtypes.SignedOrder) (clobtypes.OrderResponse, error) {
+	if s.postErr != nil {
+		return clobtypes.OrderResponse{}, s.postErr
+	}
+	s.posted = append(s.posted, req)
+	return clobtypes.OrderResponse{ID: "posted", Status: "live"}, nil
+}
+
 func (s *stubClient) Orders(ctx context.Context, req *clobtypes.OrdersRequest) (clobtypes.OrdersResponse, error) {
 	cursor := cursorFromOrdersRequest(req)
 	resp, ok := s.orders[cursor]
@@ -0,0 +1,154 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+)
+
+const (
+	defaultTradableMaxMarkets    = 100
+	defaultTradableMaxBookChecks = 25
+	defaultTradableProbeTimeout  = 2 * time.Second
+)
+
+// TradableTokenOptions configures the heuristics used by FindTradableToken.
+type TradableTokenOptions struct {
+	// ActiveOnly skips markets that are inactive or closed. Defaults to true.
+	ActiveOnly *bool
+	// AcceptingOrdersOnly skips markets that report accepting_orders=false. Defaults to true.
+	AcceptingOrdersOnly *bool
+	// RequireOrderBook probes the order book and skips tokens without one. Defaults to true.
+	RequireOrderBook *bool
+	// MinBookSize is the minimum combined bid and ask size on the book. The CLOB
+	// markets endpoint does not report volume, so book depth is used as the proxy.
+	MinBookSize decimal.Decimal
+	// MaxMarkets caps how many markets are scanned (default 100).
+	MaxMarkets int
+	// MaxBookChecks caps how many order books are probed (default 25).
+	MaxBookChecks int
+	// ProbeTimeout bounds each order book request (default 2s).
+	ProbeTimeout time.Duration
+}
+
+// TradableToken is a token selected by FindTradableToken.
+type TradableToken struct {
+	TokenID string
+	Outcome string
+	Market  clobtypes.Market
+	// Book is the probed order book, nil when RequireOrderBook is false.
+	Book *clobtypes.OrderBookResponse
+}
+
+// FindTradableToken scans CLOB markets and returns the first token that passes the
+// configured filters, probing order books when required.
+func FindTradableToken(ctx context.Context, client Client, opts *TradableTokenOptions) (*TradableToken, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts == nil {
+		opts = &TradableTokenOptions{}
+	}
+	activeOnly := opts.ActiveOnly == nil || *opts.ActiveOnly
+	acceptingOnly := opts.AcceptingOrdersOnly == nil || *opts.AcceptingOrdersOnly
+	requireBook := opts.RequireOrderBook == nil || *opts.RequireOrderBook
+	maxMarkets := opts.MaxMarkets
+	if maxMarkets <= 0 {
+		maxMarkets = defaultTradableMaxMarkets
+	}
+	maxChecks := opts.MaxBookChecks
+	if maxChecks <= 0 {
+		maxChecks = defaultTradableMaxBookChecks
+	}
+	probeTimeout := opts.ProbeTimeout
+	if probeTimeout <= 0 {
+		probeTimeout = defaultTradableProbeTimeout
+	}
+
+	req := &clobtypes.MarketsRequest{}
+	if activeOnly {
+		active := true
+		req.Active = &active
+	}
+
+	scanned := 0
+	checks := 0
+	cursor := clobtypes.InitialCursor
+	for cursor != clobtypes.EndCursor && scanned < maxMarkets {
+		req.Cursor = cursor
+		resp, err := client.Markets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, market := range resp.Data {
+			if scanned >= maxMarkets {
+				break
+			}
+			scanned++
+			if activeOnly && (!market.Active || market.Closed) {
+				continue
+			}
+			if acceptingOnly && !market.AcceptingOrders {
+				continue
+			}
+			for _, token := range market.Tokens {
+				tokenID := strings.TrimSpace(token.TokenID)
+				if tokenID == "" {
+					continue
+				}
+				found := &TradableToken{TokenID: tokenID, Outcome: token.Outcome, Market: market}
+				if !requireBook {
+					return found, nil
+				}
+				if checks >= maxChecks {
+					return nil, fmt.Errorf("no tradable token found after %d order book checks", checks)
+				}
+				checks++
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+				book, err := client.OrderBook(probeCtx, &clobtypes.BookRequest{TokenID: tokenID})
+				cancel()
+				if err != nil || !bookHasDepth(book, opts.MinBookSize) {
+					continue
+				}
+				found.Book = &book
+				return found, nil
+			}
+		}
+		if resp.NextCursor == "" || resp.NextCursor == cursor {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	return nil, fmt.Errorf("no tradable token found in %d markets", scanned)
+}
+
+func bookHasDepth(book clobtypes.OrderBookResponse, minSize decimal.Decimal) bool {
+	if len(book.Bids) == 0 && len(book.Asks) == 0 {
+		return false
+	}
+	if minSize.Sign() <= 0 {
+		return true
+	}
+	total := decimal.Zero
+	for _, levels := range [][]clobtypes.PriceLevel{book.Bids, book.Asks} {
+		for _, level := range levels {
+			size, err := level.SizeDec()
+			if err != nil {
+				continue
+			}
+			total = total.Add(size)
+		}
+	}
+	return total.GreaterThanOrEqual(minSize)
+}
@@ -0,0 +1,87 @@
+package clob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+func TestFindTradableToken(t *testing.T) {
+	markets := `{"data":[` +
+		`{"condition_id":"c0","active":true,"closed":true,"tokens":[{"token_id":"t0"}]},` +
+		`{"condition_id":"c1","active":true,"closed":false,"accepting_orders":false,"tokens":[{"token_id":"t1"}]},` +
+		`{"condition_id":"c2","active":true,"closed":false,"accepting_orders":true,"tokens":[{"token_id":"t2","outcome":"Yes"},{"token_id":"t3","outcome":"No"}]}` +
+		`],"next_cursor":"LTE="}`
+	doer := &staticDoer{responses: map[string]string{
+		"/markets?active=true&cursor=MA%3D%3D": markets,
+		"/book?token_id=t1":                    `{"bids":[{"price":"0.4","size":"500"}],"asks":[]}`,
+		"/book?token_id=t2":                    `{"bids":[{"price":"0.4","size":"1"}],"asks":[{"price":"0.6","size":"2"}]}`,
+		"/book?token_id=t3":                    `{"bids":[{"price":"0.4","size":"50"}],"asks":[{"price":"0.6","size":"60"}]}`,
+	}}
+	client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+	ctx := context.Background()
+
+	t.Run("Defaults", func(t *testing.T) {
+		token, err := FindTradableToken(ctx, client, nil)
+		if err != nil {
+			t.Fatalf("FindTradableToken failed: %v", err)
+		}
+		if token.TokenID != "t2" || token.Book == nil {
+			t.Errorf("expected t2 with book, got %+v", token)
+		}
+	})
+
+	t.Run("IncludeNotAccepting", func(t *testing.T) {
+		acceptingOnly := false
+		token, err := FindTradableToken(ctx, client, &TradableTokenOptions{AcceptingOrdersOnly: &acceptingOnly})
+		if err != nil {
+			t.Fatalf("FindTradableToken failed: %v", err)
+		}
+		if token.TokenID != "t1" || token.Market.ConditionID != "c1" {
+			t.Errorf("expected t1 in c1, got %+v", token)
+		}
+	})
+
+	t.Run("MinBookSize", func(t *testing.T) {
+		token, err := FindTradableToken(ctx, client, &TradableTokenOptions{
+			MinBookSize: decimal.NewFromInt(100),
+		})
+		if err != nil {
+			t.Fatalf("FindTradableToken failed: %v", err)
+		}
+		if token.TokenID != "t3" || token.Outcome != "No" || token.Market.ConditionID != "c2" {
+			t.Errorf("expected t3 in c2, got %+v", token)
+		}
+	})
+
+	t.Run("SkipBookProbe", func(t *testing.T) {
+		requireBook := false
+		activeOnly := false
+		acceptingOnly := false
+		doer := &staticDoer{responses: map[string]string{
+			"/markets?cursor=MA%3D%3D": markets,
+		}}
+		client := &clientImpl{httpClient: transport.NewClient(doer, "http://example")}
+		token, err := FindTradableToken(ctx, client, &TradableTokenOptions{
+			ActiveOnly:          &activeOnly,
+			AcceptingOrdersOnly: &acceptingOnly,
+			RequireOrderBook:    &requireBook,
+		})
+		if err != nil {
+			t.Fatalf("FindTradableToken failed: %v", err)
+		}
+		if token.TokenID != "t0" || token.Book != nil {
+			t.Errorf("expected t0 without book, got %+v", token)
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		_, err := FindTradableToken(ctx, client, &TradableTokenOptions{MinBookSize: decimal.NewFromInt(10000)})
+		if err == nil {
+			t.Fatal("expected error when no token qualifies")
+		}
+	})
+}
@@ -0,0 +1,387 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/shopspring/decimal"
+)
+
+// BookOptions configures a Book.
+type BookOptions struct {
+	// Resync fetches a fresh snapshot when the local book is known to be stale
+	// (hash mismatch or dropped events), e.g. from the CLOB REST /book endpoint.
+	// When nil, the book asks the WebSocket server for a new snapshot instead.
+	Resync func(ctx context.Context, assetID string) (OrderbookEvent, error)
+	// HashFunc computes the hash of a book. When set, the hash carried by each
+	// price_change is compared against the local book after the change is
+	// applied, and a mismatch triggers a resync. Hashes are compared without
+	// case or a leading "0x". There is no default: the server's hashing
+	// scheme has not been confirmed, and a wrong one would resync on every
+	// price change.
+	HashFunc func(book OrderbookEvent) string
+}
+
+// Book maintains a local L2 order book for a single asset from the market
+// channel: it applies book snapshots and price_change deltas, and resyncs when
+// it detects that it has diverged from the server.
+type Book struct {
+	assetID string
+	opts    BookOptions
+	client  Client
+
+	mu        sync.RWMutex
+	market    string
+	hash      string
+	timestamp string
+	bids      map[string]bookLevel
+	asks      map[string]bookLevel
+	synced    bool
+
+	resyncs atomic.Int64
+	updates chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+	closeMu sync.Once
+}
+
+type bookLevel struct {
+	price decimal.Decimal
+	size  decimal.Decimal
+	raw   OrderbookLevel
+}
+
+// NewBook subscribes to the order book and price changes for assetID and keeps
+// a local copy up to date until ctx is done or Close is called.
+func NewBook(ctx context.Context, client Client, assetID string, opts *BookOptions) (*Book, error) {
+	if client == nil {
+		return nil, errors.New("client is required")
+	}
+	assetID = strings.TrimSpace(assetID)
+	if assetID == "" {
+		return nil, errors.New("assetID is required")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	b := newBook(assetID, opts)
+	b.client = client
+
+	runCtx, cancel := context.WithCancel(ctx)
+	books, err := client.SubscribeOrderbookStream(runCtx, []string{assetID})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	prices, err := client.SubscribePricesStream(runCtx, []string{assetID})
+	if err != nil {
+		_ = books.Close()
+		cancel()
+		return nil, err
+	}
+	b.cancel = cancel
+	go b.run(runCtx, books, prices)
+	return b, nil
+}
+
+// BookTracker is another name for Book, matching the naming used by other
+// Polymarket clients.
+type BookTracker = Book
+
+// NewBookTracker is equivalent to NewBook.
+func NewBookTracker(ctx context.Context, client Client, assetID string, opts *BookOptions) (*BookTracker, error) {
+	return NewBook(ctx, client, assetID, opts)
+}
+
+func newBook(assetID string, opts *BookOptions) *Book {
+	b := &Book{
+		assetID: assetID,
+		bids:    make(map[string]bookLevel),
+		asks:    make(map[string]bookLevel),
+		updates: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	if opts != nil {
+		b.opts = *opts
+	}
+	return b
+}
+
+// AssetID returns the asset tracked by the book.
+func (b *Book) AssetID() string {
+	return b.assetID
+}
+
+// Updates returns a channel that receives a value after each change to the
+// book. Notifications are coalesced: a slow reader sees at most one pending
+// value. The channel is closed when the book stops.
+func (b *Book) Updates() <-chan struct{} {
+	return b.updates
+}
+
+// Synced reports whether the book holds a snapshot that has not been
+// invalidated since.
+func (b *Book) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// Resyncs returns how many times the book has been resynchronized.
+func (b *Book) Resyncs() int64 {
+	return b.resyncs.Load()
+}
+
+// Snapshot returns a copy of the book with bids sorted best (highest) first
+// and asks sorted best (lowest) first.
+func (b *Book) Snapshot() OrderbookEvent {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.snapshotLocked()
+}
+
+// BestBidAsk returns the top of book. ok is false until both sides have at
+// least one level.
+func (b *Book) BestBidAsk() (bid, ask decimal.Decimal, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	bestBid, bidOK := bestBookLevel(b.bids, true)
+	bestAsk, askOK := bestBookLevel(b.asks, false)
+	if !bidOK || !askOK {
+		return decimal.Zero, decimal.Zero, false
+	}
+	return bestBid.price, bestAsk.price, true
+}
+
+// Best returns the best bid and ask levels with their sizes. ok is false until
+// both sides have at least one level.
+func (b *Book) Best() (bid, ask OrderbookLevel, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	bestBid, bidOK := bestBookLevel(b.bids, true)
+	bestAsk, askOK := bestBookLevel(b.asks, false)
+	if !bidOK || !askOK {
+		return OrderbookLevel{}, OrderbookLevel{}, false
+	}
+	return bestBid.raw, bestAsk.raw, true
+}
+
+func bestBookLevel(side map[string]bookLevel, highest bool) (bookLevel, bool) {
+	var best bookLevel
+	found := false
+	for _, level := range side {
+		if !found || (highest && level.price.GreaterThan(best.price)) || (!highest && level.price.LessThan(best.price)) {
+			best = level
+			found = true
+		}
+	}
+	return best, found
+}
+
+// Close stops the subscriptions and closes the Updates channel.
+func (b *Book) Close() error {
+	b.closeMu.Do(func() {
+		if b.cancel != nil {
+			b.cancel()
+			<-b.done
+		}
+	})
+	return nil
+}
+
+func (b *Book) run(ctx context.Context, books *Stream[OrderbookEvent], prices *Stream[PriceChangeEvent]) {
+	defer close(b.done)
+	defer close(b.updates)
+	defer func() {
+		_ = books.Close()
+		_ = prices.Close()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-books.C:
+			if !ok {
+				return
+			}
+			if b.applySnapshot(event) {
+				b.notify()
+			}
+		case change, ok := <-prices.C:
+			if !ok {
+				return
+			}
+			applied, stale := b.applyPriceChange(change)
+			if stale {
+				b.resync(ctx)
+			} else if applied {
+				b.notify()
+			}
+		case err, ok := <-books.Err:
+			if ok && isLagged(err) {
+				b.resync(ctx)
+			}
+		case err, ok := <-prices.Err:
+			if ok && isLagged(err) {
+				b.resync(ctx)
+			}
+		}
+	}
+}
+
+func isLagged(err error) bool {
+	var lagged LaggedError
+	return errors.As(err, &lagged)
+}
+
+// applySnapshot replaces the book with event. It returns false for events of
+// other assets.
+func (b *Book) applySnapshot(event OrderbookEvent) bool {
+	if event.AssetID != "" && event.AssetID != b.assetID {
+		return false
+	}
+	bids := make(map[string]bookLevel, len(event.Bids))
+	asks := make(map[string]bookLevel, len(event.Asks))
+	for _, level := range event.Bids {
+		setLevel(bids, level)
+	}
+	for _, level := range event.Asks {
+		setLevel(asks, level)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids = bids
+	b.asks = asks
+	if event.Market != "" {
+		b.market = event.Market
+	}
+	b.hash = event.Hash
+	b.timestamp = event.Timestamp
+	b.synced = true
+	return true
+}
+
+// applyPriceChange applies a single level update. applied is true when the
+// book changed; stale is true when the book must be resynchronized.
+func (b *Book) applyPriceChange(change PriceChangeEvent) (applied, stale bool) {
+	if change.AssetId != b.assetID {
+		return false, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.synced {
+		// Deltas before the first snapshot (or during a resync) cannot be applied.
+		return false, false
+	}
+	var side map[string]bookLevel
+	switch strings.ToUpper(change.Side) {
+	case "BUY":
+		side = b.bids
+	case "SELL":
+		side = b.asks
+	default:
+		return false, false
+	}
+	if !setLevel(side, OrderbookLevel{Price: change.Price, Size: change.Size}) {
+		b.synced = false
+		return false, true
+	}
+	if change.Timestamp != "" {
+		b.timestamp = change.Timestamp
+	}
+	if change.Hash != "" {
+		b.hash = change.Hash
+		if b.opts.HashFunc != nil && !sameHash(b.opts.HashFunc(b.snapshotLocked()), change.Hash) {
+			b.synced = false
+			return false, true
+		}
+	}
+	return true, false
+}
+
+// setLevel upserts level into side, removing it when the size is zero. It
+// returns false if the level cannot be parsed.
+func setLevel(side map[string]bookLevel, level OrderbookLevel) bool {
+	price, err := decimal.NewFromString(level.Price)
+	if err != nil {
+		return false
+	}
+	size, err := decimal.NewFromString(level.Size)
+	if err != nil {
+		return false
+	}
+	key := price.String()
+	if size.Sign() <= 0 {
+		delete(side, key)
+		return true
+	}
+	side[key] = bookLevel{price: price, size: size, raw: level}
+	return true
+}
+
+func (b *Book) snapshotLocked() OrderbookEvent {
+	return OrderbookEvent{
+		AssetID:   b.assetID,
+		Market:    b.market,
+		Bids:      sortedLevels(b.bids, true),
+		Asks:      sortedLevels(b.asks, false),
+		Hash:      b.hash,
+		Timestamp: b.timestamp,
+	}
+}
+
+func sortedLevels(side map[string]bookLevel, descending bool) []OrderbookLevel {
+	levels := make([]bookLevel, 0, len(side))
+	for _, level := range side {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if descending {
+			return levels[i].price.GreaterThan(levels[j].price)
+		}
+		return levels[i].price.LessThan(levels[j].price)
+	})
+	out := make([]OrderbookLevel, len(levels))
+	for i, level := range levels {
+		out[i] = level.raw
+	}
+	return out
+}
+
+// snapshotRequester is implemented by clients that can ask the server to
+// re-send the book for an asset already subscribed to.
+type snapshotRequester interface {
+	requestSnapshot(ctx context.Context, assetIDs []string) error
+}
+
+func (b *Book) resync(ctx context.Context) {
+	b.mu.Lock()
+	b.synced = false
+	b.mu.Unlock()
+	b.resyncs.Add(1)
+
+	if b.opts.Resync != nil {
+		event, err := b.opts.Resync(ctx, b.assetID)
+		if err == nil && b.applySnapshot(event) {
+			b.notify()
+		}
+		return
+	}
+	if requester, ok := b.client.(snapshotRequester); ok {
+		_ = requester.requestSnapshot(ctx, []string{b.assetID})
+	}
+}
+
+func (b *Book) notify() {
+	select {
+	case b.updates <- struct{}{}:
+	default:
+	}
+}
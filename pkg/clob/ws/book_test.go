@@ -0,0 +1,213 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestBookApplySnapshotAndDeltas(t *testing.T) {
+	b := newBook("a1", nil)
+
+	// Deltas before the first snapshot are ignored.
+	if applied, stale := b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "BUY", Price: "0.4", Size: "1"}); applied || stale {
+		t.Fatalf("unexpected delta result before snapshot: applied=%v stale=%v", applied, stale)
+	}
+
+	b.applySnapshot(OrderbookEvent{
+		AssetID: "a1",
+		Market:  "m1",
+		Bids:    []OrderbookLevel{{Price: "0.40", Size: "10"}, {Price: "0.42", Size: "5"}},
+		Asks:    []OrderbookLevel{{Price: "0.60", Size: "7"}, {Price: "0.55", Size: "2"}},
+		Hash:    "h0",
+	})
+	if !b.Synced() {
+		t.Fatal("expected book to be synced after snapshot")
+	}
+
+	steps := []PriceChangeEvent{
+		{AssetId: "a1", Side: "BUY", Price: "0.45", Size: "3"},
+		{AssetId: "a1", Side: "buy", Price: "0.4", Size: "0"},
+		{AssetId: "a1", Side: "SELL", Price: "0.55", Size: "4", Hash: "h1"},
+		{AssetId: "other", Side: "SELL", Price: "0.1", Size: "4"},
+	}
+	for _, step := range steps {
+		if _, stale := b.applyPriceChange(step); stale {
+			t.Fatalf("unexpected stale book after %+v", step)
+		}
+	}
+
+	snap := b.Snapshot()
+	if snap.Market != "m1" || snap.Hash != "h1" {
+		t.Errorf("unexpected snapshot metadata: %+v", snap)
+	}
+	wantBids := []string{"0.45", "0.42"}
+	wantAsks := []string{"0.55", "0.60"}
+	if len(snap.Bids) != len(wantBids) || len(snap.Asks) != len(wantAsks) {
+		t.Fatalf("unexpected levels: bids=%v asks=%v", snap.Bids, snap.Asks)
+	}
+	for i, price := range wantBids {
+		if snap.Bids[i].Price != price {
+			t.Errorf("bid[%d] = %s, want %s", i, snap.Bids[i].Price, price)
+		}
+	}
+	for i, price := range wantAsks {
+		if snap.Asks[i].Price != price {
+			t.Errorf("ask[%d] = %s, want %s", i, snap.Asks[i].Price, price)
+		}
+	}
+	if snap.Asks[0].Size != "4" {
+		t.Errorf("ask size = %s, want 4", snap.Asks[0].Size)
+	}
+
+	bid, ask, ok := b.BestBidAsk()
+	if !ok || bid.String() != "0.45" || ask.String() != "0.55" {
+		t.Errorf("BestBidAsk = %s/%s/%v", bid, ask, ok)
+	}
+}
+
+func TestBookHashMismatchMarksStale(t *testing.T) {
+	b := newBook("a1", &BookOptions{
+		HashFunc: func(book OrderbookEvent) string {
+			if len(book.Bids) == 0 {
+				return ""
+			}
+			return "bid-" + book.Bids[0].Price
+		},
+	})
+	b.applySnapshot(OrderbookEvent{AssetID: "a1", Bids: []OrderbookLevel{{Price: "0.4", Size: "1"}}})
+
+	if _, stale := b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "BUY", Price: "0.5", Size: "1", Hash: "bid-0.5"}); stale {
+		t.Fatal("matching hash should not mark the book stale")
+	}
+	if _, stale := b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "BUY", Price: "0.6", Size: "1", Hash: "bid-0.5"}); !stale {
+		t.Fatal("mismatched hash should mark the book stale")
+	}
+	if b.Synced() {
+		t.Fatal("book should not be synced after a hash mismatch")
+	}
+	if applied, _ := b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "BUY", Price: "0.7", Size: "1"}); applied {
+		t.Fatal("deltas must not be applied while stale")
+	}
+}
+
+func TestBookResyncsOverWebSocket(t *testing.T) {
+	var subscribes atomic.Int32
+	s := mockWSServer(t, func(c *websocket.Conn) {
+		for {
+			_, msg, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			var req SubscriptionRequest
+			if json.Unmarshal(msg, &req) != nil || len(req.AssetIDs) == 0 {
+				continue
+			}
+			switch subscribes.Add(1) {
+			case 1:
+				_ = c.WriteJSON(map[string]interface{}{
+					"event_type": "book", "asset_id": "a1", "market": "m1",
+					"bids": []map[string]string{{"price": "0.40", "size": "10"}},
+					"asks": []map[string]string{{"price": "0.60", "size": "10"}},
+				})
+				// The hash does not match the local book, forcing a resync.
+				_ = c.WriteJSON(map[string]interface{}{
+					"event_type": "price_change", "market": "m1",
+					"price_changes": []map[string]string{{"asset_id": "a1", "side": "BUY", "price": "0.45", "size": "1", "hash": "bogus"}},
+				})
+			default:
+				_ = c.WriteJSON(map[string]interface{}{
+					"event_type": "book", "asset_id": "a1", "market": "m1",
+					"bids": []map[string]string{{"price": "0.48", "size": "10"}},
+					"asks": []map[string]string{{"price": "0.52", "size": "10"}},
+				})
+			}
+		}
+	})
+	defer s.Close()
+
+	client, err := NewClient("ws"+strings.TrimPrefix(s.URL, "http"), nil, nil)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	book, err := NewBook(context.Background(), client, "a1", &BookOptions{
+		HashFunc: func(OrderbookEvent) string { return "local" },
+	})
+	if err != nil {
+		t.Fatalf("NewBook failed: %v", err)
+	}
+	defer book.Close()
+
+	deadline := time.After(3 * time.Second)
+	for {
+		select {
+		case <-book.Updates():
+			bid, ask, ok := book.BestBidAsk()
+			if ok && bid.String() == "0.48" && ask.String() == "0.52" {
+				if book.Resyncs() < 1 {
+					t.Errorf("expected at least one resync, got %d", book.Resyncs())
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatalf("timed out waiting for resynced book (subscribes=%d, resyncs=%d)", subscribes.Load(), book.Resyncs())
+		}
+	}
+}
+
+func TestBookTrackerConcurrentReaders(t *testing.T) {
+	b := newBook("a1", nil)
+	b.applySnapshot(OrderbookEvent{
+		AssetID: "a1",
+		Bids:    []OrderbookLevel{{Price: "0.40", Size: "10"}},
+		Asks:    []OrderbookLevel{{Price: "0.60", Size: "10"}},
+	})
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				bid, ask, ok := b.Best()
+				if !ok || bid.Price == "" || ask.Price == "" {
+					t.Error("Best returned an empty side")
+					return
+				}
+				snap := b.Snapshot()
+				if len(snap.Bids) == 0 || len(snap.Asks) == 0 {
+					t.Error("Snapshot returned an empty side")
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 1; i <= 200; i++ {
+		size := strconv.Itoa(i)
+		b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "BUY", Price: "0.41", Size: size})
+		b.applyPriceChange(PriceChangeEvent{AssetId: "a1", Side: "SELL", Price: "0.59", Size: size})
+	}
+	close(done)
+	readers.Wait()
+
+	bid, ask, ok := b.Best()
+	if !ok || bid.Price != "0.41" || bid.Size != "200" || ask.Price != "0.59" || ask.Size != "200" {
+		t.Errorf("Best = %+v/%+v/%v", bid, ask, ok)
+	}
+}
@@ -5,10 +5,30 @@ package ws
 
 import (
 	"context"
+	"errors"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 )
 
+// ErrClientClosed is returned when subscribing on a client that has been
+// closed or is draining.
+var ErrClientClosed = errors.New("clobws client is closed")
+
+// Subscription errors. Callers can match them with errors.Is to tell a bad
+// request, which will not succeed on retry, from a connection problem.
+var (
+	// ErrNoAssetIDs is returned by market subscriptions without asset IDs.
+	ErrNoAssetIDs = errors.New("assetIDs required")
+	// ErrNoMarkets is returned by user subscriptions without markets.
+	ErrNoMarkets = errors.New("markets required")
+	// ErrAuthRequired is returned by user subscriptions when no API key
+	// credentials are configured.
+	ErrAuthRequired = errors.New("user subscription requires API key credentials")
+	// ErrNotConnected is returned when a channel has no open connection,
+	// and wraps the dial error when connecting fails.
+	ErrNotConnected = errors.New("connection is not established")
+)
+
 // Client defines the interface for interacting with Polymarket's WebSocket services.
 // It provides a stream-based API for real-time market data and private account updates.
 type Client interface {
@@ -16,6 +36,9 @@ type Client interface {
 
 	// Authenticate sets API credentials for private user streams.
 	Authenticate(signer auth.Signer, apiKey *auth.APIKey) Client
+	// UpdateAuth rotates the API credentials and re-authenticates the open
+	// user channel without reconnecting.
+	UpdateAuth(apiKey *auth.APIKey) error
 	// Deauthenticate clears API credentials for private user streams.
 	Deauthenticate() Client
 
@@ -23,8 +46,16 @@ type Client interface {
 	ConnectionState(channel Channel) ConnectionState
 	// ConnectionStateStream returns a stream of connection state transition events.
 	ConnectionStateStream(ctx context.Context) (*Stream[ConnectionStateEvent], error)
+	// WaitUntilConnected blocks until channel is ConnectionConnected, ctx is
+	// done or the client is closed. The user channel connects on its first
+	// subscription, so waiting on it before subscribing only returns on ctx.
+	WaitUntilConnected(ctx context.Context, channel Channel) error
 	// Close gracefully shuts down all active WebSocket connections and closes all event channels.
 	Close() error
+	// CloseAndDrain closes the client like Close, but lets consumers read
+	// events already buffered on their streams until they are empty or ctx
+	// is done.
+	CloseAndDrain(ctx context.Context) error
 
 	// -- Market Data Streams (Public) --
 
@@ -44,6 +75,28 @@ type Client interface {
 	SubscribeNewMarkets(ctx context.Context, assetIDs []string) (<-chan NewMarketEvent, error)
 	// SubscribeMarketResolutions subscribes to events triggered when markets are resolved.
 	SubscribeMarketResolutions(ctx context.Context, assetIDs []string) (<-chan MarketResolvedEvent, error)
+	// WithSyntheticMidpoints enables midpoints derived from book snapshots in the midpoint
+	// streams. It is off by default, so only server midpoint events are delivered.
+	WithSyntheticMidpoints(enabled bool) Client
+	// WithRESTFallback polls source (typically a clob.Client) for the tick size
+	// and last trade price of subscribed assets while the market channel is
+	// reconnecting or disconnected, delivering changes on the same streams.
+	// Polling stops when the channel reconnects. A nil source disables it.
+	WithRESTFallback(source RESTFallbackSource) Client
+
+	// -- Price Snapshots --
+
+	// LatestPrice returns the most recent price state received for an asset on the market channel.
+	LatestPrice(assetID string) (PriceSnapshot, bool)
+	// LatestPrices returns a copy of the most recent price state for every subscribed asset.
+	LatestPrices() map[string]PriceSnapshot
+	// LatestBestBidAsk subscribes to assetID's top of book, waits for the
+	// first event and unsubscribes, for one-shot reads. If assetID is already
+	// subscribed it requests a fresh snapshot rather than waiting for the next
+	// change. It returns ctx's error if no event arrives before ctx is done.
+	LatestBestBidAsk(ctx context.Context, assetID string) (BestBidAskEvent, error)
+	// LatestMidpoint is like LatestBestBidAsk for assetID's midpoint.
+	LatestMidpoint(ctx context.Context, assetID string) (MidpointEvent, error)
 
 	// -- User Activity Streams (Private) --
 
@@ -53,6 +106,12 @@ type Client interface {
 	// SubscribeUserTrades subscribes to trade execution events for the authenticated account.
 	// Requires an API key to be configured on the client.
 	SubscribeUserTrades(ctx context.Context, markets []string) (<-chan TradeEvent, error)
+	// SubscribeAll merges the order book of assetIDs with the account's
+	// order and trade updates for markets into one channel, so a trading
+	// loop can select on a single stream. Either list may be empty, but not
+	// both; markets require API key credentials like SubscribeUserOrders.
+	// The channel is closed when ctx is done or the client is closed.
+	SubscribeAll(ctx context.Context, assetIDs, markets []string) (<-chan Event, error)
 
 	// -- Advanced Stream Control --
 
@@ -85,6 +144,10 @@ type Client interface {
 	Unsubscribe(ctx context.Context, req *SubscriptionRequest) error
 	// UnsubscribeMarketAssets unsubscribes from all events related to specific assets on the market channel.
 	UnsubscribeMarketAssets(ctx context.Context, assetIDs []string) error
+	// UnsubscribeAsset force-removes an asset from the market channel,
+	// ignoring reference counts, and closes every stream subscribed to it
+	// after delivering an AssetUnsubscribedError on its Err channel.
+	UnsubscribeAsset(assetID string) error
 	// UnsubscribeUserMarkets unsubscribes from all account events related to specific markets.
 	UnsubscribeUserMarkets(ctx context.Context, markets []string) error
 }
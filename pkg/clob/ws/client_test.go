@@ -34,10 +34,9 @@ func TestClientConnection(t *testing.T) {
 		_, _, _ = c.ReadMessage()
 
 		// Send a dummy event
-		err := c.WriteJSON(map[string]string{
-			"event_type": "price",
-			"asset_id":   "123",
-			"price":      "0.5",
+		err := c.WriteJSON(map[string]interface{}{
+			"event_type":    "price_change",
+			"price_changes": []map[string]string{{"asset_id": "123", "price": "0.5"}},
 		})
 		if err != nil {
 			return
@@ -69,8 +68,8 @@ func TestClientConnection(t *testing.T) {
 
 	select {
 	case event := <-sub:
-		if event.AssetID != "123" {
-			t.Errorf("expected asset 123, got %s", event.AssetID)
+		if event.AssetId != "123" {
+			t.Errorf("expected asset 123, got %s", event.AssetId)
 		}
 	case <-time.After(1 * time.Second):
 		t.Error("timeout waiting for event")
@@ -0,0 +1,253 @@
+package ws
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+)
+
+// DefaultRESTFallbackInterval is how often the REST fallback polls while the
+// market channel is down.
+const DefaultRESTFallbackInterval = 2 * time.Second
+
+// RESTFallbackSource is the part of the CLOB REST client polled by the REST
+// fallback. clob.Client satisfies it.
+type RESTFallbackSource interface {
+	TickSize(ctx context.Context, req *clobtypes.TickSizeRequest) (clobtypes.TickSizeResponse, error)
+	LastTradePrice(ctx context.Context, req *clobtypes.LastTradePriceRequest) (clobtypes.LastTradePriceResponse, error)
+}
+
+// tickSizeInvalidator is implemented by sources that cache tick sizes, such
+// as clob.Client; the cache is bypassed so polling sees changes.
+type tickSizeInvalidator interface {
+	InvalidateTickSize(tokenID string)
+}
+
+// restFallback polls REST endpoints while the market channel is reconnecting
+// or disconnected and synthesizes tick_size_change and last_trade_price
+// events for subscribed assets. Events are only synthesized when a value
+// differs from the last one seen, over the WebSocket or a previous poll.
+type restFallback struct {
+	source   RESTFallbackSource
+	interval time.Duration
+
+	mu        sync.Mutex
+	tickSizes map[string]string
+	lastTrade map[string]string
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func newRESTFallback(source RESTFallbackSource, interval time.Duration) *restFallback {
+	if interval <= 0 {
+		interval = DefaultRESTFallbackInterval
+	}
+	return &restFallback{
+		source:    source,
+		interval:  interval,
+		tickSizes: make(map[string]string),
+		lastTrade: make(map[string]string),
+	}
+}
+
+// WithRESTFallback polls source for the tick size and last trade price of
+// subscribed assets while the market channel is reconnecting or
+// disconnected, and delivers changes on the tick size and last trade price
+// streams as if they came from the WebSocket. Polling stops as soon as the
+// market channel reconnects. A nil source disables the fallback.
+func (c *clientImpl) WithRESTFallback(source RESTFallbackSource) Client {
+	var fb *restFallback
+	if source != nil {
+		fb = newRESTFallback(source, DefaultRESTFallbackInterval)
+	}
+	if old := c.fallback.Swap(fb); old != nil {
+		old.stop()
+	}
+	if fb != nil {
+		if state := c.ConnectionState(ChannelMarket); state == ConnectionReconnecting {
+			c.updateRESTFallback(state)
+		}
+	}
+	return c
+}
+
+// updateRESTFallback starts or stops polling for a market channel state.
+func (c *clientImpl) updateRESTFallback(state ConnectionState) {
+	fb := c.fallback.Load()
+	if fb == nil {
+		return
+	}
+	if c.closing.Load() {
+		fb.stop()
+		return
+	}
+	switch state {
+	case ConnectionReconnecting, ConnectionDisconnected:
+		fb.start(c)
+	case ConnectionConnected:
+		fb.stop()
+	}
+}
+
+func (f *restFallback) start(c *clientImpl) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	f.done = make(chan struct{})
+	go f.run(ctx, c, f.done)
+}
+
+// stop cancels polling and waits for an in-flight poll to finish, so no
+// event is dispatched after it returns.
+func (f *restFallback) stop() {
+	f.mu.Lock()
+	cancel, done := f.cancel, f.done
+	f.cancel, f.done = nil, nil
+	f.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (f *restFallback) run(ctx context.Context, c *clientImpl, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		f.poll(ctx, c)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *restFallback) poll(ctx context.Context, c *clientImpl) {
+	tickAssets, tradeAssets := c.fallbackAssets()
+	invalidator, _ := f.source.(tickSizeInvalidator)
+	for _, assetID := range tickAssets {
+		if ctx.Err() != nil {
+			return
+		}
+		if invalidator != nil {
+			invalidator.InvalidateTickSize(assetID)
+		}
+		resp, err := f.source.TickSize(ctx, &clobtypes.TickSizeRequest{TokenID: assetID})
+		if err != nil {
+			continue
+		}
+		tickSize := resp.MinimumTickSize
+		if tickSize == 0 {
+			tickSize = resp.TickSize
+		}
+		if tickSize == 0 {
+			continue
+		}
+		value := strconv.FormatFloat(tickSize, 'f', -1, 64)
+		if f.changed(f.tickSizes, assetID, value) && ctx.Err() == nil {
+			c.dispatchTickSize(TickSizeChangeEvent{
+				AssetID:         assetID,
+				TickSize:        value,
+				MinimumTickSize: value,
+				Timestamp:       strconv.FormatInt(time.Now().UnixMilli(), 10),
+			})
+		}
+	}
+	for _, assetID := range tradeAssets {
+		if ctx.Err() != nil {
+			return
+		}
+		resp, err := f.source.LastTradePrice(ctx, &clobtypes.LastTradePriceRequest{TokenID: assetID})
+		if err != nil || resp.Price == "" {
+			continue
+		}
+		if f.changed(f.lastTrade, assetID, resp.Price) && ctx.Err() == nil {
+			c.dispatchLastTrade(LastTradePriceEvent{
+				AssetID:   assetID,
+				Price:     resp.Price,
+				Timestamp: strconv.FormatInt(time.Now().UnixMilli(), 10),
+			})
+		}
+	}
+}
+
+// changed records value for assetID and reports whether it differs from a
+// previously known value. The first value seen for an asset is a baseline.
+func (f *restFallback) changed(known map[string]string, assetID, value string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	prev, ok := known[assetID]
+	known[assetID] = value
+	return ok && prev != value
+}
+
+// observe records values delivered over the WebSocket so the fallback only
+// synthesizes real changes.
+func (f *restFallback) observe(known map[string]string, assetID, value string) {
+	if assetID == "" || value == "" {
+		return
+	}
+	f.mu.Lock()
+	known[assetID] = value
+	f.mu.Unlock()
+}
+
+// fallbackAssets returns the assets with tick size and last trade price
+// subscriptions. Subscriptions without an asset filter cover every asset
+// subscribed on the market channel.
+func (c *clientImpl) fallbackAssets() (tickAssets, tradeAssets []string) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	collect := func(assetSets []map[string]struct{}) []string {
+		seen := make(map[string]struct{})
+		var out []string
+		add := func(id string) {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				out = append(out, id)
+			}
+		}
+		for _, assets := range assetSets {
+			if len(assets) == 0 {
+				for id := range c.marketRefs {
+					add(id)
+				}
+				continue
+			}
+			for id := range assets {
+				add(id)
+			}
+		}
+		return out
+	}
+	var tickSets, tradeSets []map[string]struct{}
+	for _, sub := range c.tickSizeSubs {
+		tickSets = append(tickSets, sub.assets)
+	}
+	for _, sub := range c.lastTradeSubs {
+		tradeSets = append(tradeSets, sub.assets)
+	}
+	return collect(tickSets), collect(tradeSets)
+}
+
+// tickSizeValue returns the tick size carried by event in the form used by
+// the fallback, so "0.010" and "0.01" compare equal.
+func tickSizeValue(event TickSizeChangeEvent) string {
+	raw := event.TickSize
+	if raw == "" {
+		raw = event.MinimumTickSize
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return raw
+}
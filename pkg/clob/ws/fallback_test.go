@@ -0,0 +1,122 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+)
+
+type fakeRESTSource struct {
+	mu          sync.Mutex
+	tickSize    float64
+	lastTrade   string
+	calls       int
+	invalidated []string
+}
+
+func (f *fakeRESTSource) TickSize(ctx context.Context, req *clobtypes.TickSizeRequest) (clobtypes.TickSizeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return clobtypes.TickSizeResponse{MinimumTickSize: f.tickSize}, nil
+}
+
+func (f *fakeRESTSource) LastTradePrice(ctx context.Context, req *clobtypes.LastTradePriceRequest) (clobtypes.LastTradePriceResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return clobtypes.LastTradePriceResponse{Price: f.lastTrade}, nil
+}
+
+func (f *fakeRESTSource) InvalidateTickSize(tokenID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.invalidated = append(f.invalidated, tokenID)
+}
+
+func (f *fakeRESTSource) set(tickSize float64, lastTrade string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tickSize, f.lastTrade = tickSize, lastTrade
+}
+
+func (f *fakeRESTSource) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestRESTFallbackPollsWhileReconnecting(t *testing.T) {
+	c := newTestClient()
+	ticks := newSubscriptionEntry[TickSizeChangeEvent](c, ChannelMarket, TickSizeChange, []string{"a1"}, nil)
+	trades := newSubscriptionEntry[LastTradePriceEvent](c, ChannelMarket, LastTradePrice, []string{"a1"}, nil)
+	c.tickSizeSubs[ticks.id] = ticks
+	c.lastTradeSubs[trades.id] = trades
+
+	source := &fakeRESTSource{tickSize: 0.01, lastTrade: "0.5"}
+	c.fallback.Store(newRESTFallback(source, 10*time.Millisecond))
+
+	// Values seen over the WebSocket are the baseline, not changes.
+	c.dispatchTickSize(TickSizeChangeEvent{AssetID: "a1", TickSize: "0.010"})
+	c.dispatchLastTrade(LastTradePriceEvent{AssetID: "a1", Price: "0.5"})
+	<-ticks.ch
+	<-trades.ch
+
+	c.setConnState(ChannelMarket, ConnectionReconnecting, 1)
+	source.set(0.001, "0.55")
+
+	select {
+	case event := <-ticks.ch:
+		if event.AssetID != "a1" || event.TickSize != "0.001" {
+			t.Errorf("unexpected tick size event: %+v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for synthesized tick size change")
+	}
+	select {
+	case event := <-trades.ch:
+		if event.AssetID != "a1" || event.Price != "0.55" {
+			t.Errorf("unexpected last trade event: %+v", event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for synthesized last trade price")
+	}
+
+	c.setConnState(ChannelMarket, ConnectionConnected, 0)
+	calls := source.callCount()
+	time.Sleep(50 * time.Millisecond)
+	if got := source.callCount(); got != calls {
+		t.Errorf("polling continued after reconnect: %d calls, then %d", calls, got)
+	}
+	select {
+	case event := <-ticks.ch:
+		t.Errorf("unexpected tick size event after reconnect: %+v", event)
+	case event := <-trades.ch:
+		t.Errorf("unexpected last trade event after reconnect: %+v", event)
+	default:
+	}
+	source.mu.Lock()
+	invalidated := len(source.invalidated)
+	source.mu.Unlock()
+	if invalidated == 0 {
+		t.Error("expected the tick size cache to be bypassed")
+	}
+}
+
+func TestRESTFallbackIgnoredWithoutSource(t *testing.T) {
+	c := newTestClient()
+	c.setConnState(ChannelMarket, ConnectionReconnecting, 1)
+	if c.fallback.Load() != nil {
+		t.Fatal("fallback should be disabled by default")
+	}
+
+	source := &fakeRESTSource{tickSize: 0.01}
+	c.WithRESTFallback(source)
+	c.WithRESTFallback(nil)
+	if c.fallback.Load() != nil {
+		t.Fatal("nil source should disable the fallback")
+	}
+}
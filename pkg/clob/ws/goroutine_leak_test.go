@@ -244,3 +244,102 @@ func TestWebSocketGoroutineLeaks_SubscriptionCleanup(t *testing.T) {
 
 	time.Sleep(100 * time.Millisecond)
 }
+
+// TestWebSocketGoroutineLeaks_RepeatedSubscribeClose opens, subscribes and
+// closes clients against a server streaming events, so Close races the read
+// loop dispatching. Run with -race.
+func TestWebSocketGoroutineLeaks_RepeatedSubscribeClose(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		go func() {
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+		event := []byte(`{"event_type":"book","asset_id":"asset1","market":"m1","bids":[{"price":"0.5","size":"10"}],"asks":[]}`)
+		for {
+			if err := conn.WriteMessage(websocket.TextMessage, event); err != nil {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("CLOB_WS_HEARTBEAT_INTERVAL_MS", "5")
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	for i := 0; i < 20; i++ {
+		client, err := NewClient(wsURL, nil, nil)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		impl := client.(*clientImpl)
+
+		stream, err := client.SubscribeOrderbookStream(context.Background(), []string{"asset1"})
+		if err != nil {
+			t.Fatalf("failed to subscribe: %v", err)
+		}
+		select {
+		case <-stream.C:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for an event")
+		}
+
+		if err := client.Close(); err != nil {
+			t.Fatalf("failed to close client: %v", err)
+		}
+		for range impl.orderbookCh {
+		}
+		for range stream.C {
+		}
+	}
+}
+
+// TestWebSocketGoroutineLeaks_CloseDuringReconnectBackoff checks that Close
+// interrupts a reconnect backoff instead of waiting it out.
+func TestWebSocketGoroutineLeaks_CloseDuringReconnectBackoff(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	t.Setenv("CLOB_WS_RECONNECT_DELAY_MS", "60000")
+	t.Setenv("CLOB_WS_RECONNECT_MAX_DELAY_MS", "60000")
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	client, err := NewClient(wsURL, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	impl := client.(*clientImpl)
+	deadline := time.Now().Add(2 * time.Second)
+	for impl.ConnectionState(ChannelMarket) != ConnectionReconnecting {
+		if time.Now().After(deadline) {
+			t.Fatal("client never started reconnecting")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	start := time.Now()
+	if err := client.Close(); err != nil {
+		t.Fatalf("failed to close client: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Close took %s, want it to interrupt the backoff", elapsed)
+	}
+}
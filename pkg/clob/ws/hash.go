@@ -0,0 +1,90 @@
+package ws
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// orderbookHashPayload mirrors the server's book summary. Field order matters:
+// it determines the serialized bytes that are hashed.
+type orderbookHashPayload struct {
+	Market    string           `json:"market"`
+	AssetID   string           `json:"asset_id"`
+	Timestamp string           `json:"timestamp"`
+	Bids      []OrderbookLevel `json:"bids"`
+	Asks      []OrderbookLevel `json:"asks"`
+	Hash      string           `json:"hash"`
+}
+
+// orderbookHash computes a book hash following the scheme used by the
+// official clients:
+//
+//  1. Build the book summary object
+//     {"market","asset_id","timestamp","bids","asks","hash"} in that order,
+//     with "hash" set to the empty string and each level as
+//     {"price","size"} using the exact strings received from the server.
+//  2. Order bids by ascending price and asks by descending price, i.e. the
+//     best level last, as the server sends them.
+//  3. Serialize as compact JSON (no whitespace) and return the lowercase hex
+//     SHA-1 digest of the bytes, without a "0x" prefix.
+//
+// It is unexported and not used by Book because it has not been checked
+// against hashes from the live feed, which may include summary fields this
+// payload omits (min_order_size, tick_size, neg_risk).
+func orderbookHash(book OrderbookEvent) string {
+	payload := orderbookHashPayload{
+		Market:    book.Market,
+		AssetID:   book.AssetID,
+		Timestamp: book.Timestamp,
+		Bids:      hashLevels(book.Bids, false),
+		Asks:      hashLevels(book.Asks, true),
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ""
+	}
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sameHash compares two hex digests ignoring case and a "0x" prefix.
+func sameHash(a, b string) bool {
+	return strings.EqualFold(trimHexPrefix(a), trimHexPrefix(b))
+}
+
+func trimHexPrefix(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
+}
+
+// hashLevels returns levels sorted by price. Levels with unparsable prices keep
+// their relative position at the end.
+func hashLevels(levels []OrderbookLevel, descending bool) []OrderbookLevel {
+	out := make([]OrderbookLevel, len(levels))
+	copy(out, levels)
+	prices := make(map[string]decimal.Decimal, len(out))
+	for _, level := range out {
+		if price, err := decimal.NewFromString(level.Price); err == nil {
+			prices[level.Price] = price
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		pi, okI := prices[out[i].Price]
+		pj, okJ := prices[out[j].Price]
+		if !okI || !okJ {
+			return okI && !okJ
+		}
+		if descending {
+			return pi.GreaterThan(pj)
+		}
+		return pi.LessThan(pj)
+	})
+	return out
+}
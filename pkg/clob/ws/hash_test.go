@@ -0,0 +1,52 @@
+package ws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOrderbookHash(t *testing.T) {
+	// Regression value for the documented scheme, the SHA-1 of
+	// `{"market":"0xabc","asset_id":"123","timestamp":"1700000000000","bids":[...],"asks":[...],"hash":""}`.
+	// It pins the serialization, not agreement with the live server.
+	const want = "b8ac0042af23e7d160fbf9df6c1782734302e524"
+	book := OrderbookEvent{
+		Market:    "0xabc",
+		AssetID:   "123",
+		Timestamp: "1700000000000",
+		// Best-first order, as returned by Book.Snapshot; hashing reorders it.
+		Bids: []OrderbookLevel{{Price: "0.49", Size: "20"}, {Price: "0.48", Size: "30"}},
+		Asks: []OrderbookLevel{{Price: "0.51", Size: "10"}, {Price: "0.52", Size: "25"}},
+	}
+	if got := orderbookHash(book); got != want {
+		t.Fatalf("orderbookHash = %s, want %s", got, want)
+	}
+	book.Asks[0].Size = "11"
+	if orderbookHash(book) == want {
+		t.Error("modified book should hash differently")
+	}
+}
+
+func TestSameHash(t *testing.T) {
+	const h = "b8ac0042af23e7d160fbf9df6c1782734302e524"
+	if !sameHash(h, "0x"+strings.ToUpper(h)) {
+		t.Error("expected 0x-prefixed upper-case hash to match")
+	}
+	if sameHash(h, "0xdeadbeef") {
+		t.Error("different hashes should not match")
+	}
+}
+
+func TestBookSkipsHashByDefault(t *testing.T) {
+	b := newBook("123", nil)
+	b.applySnapshot(OrderbookEvent{
+		Market:  "0xabc",
+		AssetID: "123",
+		Bids:    []OrderbookLevel{{Price: "0.48", Size: "30"}},
+		Asks:    []OrderbookLevel{{Price: "0.51", Size: "5"}},
+	})
+	change := PriceChangeEvent{AssetId: "123", Side: "SELL", Price: "0.51", Size: "10", Market: "0xabc", Hash: "0xdeadbeef"}
+	if applied, stale := b.applyPriceChange(change); !applied || stale {
+		t.Fatalf("hash should not be checked by default: applied=%v stale=%v", applied, stale)
+	}
+}
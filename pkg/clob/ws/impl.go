@@ -1,9 +1,11 @@
 package ws
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/logger"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 
 	"github.com/gorilla/websocket"
 	"github.com/shopspring/decimal"
@@ -31,6 +34,7 @@ type clientImpl struct {
 	conn         *websocket.Conn
 	userConn     *websocket.Conn
 	signer       auth.Signer
+	authMu       sync.RWMutex // guards signer and apiKey
 	apiKey       *auth.APIKey
 	mu           sync.Mutex
 	userMu       sync.Mutex
@@ -39,6 +43,17 @@ type clientImpl struct {
 	done         chan struct{}
 	closeOnce    sync.Once
 	closing      atomic.Bool
+	draining     atomic.Bool
+	// dispatchMu orders sends on the global event channels before shutdown
+	// closes them.
+	dispatchMu sync.RWMutex
+	// loops tracks the read and ping loops; Close waits for them before
+	// closing the global channels.
+	loops sync.WaitGroup
+	// stopCtx is cancelled when Close starts, interrupting reconnect
+	// backoff sleeps and dials.
+	stopCtx  context.Context
+	stopLoop context.CancelFunc
 	// Per-connection context cancellation for goroutine lifecycle management
 	marketCtx      context.Context
 	marketCancel   context.CancelFunc
@@ -53,19 +68,35 @@ type clientImpl struct {
 	reconnectDelay      time.Duration
 	reconnectMaxDelay   time.Duration
 	reconnectMultiplier float64
+	reconnectJitter     float64
 	heartbeatInterval   time.Duration
 	heartbeatTimeout    time.Duration
 	readTimeout         atomic.Int64 // stored as nanoseconds
+	syntheticMidpoints  atomic.Bool
+	fallback            atomic.Pointer[restFallback]
+	// recorder, when set by RecordTo, receives every event frame read.
+	recorder *frameRecorder
+	// replaying marks a client created by NewReplayClient: it never dials
+	// or writes, and frames come from the recording instead.
+	replaying   bool
+	replaySpeed float64
 
 	lastPongMarket atomic.Int64
 	lastPongUser   atomic.Int64
 
-	subMu          sync.Mutex
-	marketRefs     map[string]int
-	userRefs       map[string]int
-	lastAuth       *AuthPayload
-	customFeatures bool
-	nextSubID      uint64
+	subMu      sync.Mutex
+	marketRefs map[string]int
+	// customAssets holds the subscribed assets that at least one
+	// subscription asked custom features for; an asset leaves the set when
+	// its last reference is released.
+	customAssets map[string]struct{}
+	userRefs     map[string]int
+	lastAuth     *AuthPayload
+	nextSubID    uint64
+
+	// Latest price state per asset, fed by the dispatch path
+	priceMu      sync.RWMutex
+	latestPrices map[string]PriceSnapshot
 
 	// Connection state
 	stateMu     sync.Mutex
@@ -100,7 +131,17 @@ type clientImpl struct {
 	// Callbacks or listeners could be added here
 }
 
-func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey) (Client, error) {
+func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey, opts ...Option) (Client, error) {
+	c := newClientImpl(url, signer, apiKey, opts...)
+	if err := c.ensureMarketConn(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// newClientImpl builds a client from the environment and opts without
+// connecting.
+func newClientImpl(url string, signer auth.Signer, apiKey *auth.APIKey, opts ...Option) *clientImpl {
 	marketURL, userURL, baseURL := normalizeWSURLs(url)
 
 	reconnect := true
@@ -125,6 +166,12 @@ func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey) (Client, err
 			reconnectMultiplier = mult
 		}
 	}
+	reconnectJitter := DefaultReconnectJitter
+	if raw := strings.TrimSpace(os.Getenv("CLOB_WS_RECONNECT_JITTER")); raw != "" {
+		if jitter, err := strconv.ParseFloat(raw, 64); err == nil {
+			reconnectJitter = clampJitter(jitter)
+		}
+	}
 	reconnectMax := 5
 	if raw := strings.TrimSpace(os.Getenv("CLOB_WS_RECONNECT_MAX")); raw != "" {
 		if max, err := strconv.Atoi(raw); err == nil {
@@ -146,6 +193,7 @@ func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey) (Client, err
 		heartbeatTimeout = heartbeatInterval * 3
 	}
 
+	stopCtx, stopLoop := context.WithCancel(context.Background())
 	c := &clientImpl{
 		baseURL:             baseURL,
 		marketURL:           marketURL,
@@ -158,11 +206,15 @@ func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey) (Client, err
 		reconnectDelay:      reconnectDelay,
 		reconnectMaxDelay:   reconnectMaxDelay,
 		reconnectMultiplier: reconnectMultiplier,
+		reconnectJitter:     reconnectJitter,
 		reconnectMax:        reconnectMax,
 		heartbeatInterval:   heartbeatInterval,
 		heartbeatTimeout:    heartbeatTimeout,
 		done:                make(chan struct{}),
+		stopCtx:             stopCtx,
+		stopLoop:            stopLoop,
 		marketRefs:          make(map[string]int),
+		customAssets:        make(map[string]struct{}),
 		userRefs:            make(map[string]int),
 		marketState:         ConnectionDisconnected,
 		userState:           ConnectionDisconnected,
@@ -189,27 +241,68 @@ func NewClient(url string, signer auth.Signer, apiKey *auth.APIKey) (Client, err
 		orderCh:             make(chan OrderEvent, 100),
 	}
 
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
 	// Initialize atomic readTimeout
 	c.readTimeout.Store(int64(DefaultReadTimeout))
-
-	if err := c.ensureMarketConn(); err != nil {
-		return nil, err
+	if raw := strings.TrimSpace(os.Getenv("CLOB_WS_SYNTHETIC_MIDPOINTS")); raw != "" {
+		c.syntheticMidpoints.Store(raw != "0" && strings.ToLower(raw) != "false")
 	}
-	return c, nil
+	return c
 }
 
 func (c *clientImpl) Authenticate(signer auth.Signer, apiKey *auth.APIKey) Client {
+	c.authMu.Lock()
 	c.signer = signer
 	c.apiKey = apiKey
+	c.authMu.Unlock()
 	c.subMu.Lock()
 	c.lastAuth = nil
 	c.subMu.Unlock()
 	return c
 }
 
+// UpdateAuth swaps the API credentials used for user channel subscriptions
+// and re-authenticates the open user connection by re-sending its
+// subscription with the new credentials, without reconnecting.
+func (c *clientImpl) UpdateAuth(apiKey *auth.APIKey) error {
+	if apiKey == nil || apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
+		return errors.New("apiKey with key, secret and passphrase is required")
+	}
+	if err := auth.ValidateSecret(apiKey.Secret); err != nil {
+		return err
+	}
+	c.authMu.Lock()
+	c.apiKey = apiKey
+	c.authMu.Unlock()
+	payload := c.authPayload()
+
+	c.subMu.Lock()
+	copy := *payload
+	c.lastAuth = &copy
+	markets := make([]string, 0, len(c.userRefs))
+	for id := range c.userRefs {
+		markets = append(markets, id)
+	}
+	c.subMu.Unlock()
+
+	if len(markets) == 0 || c.getConn(ChannelUser) == nil {
+		return nil
+	}
+	req := NewUserSubscription(markets)
+	req.Auth = payload
+	return c.writeJSON(ChannelUser, req)
+}
+
 func (c *clientImpl) Deauthenticate() Client {
+	c.authMu.Lock()
 	c.signer = nil
 	c.apiKey = nil
+	c.authMu.Unlock()
 	c.subMu.Lock()
 	c.lastAuth = nil
 	c.subMu.Unlock()
@@ -236,6 +329,7 @@ func normalizeWSURLs(raw string) (string, string, string) {
 }
 
 func (c *clientImpl) pingLoop(channel Channel) {
+	defer c.loops.Done()
 	interval := c.heartbeatInterval
 	if interval <= 0 {
 		interval = 10 * time.Second
@@ -277,8 +371,15 @@ func (c *clientImpl) pingLoop(channel Channel) {
 }
 
 func (c *clientImpl) ensureMarketConn() error {
+	return c.ensureMarketConnContext(context.Background())
+}
+
+func (c *clientImpl) ensureMarketConnContext(ctx context.Context) error {
 	c.marketInitMu.Lock()
 	defer c.marketInitMu.Unlock()
+	if c.closing.Load() {
+		return ErrClientClosed
+	}
 	if c.getConn(ChannelMarket) != nil {
 		return nil
 	}
@@ -290,22 +391,26 @@ func (c *clientImpl) ensureMarketConn() error {
 	// Create new context for this connection's goroutines
 	c.createGoroutineContext(ChannelMarket)
 
-	if err := c.connectMarket(); err != nil {
+	if err := c.connect(ctx, c.marketURL, c.setMarketConn); err != nil {
 		c.setConnState(ChannelMarket, ConnectionDisconnected, 0)
 		return err
 	}
 	c.setConnState(ChannelMarket, ConnectionConnected, 0)
 	c.setLastPong(ChannelMarket, time.Now())
-	go c.readLoop(ChannelMarket)
-	if !c.disablePing {
-		go c.pingLoop(ChannelMarket)
-	}
+	c.startLoops(ChannelMarket)
 	return nil
 }
 
 func (c *clientImpl) ensureUserConn() error {
+	return c.ensureUserConnContext(context.Background())
+}
+
+func (c *clientImpl) ensureUserConnContext(ctx context.Context) error {
 	c.userInitMu.Lock()
 	defer c.userInitMu.Unlock()
+	if c.closing.Load() {
+		return ErrClientClosed
+	}
 	if c.getConn(ChannelUser) != nil {
 		return nil
 	}
@@ -317,37 +422,58 @@ func (c *clientImpl) ensureUserConn() error {
 	// Create new context for this connection's goroutines
 	c.createGoroutineContext(ChannelUser)
 
-	if err := c.connectUser(); err != nil {
+	if err := c.connect(ctx, c.userURL, c.setUserConn); err != nil {
 		c.setConnState(ChannelUser, ConnectionDisconnected, 0)
 		return err
 	}
 	c.setConnState(ChannelUser, ConnectionConnected, 0)
 	c.setLastPong(ChannelUser, time.Now())
-	go c.readLoop(ChannelUser)
-	if !c.disablePing {
-		go c.pingLoop(ChannelUser)
-	}
+	c.startLoops(ChannelUser)
 	return nil
 }
 
 func (c *clientImpl) ensureConn(channel Channel) error {
+	return c.ensureConnContext(context.Background(), channel)
+}
+
+// ensureConnContext establishes the channel connection, aborting the dial when ctx is done.
+func (c *clientImpl) ensureConnContext(ctx context.Context, channel Channel) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if c.closing.Load() {
+		return ErrClientClosed
+	}
+	if c.replaying {
+		return nil
+	}
 	switch channel {
 	case ChannelMarket:
-		return c.ensureMarketConn()
+		return c.ensureMarketConnContext(ctx)
 	case ChannelUser:
-		return c.ensureUserConn()
+		return c.ensureUserConnContext(ctx)
 	default:
 		return errors.New("unknown subscription channel")
 	}
 }
 
-func (c *clientImpl) connect(url string, setConn func(*websocket.Conn)) error {
+func (c *clientImpl) connect(ctx context.Context, url string, setConn func(*websocket.Conn)) error {
 	headers := http.Header{}
 	headers.Set("User-Agent", "Go-Polymarket-SDK/1.0")
 
-	conn, _, err := websocket.DefaultDialer.Dial(url, headers)
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, headers)
 	if err != nil {
-		return err
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		// The dial deadline can expire just before ctx reports it.
+		if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+			return context.DeadlineExceeded
+		}
+		return fmt.Errorf("%w: %w", ErrNotConnected, err)
 	}
 	setConn(conn)
 
@@ -361,14 +487,35 @@ func (c *clientImpl) connect(url string, setConn func(*websocket.Conn)) error {
 }
 
 func (c *clientImpl) connectMarket() error {
-	return c.connect(c.marketURL, c.setMarketConn)
+	return c.connect(c.stopContext(), c.marketURL, c.setMarketConn)
 }
 
 func (c *clientImpl) connectUser() error {
-	return c.connect(c.userURL, c.setUserConn)
+	return c.connect(c.stopContext(), c.userURL, c.setUserConn)
+}
+
+// stopContext returns the context cancelled when Close starts.
+func (c *clientImpl) stopContext() context.Context {
+	if c.stopCtx == nil {
+		return context.Background()
+	}
+	return c.stopCtx
+}
+
+// startLoops starts the read loop, and the ping loop unless disabled, for a
+// freshly connected channel.
+func (c *clientImpl) startLoops(channel Channel) {
+	c.loops.Add(1)
+	go c.readLoop(channel)
+	if !c.disablePing {
+		c.loops.Add(1)
+		go c.pingLoop(channel)
+	}
 }
 
 func (c *clientImpl) readLoop(channel Channel) {
+	defer c.loops.Done()
+
 	// Get the context for this connection to enable proper cancellation
 	ctx := c.getGoroutineContext(channel)
 	if ctx == nil {
@@ -437,25 +584,12 @@ func (c *clientImpl) readLoop(channel Channel) {
 			logger.Debug("Raw WS Message: %s", string(message))
 		}
 
-		// Parse generic message to determine type
-		var rawObj map[string]interface{}
-		var rawArr []map[string]interface{}
-
-		// Try unmarshal as array first
-		if err := json.Unmarshal(message, &rawArr); err == nil {
-			for _, item := range rawArr {
-				c.processEvent(item)
-			}
-			continue
-		}
-
-		// Try unmarshal as single object
-		if err := json.Unmarshal(message, &rawObj); err == nil {
-			c.processEvent(rawObj)
-			continue
+		if c.recorder != nil {
+			c.recorder.record(channel, message)
 		}
+		c.processMessage(message)
 	}
-	if c.closing.Load() {
+	if c.closing.Load() && !c.draining.Load() {
 		c.shutdown()
 	}
 }
@@ -483,16 +617,43 @@ func (c *clientImpl) lastPong(channel Channel) time.Time {
 	return time.Time{}
 }
 
-func (c *clientImpl) processEvent(raw map[string]interface{}) {
-	eventType, _ := raw["event_type"].(string)
-	if eventType == "" {
-		eventType, _ = raw["type"].(string)
+// processMessage dispatches a frame holding one event object or an array of
+// them. Anything else, such as PONG text frames, is ignored.
+func (c *clientImpl) processMessage(message []byte) {
+	message = bytes.TrimSpace(message)
+	if len(message) == 0 {
+		return
 	}
+	switch message[0] {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(message, &items); err != nil {
+			return
+		}
+		for _, item := range items {
+			c.processEvent(item)
+		}
+	case '{':
+		c.processEvent(message)
+	}
+}
 
-	// Re-marshal to bytes to use existing logic or decode from map directly
-	// For simplicity, let's just use the map or re-marshal for struct decoding
-	// Re-marshalling is inefficient but safe for now to reuse struct definitions
-	msgBytes, _ := json.Marshal(raw)
+// eventEnvelope is decoded first to route an event by its type; the event
+// itself is then decoded from the same bytes straight into its typed form.
+type eventEnvelope struct {
+	EventType string `json:"event_type"`
+	Type      string `json:"type"`
+}
+
+func (c *clientImpl) processEvent(msgBytes []byte) {
+	var envelope eventEnvelope
+	if err := json.Unmarshal(msgBytes, &envelope); err != nil {
+		return
+	}
+	eventType := envelope.EventType
+	if eventType == "" {
+		eventType = envelope.Type
+	}
 
 	switch eventType {
 	case "book", "orderbook": // Orderbook snapshot/update
@@ -523,12 +684,9 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 			}
 			c.dispatchOrderbook(event)
 
-			if len(event.Bids) > 0 && len(event.Asks) > 0 {
-				bid, bidErr := decimal.NewFromString(event.Bids[0].Price)
-				ask, askErr := decimal.NewFromString(event.Asks[0].Price)
-				if bidErr == nil && askErr == nil {
-					mid := bid.Add(ask).Div(decimal.NewFromInt(2))
-					c.dispatchMidpoint(MidpointEvent{AssetID: event.AssetID, Midpoint: mid.String()})
+			if c.syntheticMidpoints.Load() {
+				if mid, ok := bookMidpoint(event); ok {
+					c.dispatchMidpoint(MidpointEvent{AssetID: event.AssetID, Midpoint: mid.String(), Synthetic: true})
 				}
 			}
 		}
@@ -624,22 +782,205 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 			c.dispatchMarketResolved(event)
 		}
 	case "trade":
-		var event TradeEvent
-		if err := json.Unmarshal(msgBytes, &event); err == nil {
-			c.dispatchTrade(event)
+		var wire tradeWire
+		if err := json.Unmarshal(msgBytes, &wire); err == nil {
+			c.dispatchTrade(wire.event())
 		}
 	case "order":
-		var event OrderEvent
-		if err := json.Unmarshal(msgBytes, &event); err == nil {
-			c.dispatchOrder(event)
+		var wire orderWire
+		if err := json.Unmarshal(msgBytes, &wire); err == nil {
+			c.dispatchOrder(wire.event())
+		}
+	}
+}
+
+// flexString decodes a JSON string or number into its text. User channel
+// events do not quote numeric fields (prices, sizes, timestamps)
+// consistently; numbers keep the exact digits sent by the server.
+type flexString string
+
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*s = flexString(v)
+		return nil
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*s = flexString(n)
+	return nil
+}
+
+// tradeWire is the wire form of TradeEvent.
+type tradeWire struct {
+	ID           flexString       `json:"id"`
+	AssetID      flexString       `json:"asset_id"`
+	Market       flexString       `json:"market"`
+	Outcome      flexString       `json:"outcome"`
+	Price        flexString       `json:"price"`
+	Size         flexString       `json:"size"`
+	Side         flexString       `json:"side"`
+	Status       flexString       `json:"status"`
+	Type         flexString       `json:"type"`
+	EventType    flexString       `json:"event_type"`
+	Owner        flexString       `json:"owner"`
+	TradeOwner   flexString       `json:"trade_owner"`
+	TraderSide   flexString       `json:"trader_side"`
+	TakerOrderID flexString       `json:"taker_order_id"`
+	MakerOrders  []makerOrderWire `json:"maker_orders"`
+	FeeRateBps   flexString       `json:"fee_rate_bps"`
+	MatchTime    flexString       `json:"matchtime"`
+	LastUpdate   flexString       `json:"last_update"`
+	Timestamp    flexString       `json:"timestamp"`
+}
+
+func (w tradeWire) event() TradeEvent {
+	event := TradeEvent{
+		ID:           string(w.ID),
+		AssetID:      string(w.AssetID),
+		Market:       string(w.Market),
+		Outcome:      string(w.Outcome),
+		Price:        string(w.Price),
+		Size:         string(w.Size),
+		Side:         string(w.Side),
+		Status:       string(w.Status),
+		Type:         string(w.Type),
+		EventType:    string(w.EventType),
+		Owner:        string(w.Owner),
+		TradeOwner:   string(w.TradeOwner),
+		TraderSide:   string(w.TraderSide),
+		TakerOrderID: string(w.TakerOrderID),
+		FeeRateBps:   string(w.FeeRateBps),
+		MatchTime:    string(w.MatchTime),
+		LastUpdate:   string(w.LastUpdate),
+		Timestamp:    string(w.Timestamp),
+	}
+	if len(w.MakerOrders) > 0 {
+		event.MakerOrders = make([]TradeMakerOrder, len(w.MakerOrders))
+		for i, maker := range w.MakerOrders {
+			event.MakerOrders[i] = TradeMakerOrder{
+				OrderID:       string(maker.OrderID),
+				AssetID:       string(maker.AssetID),
+				Owner:         string(maker.Owner),
+				MakerAddress:  string(maker.MakerAddress),
+				Outcome:       string(maker.Outcome),
+				Price:         string(maker.Price),
+				MatchedAmount: string(maker.MatchedAmount),
+				FeeRateBps:    string(maker.FeeRateBps),
+				Side:          string(maker.Side),
+			}
 		}
 	}
+	return event
+}
+
+// makerOrderWire is the wire form of TradeMakerOrder.
+type makerOrderWire struct {
+	OrderID       flexString `json:"order_id"`
+	AssetID       flexString `json:"asset_id"`
+	Owner         flexString `json:"owner"`
+	MakerAddress  flexString `json:"maker_address"`
+	Outcome       flexString `json:"outcome"`
+	Price         flexString `json:"price"`
+	MatchedAmount flexString `json:"matched_amount"`
+	FeeRateBps    flexString `json:"fee_rate_bps"`
+	Side          flexString `json:"side"`
+}
+
+// orderWire is the wire form of OrderEvent.
+type orderWire struct {
+	ID              flexString `json:"id"`
+	AssetID         flexString `json:"asset_id"`
+	Market          flexString `json:"market"`
+	Side            flexString `json:"side"`
+	Price           flexString `json:"price"`
+	OriginalSize    flexString `json:"original_size"`
+	SizeMatched     flexString `json:"size_matched"`
+	Status          flexString `json:"status"`
+	Type            flexString `json:"type"`
+	Outcome         flexString `json:"outcome"`
+	OrderOwner      flexString `json:"order_owner"`
+	Owner           flexString `json:"owner"`
+	Timestamp       flexString `json:"timestamp"`
+	CreatedAt       flexString `json:"created_at"`
+	Expiration      flexString `json:"expiration"`
+	OrderType       flexString `json:"order_type"`
+	MakerAddress    flexString `json:"maker_address"`
+	AssociateTrades []string   `json:"associate_trades"`
+	EventType       flexString `json:"event_type"`
+}
+
+func (w orderWire) event() OrderEvent {
+	return OrderEvent{
+		ID:              string(w.ID),
+		AssetID:         string(w.AssetID),
+		Market:          string(w.Market),
+		Side:            string(w.Side),
+		Price:           string(w.Price),
+		OriginalSize:    string(w.OriginalSize),
+		SizeMatched:     string(w.SizeMatched),
+		Status:          string(w.Status),
+		Type:            string(w.Type),
+		Outcome:         string(w.Outcome),
+		OrderOwner:      string(w.OrderOwner),
+		Owner:           string(w.Owner),
+		Timestamp:       string(w.Timestamp),
+		CreatedAt:       string(w.CreatedAt),
+		Expiration:      string(w.Expiration),
+		OrderType:       string(w.OrderType),
+		MakerAddress:    string(w.MakerAddress),
+		AssociateTrades: w.AssociateTrades,
+		EventType:       string(w.EventType),
+	}
+}
+
+// bookMidpoint returns (best bid + best ask) / 2. Levels are scanned rather
+// than taking the first entry because the server sends the best level last.
+func bookMidpoint(event OrderbookEvent) (decimal.Decimal, bool) {
+	bid, bidOK := bestLevel(event.Bids, true)
+	ask, askOK := bestLevel(event.Asks, false)
+	if !bidOK || !askOK {
+		return decimal.Zero, false
+	}
+	return bid.Add(ask).Div(decimal.NewFromInt(2)), true
+}
+
+func bestLevel(levels []OrderbookLevel, highest bool) (decimal.Decimal, bool) {
+	var best decimal.Decimal
+	found := false
+	for _, level := range levels {
+		price, err := decimal.NewFromString(level.Price)
+		if err != nil {
+			continue
+		}
+		if !found || (highest && price.GreaterThan(best)) || (!highest && price.LessThan(best)) {
+			best = price
+			found = true
+		}
+	}
+	return best, found
 }
 
-func trySendGlobal[T any](ch chan T, msg T) {
+// trySendGlobal delivers msg on a global event channel without blocking.
+// Sends are dropped once the client is closing, and shutdown waits for
+// in-flight sends before closing the channels.
+func trySendGlobal[T any](c *clientImpl, ch chan T, msg T) {
 	if ch == nil {
 		return
 	}
+	c.dispatchMu.RLock()
+	defer c.dispatchMu.RUnlock()
+	if c.closing.Load() {
+		return
+	}
 	select {
 	case ch <- msg:
 	default:
@@ -647,7 +988,7 @@ func trySendGlobal[T any](ch chan T, msg T) {
 }
 
 func (c *clientImpl) dispatchOrderbook(event OrderbookEvent) {
-	trySendGlobal(c.orderbookCh, event)
+	trySendGlobal(c, c.orderbookCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.orderbookSubs)
 	c.subMu.Unlock()
@@ -659,7 +1000,16 @@ func (c *clientImpl) dispatchOrderbook(event OrderbookEvent) {
 }
 
 func (c *clientImpl) dispatchPrice(event PriceEvent) {
-	trySendGlobal(c.priceCh, event)
+	for i := range event.PriceChanges {
+		if event.PriceChanges[i].Market == "" {
+			event.PriceChanges[i].Market = event.Market
+		}
+		if event.PriceChanges[i].Timestamp == "" {
+			event.PriceChanges[i].Timestamp = event.Timestamp
+		}
+	}
+	c.recordPriceChanges(event)
+	trySendGlobal(c, c.priceCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.priceSubs)
 	c.subMu.Unlock()
@@ -673,7 +1023,7 @@ func (c *clientImpl) dispatchPrice(event PriceEvent) {
 }
 
 func (c *clientImpl) dispatchMidpoint(event MidpointEvent) {
-	trySendGlobal(c.midpointCh, event)
+	trySendGlobal(c, c.midpointCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.midpointSubs)
 	c.subMu.Unlock()
@@ -685,7 +1035,10 @@ func (c *clientImpl) dispatchMidpoint(event MidpointEvent) {
 }
 
 func (c *clientImpl) dispatchLastTrade(event LastTradePriceEvent) {
-	trySendGlobal(c.lastTradeCh, event)
+	if fb := c.fallback.Load(); fb != nil {
+		fb.observe(fb.lastTrade, event.AssetID, event.Price)
+	}
+	trySendGlobal(c, c.lastTradeCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.lastTradeSubs)
 	c.subMu.Unlock()
@@ -697,7 +1050,10 @@ func (c *clientImpl) dispatchLastTrade(event LastTradePriceEvent) {
 }
 
 func (c *clientImpl) dispatchTickSize(event TickSizeChangeEvent) {
-	trySendGlobal(c.tickSizeCh, event)
+	if fb := c.fallback.Load(); fb != nil {
+		fb.observe(fb.tickSizes, event.AssetID, tickSizeValue(event))
+	}
+	trySendGlobal(c, c.tickSizeCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.tickSizeSubs)
 	c.subMu.Unlock()
@@ -709,7 +1065,8 @@ func (c *clientImpl) dispatchTickSize(event TickSizeChangeEvent) {
 }
 
 func (c *clientImpl) dispatchBestBidAsk(event BestBidAskEvent) {
-	trySendGlobal(c.bestBidAskCh, event)
+	c.recordBestBidAsk(event)
+	trySendGlobal(c, c.bestBidAskCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.bestBidAskSubs)
 	c.subMu.Unlock()
@@ -721,7 +1078,7 @@ func (c *clientImpl) dispatchBestBidAsk(event BestBidAskEvent) {
 }
 
 func (c *clientImpl) dispatchNewMarket(event NewMarketEvent) {
-	trySendGlobal(c.newMarketCh, event)
+	trySendGlobal(c, c.newMarketCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.newMarketSubs)
 	c.subMu.Unlock()
@@ -733,7 +1090,7 @@ func (c *clientImpl) dispatchNewMarket(event NewMarketEvent) {
 }
 
 func (c *clientImpl) dispatchMarketResolved(event MarketResolvedEvent) {
-	trySendGlobal(c.marketResolvedCh, event)
+	trySendGlobal(c, c.marketResolvedCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.marketResolvedSubs)
 	c.subMu.Unlock()
@@ -745,7 +1102,7 @@ func (c *clientImpl) dispatchMarketResolved(event MarketResolvedEvent) {
 }
 
 func (c *clientImpl) dispatchTrade(event TradeEvent) {
-	trySendGlobal(c.tradeCh, event)
+	trySendGlobal(c, c.tradeCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.tradeSubs)
 	c.subMu.Unlock()
@@ -758,7 +1115,7 @@ func (c *clientImpl) dispatchTrade(event TradeEvent) {
 }
 
 func (c *clientImpl) dispatchOrder(event OrderEvent) {
-	trySendGlobal(c.orderCh, event)
+	trySendGlobal(c, c.orderCh, event)
 	c.subMu.Lock()
 	subs := snapshotSubs(c.orderSubs)
 	c.subMu.Unlock()
@@ -767,6 +1124,149 @@ func (c *clientImpl) dispatchOrder(event OrderEvent) {
 	}
 }
 
+func (c *clientImpl) LatestPrice(assetID string) (PriceSnapshot, bool) {
+	c.priceMu.RLock()
+	defer c.priceMu.RUnlock()
+	snapshot, ok := c.latestPrices[assetID]
+	return snapshot, ok
+}
+
+func (c *clientImpl) LatestPrices() map[string]PriceSnapshot {
+	c.priceMu.RLock()
+	defer c.priceMu.RUnlock()
+	out := make(map[string]PriceSnapshot, len(c.latestPrices))
+	for id, snapshot := range c.latestPrices {
+		out[id] = snapshot
+	}
+	return out
+}
+
+func (c *clientImpl) LatestBestBidAsk(ctx context.Context, assetID string) (BestBidAskEvent, error) {
+	return firstMarketEvent(ctx, assetID, func(ctx context.Context, assetIDs []string) (*Stream[BestBidAskEvent], error) {
+		return openMarketStream(c, ctx, assetIDs, BestBidAsk, true, c.bestBidAskSubs, true)
+	})
+}
+
+func (c *clientImpl) LatestMidpoint(ctx context.Context, assetID string) (MidpointEvent, error) {
+	return firstMarketEvent(ctx, assetID, func(ctx context.Context, assetIDs []string) (*Stream[MidpointEvent], error) {
+		return openMarketStream(c, ctx, assetIDs, Midpoint, false, c.midpointSubs, true)
+	})
+}
+
+// firstMarketEvent subscribes to assetID with subscribe and returns the
+// first event delivered, releasing the subscription before returning.
+// subscribe must request a snapshot for assets that are already subscribed.
+func firstMarketEvent[T any](ctx context.Context, assetID string, subscribe func(context.Context, []string) (*Stream[T], error)) (T, error) {
+	var zero T
+	assetID = strings.TrimSpace(assetID)
+	if assetID == "" {
+		return zero, ErrNoAssetIDs
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := subscribe(ctx, []string{assetID})
+	if err != nil {
+		return zero, err
+	}
+	defer stream.Close()
+
+	errs := stream.Err
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case event, ok := <-stream.C:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return zero, err
+				}
+				return zero, ErrClientClosed
+			}
+			return event, nil
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			// Dropped events do not matter when waiting for the next one.
+			var lagged LaggedError
+			if !errors.As(err, &lagged) {
+				return zero, err
+			}
+		}
+	}
+}
+
+func (c *clientImpl) recordPriceChanges(event PriceEvent) {
+	if len(event.PriceChanges) == 0 {
+		return
+	}
+	now := time.Now()
+	c.priceMu.Lock()
+	defer c.priceMu.Unlock()
+	if c.latestPrices == nil {
+		c.latestPrices = make(map[string]PriceSnapshot)
+	}
+	for _, change := range event.PriceChanges {
+		if change.AssetId == "" {
+			continue
+		}
+		snapshot := c.latestPrices[change.AssetId]
+		snapshot.AssetID = change.AssetId
+		if event.Market != "" {
+			snapshot.Market = event.Market
+		}
+		if change.Price != "" {
+			snapshot.Price = change.Price
+			snapshot.Side = change.Side
+			snapshot.Size = change.Size
+		}
+		if change.BestBid != "" {
+			snapshot.BestBid = change.BestBid
+		}
+		if change.BestAsk != "" {
+			snapshot.BestAsk = change.BestAsk
+		}
+		snapshot.Timestamp = event.Timestamp
+		snapshot.UpdatedAt = now
+		c.latestPrices[change.AssetId] = snapshot
+	}
+}
+
+func (c *clientImpl) recordBestBidAsk(event BestBidAskEvent) {
+	if event.AssetID == "" {
+		return
+	}
+	c.priceMu.Lock()
+	defer c.priceMu.Unlock()
+	if c.latestPrices == nil {
+		c.latestPrices = make(map[string]PriceSnapshot)
+	}
+	snapshot := c.latestPrices[event.AssetID]
+	snapshot.AssetID = event.AssetID
+	if event.Market != "" {
+		snapshot.Market = event.Market
+	}
+	snapshot.BestBid = event.BestBid
+	snapshot.BestAsk = event.BestAsk
+	snapshot.Spread = event.Spread
+	snapshot.Timestamp = event.Timestamp
+	snapshot.UpdatedAt = time.Now()
+	c.latestPrices[event.AssetID] = snapshot
+}
+
+func (c *clientImpl) forgetPrices(assetIDs []string) {
+	if len(assetIDs) == 0 {
+		return
+	}
+	c.priceMu.Lock()
+	defer c.priceMu.Unlock()
+	for _, id := range assetIDs {
+		delete(c.latestPrices, id)
+	}
+}
+
 func (c *clientImpl) SubscribeOrderbookStream(ctx context.Context, assetIDs []string) (*Stream[OrderbookEvent], error) {
 	return subscribeMarketStream(c, ctx, assetIDs, Orderbook, false, c.orderbookSubs)
 }
@@ -800,11 +1300,11 @@ func (c *clientImpl) SubscribeMarketResolutionsStream(ctx context.Context, asset
 }
 
 func (c *clientImpl) SubscribeOrdersStream(ctx context.Context) (*Stream[OrderEvent], error) {
-	return nil, errors.New("markets required: use SubscribeUserOrdersStream")
+	return nil, fmt.Errorf("%w: use SubscribeUserOrdersStream", ErrNoMarkets)
 }
 
 func (c *clientImpl) SubscribeTradesStream(ctx context.Context) (*Stream[TradeEvent], error) {
-	return nil, errors.New("markets required: use SubscribeUserTradesStream")
+	return nil, fmt.Errorf("%w: use SubscribeUserTradesStream", ErrNoMarkets)
 }
 
 func (c *clientImpl) SubscribeUserOrdersStream(ctx context.Context, markets []string) (*Stream[OrderEvent], error) {
@@ -912,7 +1412,7 @@ func (c *clientImpl) SubscribeUserTrades(ctx context.Context, markets []string)
 }
 
 func (c *clientImpl) Subscribe(ctx context.Context, req *SubscriptionRequest) error {
-	return c.applySubscription(req, OperationSubscribe)
+	return c.applySubscription(ctx, req, OperationSubscribe)
 }
 
 func (c *clientImpl) Unsubscribe(ctx context.Context, req *SubscriptionRequest) error {
@@ -920,27 +1420,96 @@ func (c *clientImpl) Unsubscribe(ctx context.Context, req *SubscriptionRequest)
 		return errors.New("subscription request is required")
 	}
 	req.Operation = OperationUnsubscribe
-	return c.applySubscription(req, OperationUnsubscribe)
+	return c.applySubscription(ctx, req, OperationUnsubscribe)
 }
 
 func (c *clientImpl) UnsubscribeMarketAssets(ctx context.Context, assetIDs []string) error {
 	if len(assetIDs) == 0 {
-		return errors.New("assetIDs required")
+		return ErrNoAssetIDs
 	}
 	return c.Unsubscribe(ctx, NewMarketUnsubscribe(assetIDs))
 }
 
+// UnsubscribeAsset removes assetID from the market channel regardless of
+// how many streams still reference it. Every typed stream subscribed to the
+// asset receives an AssetUnsubscribedError on its Err channel and is then
+// closed; the references those streams hold on their other assets are
+// released as if the streams had been closed, so the other assets stay
+// subscribed only while another consumer still needs them. Closing an
+// affected stream afterwards is a no-op.
+func (c *clientImpl) UnsubscribeAsset(assetID string) error {
+	assetID = strings.TrimSpace(assetID)
+	if assetID == "" {
+		return errors.New("assetID required")
+	}
+
+	c.subMu.Lock()
+	_, subscribed := c.marketRefs[assetID]
+	delete(c.marketRefs, assetID)
+	delete(c.customAssets, assetID)
+	c.forgetPrices([]string{assetID})
+	c.subMu.Unlock()
+
+	notice := AssetUnsubscribedError{AssetID: assetID}
+	var toUnsub []string
+	if subscribed {
+		toUnsub = append(toUnsub, assetID)
+	}
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.orderbookSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.priceSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.midpointSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.lastTradeSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.tickSizeSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.bestBidAskSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.newMarketSubs, assetID, notice)...)
+	toUnsub = append(toUnsub, forceCloseMarketStreams(c, c.marketResolvedSubs, assetID, notice)...)
+
+	if len(toUnsub) == 0 || c.getConn(ChannelMarket) == nil {
+		return nil
+	}
+	return c.writeJSON(ChannelMarket, NewMarketUnsubscribe(toUnsub))
+}
+
+// forceCloseMarketStreams closes the streams in subs subscribed to assetID
+// with err and releases their references on other assets. It returns the
+// assets no longer referenced by any stream.
+func forceCloseMarketStreams[T any](c *clientImpl, subs map[string]*subscriptionEntry[T], assetID string, err error) []string {
+	c.subMu.Lock()
+	var affected []*subscriptionEntry[T]
+	for id, sub := range subs {
+		if _, ok := sub.assets[assetID]; ok {
+			affected = append(affected, sub)
+			delete(subs, id)
+		}
+	}
+	c.subMu.Unlock()
+
+	var toUnsub []string
+	for _, sub := range affected {
+		if sub.closeWithError(err) {
+			toUnsub = append(toUnsub, c.removeMarketRefs(sub.assetIDs)...)
+		}
+	}
+	return toUnsub
+}
+
 func (c *clientImpl) UnsubscribeUserMarkets(ctx context.Context, markets []string) error {
 	if len(markets) == 0 {
-		return errors.New("markets required")
+		return ErrNoMarkets
 	}
 	return c.Unsubscribe(ctx, NewUserUnsubscribe(markets))
 }
 
-func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Operation) error {
+func (c *clientImpl) applySubscription(ctx context.Context, req *SubscriptionRequest, defaultOp Operation) error {
 	if req == nil {
 		return errors.New("subscription request is required")
 	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	if req.Type == "" {
 		if len(req.AssetIDs) > 0 {
 			req.Type = ChannelMarket
@@ -954,11 +1523,11 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 	switch req.Type {
 	case ChannelMarket:
 		if len(req.AssetIDs) == 0 {
-			return errors.New("assetIDs required")
+			return ErrNoAssetIDs
 		}
 	case ChannelUser:
 		if len(req.Markets) == 0 {
-			return errors.New("markets required")
+			return ErrNoMarkets
 		}
 	default:
 		return errors.New("unknown subscription channel")
@@ -973,7 +1542,8 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 		switch req.Operation {
 		case OperationSubscribe:
 			newAssets := c.addMarketRefs(req.AssetIDs, custom)
-			if err := c.ensureConn(ChannelMarket); err != nil {
+			if err := c.ensureConnContext(ctx, ChannelMarket); err != nil {
+				c.rollbackMarketRefs(req.AssetIDs, newAssets, custom)
 				return err
 			}
 			if len(newAssets) == 0 {
@@ -983,28 +1553,33 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 			if custom {
 				subReq.WithCustomFeatures(true)
 			}
-			return c.writeJSON(ChannelMarket, subReq)
+			if err := c.writeJSONContext(ctx, ChannelMarket, subReq); err != nil {
+				c.rollbackMarketRefs(req.AssetIDs, newAssets, custom)
+				return err
+			}
+			return nil
 		case OperationUnsubscribe:
 			toUnsub := c.removeMarketRefs(req.AssetIDs)
 			if len(toUnsub) == 0 {
 				return nil
 			}
-			if err := c.ensureConn(ChannelMarket); err != nil {
+			if err := c.ensureConnContext(ctx, ChannelMarket); err != nil {
 				return err
 			}
-			return c.writeJSON(ChannelMarket, NewMarketUnsubscribe(toUnsub))
+			return c.writeJSONContext(ctx, ChannelMarket, NewMarketUnsubscribe(toUnsub))
 		default:
 			return errors.New("unknown subscription operation")
 		}
 	case ChannelUser:
 		auth := c.resolveAuth(req.Auth)
 		if auth == nil {
-			return errors.New("user subscription requires API key credentials")
+			return ErrAuthRequired
 		}
 		switch req.Operation {
 		case OperationSubscribe:
 			newMarkets := c.addUserRefs(req.Markets, auth)
-			if err := c.ensureConn(ChannelUser); err != nil {
+			if err := c.ensureConnContext(ctx, ChannelUser); err != nil {
+				c.removeUserRefs(req.Markets)
 				return err
 			}
 			if len(newMarkets) == 0 {
@@ -1012,18 +1587,22 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 			}
 			subReq := NewUserSubscription(newMarkets)
 			subReq.Auth = auth
-			return c.writeJSON(ChannelUser, subReq)
+			if err := c.writeJSONContext(ctx, ChannelUser, subReq); err != nil {
+				c.removeUserRefs(req.Markets)
+				return err
+			}
+			return nil
 		case OperationUnsubscribe:
 			toUnsub := c.removeUserRefs(req.Markets)
 			if len(toUnsub) == 0 {
 				return nil
 			}
-			if err := c.ensureConn(ChannelUser); err != nil {
+			if err := c.ensureConnContext(ctx, ChannelUser); err != nil {
 				return err
 			}
 			unsubReq := NewUserUnsubscribe(toUnsub)
 			unsubReq.Auth = auth
-			return c.writeJSON(ChannelUser, unsubReq)
+			return c.writeJSONContext(ctx, ChannelUser, unsubReq)
 		default:
 			return errors.New("unknown subscription operation")
 		}
@@ -1032,18 +1611,106 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 	}
 }
 
+// Close unsubscribes, closes both connections and waits for the read and
+// ping loops to exit before closing the streams and global channels, so no
+// loop is left dispatching into a closed channel.
 func (c *clientImpl) Close() error {
-	c.closing.Store(true)
+	c.beginClose()
 	c.cleanupSubscriptions()
 	c.closeConn(ChannelMarket)
 	c.closeConn(ChannelUser)
 	c.setConnState(ChannelMarket, ConnectionDisconnected, 0)
 	c.setConnState(ChannelUser, ConnectionDisconnected, 0)
 	c.closeAllStreams()
+	c.loops.Wait()
+	c.shutdown()
+	return nil
+}
+
+// beginClose marks the client as closing and interrupts reconnect attempts.
+// Taking the init mutexes waits out connection attempts already in flight;
+// later ones see closing and fail, so no loop starts after Close waits.
+func (c *clientImpl) beginClose() {
+	c.closing.Store(true)
+	if c.stopLoop != nil {
+		c.stopLoop()
+	}
+	c.marketInitMu.Lock()
+	c.marketInitMu.Unlock()
+	c.userInitMu.Lock()
+	c.userInitMu.Unlock()
+}
+
+// CloseAndDrain shuts the client down like Close, but gives consumers until
+// ctx is done to read events already buffered on their streams. New
+// subscriptions fail with ErrClientClosed, the server is sent unsubscribe
+// requests, and the read loops finish their in-flight messages before the
+// wait starts. Streams are closed once they are empty or ctx is done, in
+// which case ctx's error is returned.
+func (c *clientImpl) CloseAndDrain(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c.draining.Store(true)
+	c.beginClose()
+	c.cleanupSubscriptions()
+	c.closeConn(ChannelMarket)
+	c.closeConn(ChannelUser)
+	c.setConnState(ChannelMarket, ConnectionDisconnected, 0)
+	c.setConnState(ChannelUser, ConnectionDisconnected, 0)
+
+	loopsDone := make(chan struct{})
+	go func() {
+		c.loops.Wait()
+		close(loopsDone)
+	}()
+
+	var err error
+	select {
+	case <-loopsDone:
+		err = c.waitDrained(ctx)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
 	c.shutdown()
+	return err
+}
+
+// waitDrained polls until every stream buffer is empty or ctx is done.
+func (c *clientImpl) waitDrained(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for c.pendingEvents() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 	return nil
 }
 
+// pendingEvents counts the events buffered on open streams.
+func (c *clientImpl) pendingEvents() int {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	return pendingSubMap(c.orderbookSubs) +
+		pendingSubMap(c.priceSubs) +
+		pendingSubMap(c.midpointSubs) +
+		pendingSubMap(c.lastTradeSubs) +
+		pendingSubMap(c.tickSizeSubs) +
+		pendingSubMap(c.bestBidAskSubs) +
+		pendingSubMap(c.newMarketSubs) +
+		pendingSubMap(c.marketResolvedSubs) +
+		pendingSubMap(c.tradeSubs) +
+		pendingSubMap(c.orderSubs)
+}
+
+func (c *clientImpl) WithSyntheticMidpoints(enabled bool) Client {
+	c.syntheticMidpoints.Store(enabled)
+	return c
+}
+
 // setReadTimeout sets the read timeout for WebSocket connections.
 // This is primarily used for testing purposes.
 func (c *clientImpl) setReadTimeout(timeout time.Duration) {
@@ -1051,22 +1718,57 @@ func (c *clientImpl) setReadTimeout(timeout time.Duration) {
 }
 
 func (c *clientImpl) writeJSON(channel Channel, v interface{}) error {
-	switch channel {
-	case ChannelUser:
-		c.userMu.Lock()
-		defer c.userMu.Unlock()
-		if c.userConn == nil {
-			return errors.New("connection is not established")
-		}
-		return c.userConn.WriteJSON(v)
-	default:
-		c.mu.Lock()
-		defer c.mu.Unlock()
-		if c.conn == nil {
-			return errors.New("connection is not established")
+	return c.writeJSONContext(context.Background(), channel, v)
+}
+
+// writeJSONContext writes v to the channel connection. The write deadline follows
+// ctx, and cancelling ctx unblocks a pending write and returns ctx.Err().
+func (c *clientImpl) writeJSONContext(ctx context.Context, channel Channel, v interface{}) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if c.replaying {
+		return nil
+	}
+	mu, connPtr := &c.mu, &c.conn
+	if channel == ChannelUser {
+		mu, connPtr = &c.userMu, &c.userConn
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	conn := *connPtr
+	if conn == nil {
+		return ErrNotConnected
+	}
+	if ctx.Done() == nil {
+		return conn.WriteJSON(v)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+	// Force any in-flight write to fail as soon as ctx is done.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetWriteDeadline(time.Now())
+	})
+	err := conn.WriteJSON(v)
+	if !stop() {
+		// The write may have been interrupted mid-frame, which leaves the
+		// connection unusable; close it so the read loop reconnects.
+		_ = conn.Close()
+		return ctx.Err()
+	}
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
-		return c.conn.WriteJSON(v)
+		return err
 	}
+	_ = conn.SetWriteDeadline(time.Time{})
+	return nil
 }
 
 func (c *clientImpl) writeMessage(channel Channel, payload []byte) error {
@@ -1075,41 +1777,68 @@ func (c *clientImpl) writeMessage(channel Channel, payload []byte) error {
 		c.userMu.Lock()
 		defer c.userMu.Unlock()
 		if c.userConn == nil {
-			return errors.New("connection is not established")
+			return ErrNotConnected
 		}
 		return c.userConn.WriteMessage(websocket.TextMessage, payload)
 	default:
 		c.mu.Lock()
 		defer c.mu.Unlock()
 		if c.conn == nil {
-			return errors.New("connection is not established")
+			return ErrNotConnected
 		}
 		return c.conn.WriteMessage(websocket.TextMessage, payload)
 	}
 }
 
 func subscribeMarketStream[T any](c *clientImpl, ctx context.Context, assetIDs []string, eventType EventType, custom bool, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
+	return openMarketStream(c, ctx, assetIDs, eventType, custom, subs, false)
+}
+
+// openMarketStream is subscribeMarketStream. With snapshot set it also asks
+// the server for a fresh snapshot of assets that were already subscribed,
+// which would otherwise send the new stream nothing until they next change.
+func openMarketStream[T any](c *clientImpl, ctx context.Context, assetIDs []string, eventType EventType, custom bool, subs map[string]*subscriptionEntry[T], snapshot bool) (*Stream[T], error) {
 	if len(assetIDs) == 0 {
-		return nil, errors.New("assetIDs required")
+		return nil, ErrNoAssetIDs
+	}
+	if ctx == nil {
+		ctx = context.Background()
 	}
 	newAssets := c.addMarketRefs(assetIDs, custom)
-	if err := c.ensureConn(ChannelMarket); err != nil {
+	// Register the entry before subscribing: the initial dump can be
+	// dispatched before the write returns.
+	entry := newSubscriptionEntry[T](c, ChannelMarket, eventType, assetIDs, nil)
+	c.subMu.Lock()
+	subs[entry.id] = entry
+	c.subMu.Unlock()
+	fail := func(err error) (*Stream[T], error) {
+		c.subMu.Lock()
+		delete(subs, entry.id)
+		c.subMu.Unlock()
+		entry.close()
+		c.rollbackMarketRefs(assetIDs, newAssets, custom)
 		return nil, err
 	}
+
+	if err := c.ensureConnContext(ctx, ChannelMarket); err != nil {
+		return fail(err)
+	}
 	if len(newAssets) > 0 {
 		req := NewMarketSubscription(newAssets)
 		if custom {
 			req.WithCustomFeatures(true)
 		}
-		if err := c.writeJSON(ChannelMarket, req); err != nil {
-			return nil, err
+		if err := c.writeJSONContext(ctx, ChannelMarket, req); err != nil {
+			return fail(err)
+		}
+	}
+	if snapshot {
+		if known := missingIDs(assetIDs, newAssets); len(known) > 0 {
+			if err := c.requestSnapshot(ctx, known); err != nil {
+				return fail(err)
+			}
 		}
 	}
-
-	entry := newSubscriptionEntry[T](c, ChannelMarket, eventType, assetIDs, nil)
-	c.subMu.Lock()
-	subs[entry.id] = entry
-	c.subMu.Unlock()
 
 	stream := &Stream[T]{
 		C:   entry.ch,
@@ -1125,29 +1854,41 @@ func subscribeMarketStream[T any](c *clientImpl, ctx context.Context, assetIDs [
 
 func subscribeUserStream[T any](c *clientImpl, ctx context.Context, markets []string, eventType EventType, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
 	if len(markets) == 0 {
-		return nil, errors.New("markets required")
+		return nil, ErrNoMarkets
 	}
 	auth := c.resolveAuth(nil)
 	if auth == nil {
-		return nil, errors.New("user subscription requires API key credentials")
+		return nil, ErrAuthRequired
+	}
+	if ctx == nil {
+		ctx = context.Background()
 	}
 	newMarkets := c.addUserRefs(markets, auth)
-	if err := c.ensureConn(ChannelUser); err != nil {
+	// Registered before subscribing, as in openMarketStream.
+	entry := newSubscriptionEntry[T](c, ChannelUser, eventType, nil, markets)
+	c.subMu.Lock()
+	subs[entry.id] = entry
+	c.subMu.Unlock()
+	fail := func(err error) (*Stream[T], error) {
+		c.subMu.Lock()
+		delete(subs, entry.id)
+		c.subMu.Unlock()
+		entry.close()
+		c.removeUserRefs(markets)
 		return nil, err
 	}
+
+	if err := c.ensureConnContext(ctx, ChannelUser); err != nil {
+		return fail(err)
+	}
 	if len(newMarkets) > 0 {
 		req := NewUserSubscription(newMarkets)
 		req.Auth = auth
-		if err := c.writeJSON(ChannelUser, req); err != nil {
-			return nil, err
+		if err := c.writeJSONContext(ctx, ChannelUser, req); err != nil {
+			return fail(err)
 		}
 	}
 
-	entry := newSubscriptionEntry[T](c, ChannelUser, eventType, nil, markets)
-	c.subMu.Lock()
-	subs[entry.id] = entry
-	c.subMu.Unlock()
-
 	stream := &Stream[T]{
 		C:   entry.ch,
 		Err: entry.errCh,
@@ -1177,13 +1918,14 @@ func bindContext[T any](ctx context.Context, stream *Stream[T]) {
 func newSubscriptionEntry[T any](c *clientImpl, channel Channel, eventType EventType, assets []string, markets []string) *subscriptionEntry[T] {
 	id := atomic.AddUint64(&c.nextSubID, 1)
 	return &subscriptionEntry[T]{
-		id:      strconv.FormatUint(id, 10),
-		channel: channel,
-		event:   eventType,
-		assets:  makeIDSet(assets),
-		markets: makeIDSet(markets),
-		ch:      make(chan T, defaultStreamBuffer),
-		errCh:   make(chan error, defaultErrBuffer),
+		id:       strconv.FormatUint(id, 10),
+		channel:  channel,
+		event:    eventType,
+		assets:   makeIDSet(assets),
+		assetIDs: append([]string(nil), assets...),
+		markets:  makeIDSet(markets),
+		ch:       make(chan T, defaultStreamBuffer),
+		errCh:    make(chan error, defaultErrBuffer),
 	}
 }
 
@@ -1236,16 +1978,19 @@ func closeUserStream[T any](c *clientImpl, entry *subscriptionEntry[T], markets
 }
 
 func (c *clientImpl) authPayload() *AuthPayload {
-	if c.apiKey == nil {
+	c.authMu.RLock()
+	apiKey := c.apiKey
+	c.authMu.RUnlock()
+	if apiKey == nil {
 		return nil
 	}
-	if c.apiKey.Key == "" || c.apiKey.Secret == "" || c.apiKey.Passphrase == "" {
+	if apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
 		return nil
 	}
 	return &AuthPayload{
-		APIKey:     c.apiKey.Key,
-		Secret:     c.apiKey.Secret,
-		Passphrase: c.apiKey.Passphrase,
+		APIKey:     apiKey.Key,
+		Secret:     apiKey.Secret,
+		Passphrase: apiKey.Passphrase,
 	}
 }
 
@@ -1257,7 +2002,15 @@ func (c *clientImpl) resolveAuth(explicit *AuthPayload) *AuthPayload {
 	if auth := c.authPayload(); auth != nil {
 		return auth
 	}
-	return c.getLastAuth()
+	if auth := c.getLastAuth(); auth != nil {
+		return auth
+	}
+	if c.replaying {
+		// Nothing is sent while replaying, so user streams need no
+		// credentials.
+		return &AuthPayload{}
+	}
+	return nil
 }
 
 func (c *clientImpl) getLastAuth() *AuthPayload {
@@ -1270,21 +2023,31 @@ func (c *clientImpl) getLastAuth() *AuthPayload {
 	return &copy
 }
 
+// addMarketRefs takes a reference on each asset and returns the assets that
+// must be (re)subscribed: those not referenced before and, when custom is
+// set, those subscribed so far without custom features.
 func (c *clientImpl) addMarketRefs(assetIDs []string, custom bool) []string {
 	if len(assetIDs) == 0 {
 		return nil
 	}
 	c.subMu.Lock()
 	defer c.subMu.Unlock()
-	if custom {
-		c.customFeatures = true
+	if custom && c.customAssets == nil {
+		c.customAssets = make(map[string]struct{})
 	}
 	newAssets := make([]string, 0, len(assetIDs))
 	for _, id := range assetIDs {
 		if id == "" {
 			continue
 		}
-		if c.marketRefs[id] == 0 {
+		upgrade := false
+		if custom {
+			if _, ok := c.customAssets[id]; !ok {
+				c.customAssets[id] = struct{}{}
+				upgrade = true
+			}
+		}
+		if c.marketRefs[id] == 0 || upgrade {
 			newAssets = append(newAssets, id)
 		}
 		c.marketRefs[id]++
@@ -1292,6 +2055,19 @@ func (c *clientImpl) addMarketRefs(assetIDs []string, custom bool) []string {
 	return newAssets
 }
 
+// missingIDs returns the non-empty ids that are not in exclude.
+func missingIDs(ids, exclude []string) []string {
+	skip := makeIDSet(exclude)
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := skip[id]; id == "" || ok {
+			continue
+		}
+		out = append(out, id)
+	}
+	return out
+}
+
 func (c *clientImpl) removeMarketRefs(assetIDs []string) []string {
 	if len(assetIDs) == 0 {
 		return nil
@@ -1304,15 +2080,33 @@ func (c *clientImpl) removeMarketRefs(assetIDs []string) []string {
 		if count <= 1 {
 			if count > 0 {
 				delete(c.marketRefs, id)
+				delete(c.customAssets, id)
 				toUnsub = append(toUnsub, id)
 			}
 			continue
 		}
 		c.marketRefs[id] = count - 1
 	}
+	c.forgetPrices(toUnsub)
 	return toUnsub
 }
 
+// rollbackMarketRefs undoes addMarketRefs when the subscription could not
+// be sent, including custom feature upgrades of assets still referenced.
+func (c *clientImpl) rollbackMarketRefs(assetIDs, newAssets []string, custom bool) {
+	c.removeMarketRefs(assetIDs)
+	if !custom {
+		return
+	}
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	for _, id := range newAssets {
+		if c.marketRefs[id] > 0 {
+			delete(c.customAssets, id)
+		}
+	}
+}
+
 func (c *clientImpl) addUserRefs(markets []string, auth *AuthPayload) []string {
 	if len(markets) == 0 {
 		return nil
@@ -1357,13 +2151,14 @@ func (c *clientImpl) removeUserRefs(markets []string) []string {
 	return toUnsub
 }
 
-func (c *clientImpl) snapshotSubscriptionRefs() ([]string, []string, bool, *AuthPayload) {
+func (c *clientImpl) snapshotSubscriptionRefs() ([]string, []string, []string, *AuthPayload) {
 	c.subMu.Lock()
 	defer c.subMu.Unlock()
 	assets := make([]string, 0, len(c.marketRefs))
 	for id := range c.marketRefs {
 		assets = append(assets, id)
 	}
+	plain, custom := c.splitCustomAssetsLocked(assets)
 	markets := make([]string, 0, len(c.userRefs))
 	for id := range c.userRefs {
 		markets = append(markets, id)
@@ -1373,33 +2168,61 @@ func (c *clientImpl) snapshotSubscriptionRefs() ([]string, []string, bool, *Auth
 		copy := *c.lastAuth
 		authCopy = &copy
 	}
-	return assets, markets, c.customFeatures, authCopy
+	return plain, custom, markets, authCopy
 }
 
-func (c *clientImpl) reconnectLoop(channel Channel) error {
-	var lastErr error
-	delay := c.reconnectDelay
-	if delay <= 0 {
-		delay = 1 * time.Second
+// splitCustomAssets groups assetIDs by whether they are subscribed with
+// custom features.
+func (c *clientImpl) splitCustomAssets(assetIDs []string) (plain, custom []string) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+	return c.splitCustomAssetsLocked(assetIDs)
+}
+
+func (c *clientImpl) splitCustomAssetsLocked(assetIDs []string) (plain, custom []string) {
+	for _, id := range assetIDs {
+		if _, ok := c.customAssets[id]; ok {
+			custom = append(custom, id)
+		} else {
+			plain = append(plain, id)
+		}
 	}
-	maxDelay := c.reconnectMaxDelay
-	if maxDelay <= 0 {
-		maxDelay = 30 * time.Second
+	return plain, custom
+}
+
+// marketSubscriptions builds one subscription for the assets without custom
+// features and one for the assets with them, skipping empty groups.
+func marketSubscriptions(plain, custom []string) []*SubscriptionRequest {
+	var reqs []*SubscriptionRequest
+	if len(plain) > 0 {
+		reqs = append(reqs, NewMarketSubscription(plain))
 	}
-	multiplier := c.reconnectMultiplier
-	if multiplier <= 0 {
-		multiplier = 2
+	if len(custom) > 0 {
+		reqs = append(reqs, NewMarketSubscription(custom).WithCustomFeatures(true))
 	}
+	return reqs
+}
+
+func (c *clientImpl) reconnectLoop(channel Channel) error {
+	var lastErr error
+	backoff := c.reconnectBackoff()
 
 	for attempt := 0; c.reconnectMax <= 0 || attempt < c.reconnectMax; attempt++ {
 		if c.closing.Load() {
-			return lastErr
+			return ErrClientClosed
 		}
+		delay := backoff.Next()
 		if c.debug {
 			logger.Debug("ws reconnect attempt %d in %s (%s)", attempt+1, delay, channel)
 		}
 		c.setConnState(channel, ConnectionReconnecting, attempt+1)
-		time.Sleep(delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-c.stopContext().Done():
+			timer.Stop()
+			return ErrClientClosed
+		}
 
 		// Use init mutex to serialize with ensure* methods
 		var initMu *sync.Mutex
@@ -1413,6 +2236,12 @@ func (c *clientImpl) reconnectLoop(channel Channel) error {
 		if initMu != nil {
 			initMu.Lock()
 		}
+		if c.closing.Load() {
+			if initMu != nil {
+				initMu.Unlock()
+			}
+			return ErrClientClosed
+		}
 
 		// Cancel old goroutines and close old connection
 		c.cancelGoroutines(channel)
@@ -1438,10 +2267,7 @@ func (c *clientImpl) reconnectLoop(channel Channel) error {
 			c.setLastPong(channel, time.Now())
 
 			// Restart read and ping loops after successful reconnection
-			go c.readLoop(channel)
-			if !c.disablePing {
-				go c.pingLoop(channel)
-			}
+			c.startLoops(channel)
 
 			if initMu != nil {
 				initMu.Unlock()
@@ -1458,31 +2284,37 @@ func (c *clientImpl) reconnectLoop(channel Channel) error {
 		if c.debug {
 			logger.Debug("ws reconnect failed: %v", err)
 		}
-		nextDelay := time.Duration(float64(delay) * multiplier)
-		if nextDelay <= 0 {
-			nextDelay = delay
-		}
-		if nextDelay > maxDelay {
-			nextDelay = maxDelay
-		}
-		delay = nextDelay
 	}
 	c.setConnState(channel, ConnectionDisconnected, 0)
 	return lastErr
 }
 
+// reconnectBackoff builds the reconnect delay policy: 1s doubling up to 30s
+// unless overridden by the client configuration, randomized by the
+// configured jitter.
+func (c *clientImpl) reconnectBackoff() *transport.Backoff {
+	delay := c.reconnectDelay
+	if delay <= 0 {
+		delay = 1 * time.Second
+	}
+	maxDelay := c.reconnectMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	multiplier := c.reconnectMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	return transport.NewBackoff(delay, maxDelay, multiplier, c.reconnectJitter)
+}
+
 func (c *clientImpl) resubscribe(channel Channel) {
-	assets, markets, custom, auth := c.snapshotSubscriptionRefs()
+	plain, custom, markets, auth := c.snapshotSubscriptionRefs()
 	switch channel {
 	case ChannelMarket:
-		if len(assets) == 0 {
-			return
-		}
-		req := NewMarketSubscription(assets)
-		if custom {
-			req.WithCustomFeatures(true)
+		for _, req := range marketSubscriptions(plain, custom) {
+			_ = c.writeJSON(ChannelMarket, req)
 		}
-		_ = c.writeJSON(ChannelMarket, req)
 	case ChannelUser:
 		if len(markets) == 0 || auth == nil {
 			return
@@ -1493,9 +2325,30 @@ func (c *clientImpl) resubscribe(channel Channel) {
 	}
 }
 
+// requestSnapshot re-sends the market subscription for assets that are already
+// subscribed so the server replies with a fresh book snapshot.
+func (c *clientImpl) requestSnapshot(ctx context.Context, assetIDs []string) error {
+	if err := c.ensureConnContext(ctx, ChannelMarket); err != nil {
+		return err
+	}
+	for _, req := range marketSubscriptions(c.splitCustomAssets(assetIDs)) {
+		if err := c.writeJSONContext(ctx, ChannelMarket, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shutdown closes every stream and global event channel exactly once. The
+// read loop may still be dispatching when Close runs, so closing is set
+// first and the channels are closed under dispatchMu; trySendGlobal checks
+// closing under the same lock and never sends on a closed channel.
 func (c *clientImpl) shutdown() {
 	c.closeOnce.Do(func() {
+		c.closing.Store(true)
 		c.closeAllStreams()
+		c.dispatchMu.Lock()
+		defer c.dispatchMu.Unlock()
 		close(c.done)
 		close(c.orderbookCh)
 		close(c.priceCh)
@@ -1652,6 +2505,45 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 	return stream, nil
 }
 
+func (c *clientImpl) WaitUntilConnected(ctx context.Context, channel Channel) error {
+	if channel != ChannelMarket && channel != ChannelUser {
+		return errors.New("unknown subscription channel")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.ConnectionStateStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	lagged := stream.Err
+	for {
+		if c.ConnectionState(channel) == ConnectionConnected {
+			return nil
+		}
+		if c.closing.Load() {
+			return ErrClientClosed
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-stream.C:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return ErrClientClosed
+			}
+		case _, ok := <-lagged:
+			// Dropped state events only mean the state must be re-read.
+			if !ok {
+				lagged = nil
+			}
+		}
+	}
+}
+
 func (c *clientImpl) setConnState(channel Channel, state ConnectionState, attempt int) {
 	event := ConnectionStateEvent{
 		Channel:  channel,
@@ -1676,6 +2568,9 @@ func (c *clientImpl) setConnState(channel Channel, state ConnectionState, attemp
 	for _, sub := range subs {
 		sub.trySend(event)
 	}
+	if channel == ChannelMarket {
+		c.updateRESTFallback(state)
+	}
 }
 
 // createGoroutineContext creates a new context for managing goroutines for a specific channel.
@@ -1,13 +1,22 @@
 package ws
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/gorilla/websocket"
 )
 
 // --------------- normalizeWSURLs ---------------
@@ -391,7 +400,7 @@ func newTestClient() *clientImpl {
 		marketState:        ConnectionDisconnected,
 		userState:          ConnectionDisconnected,
 		orderbookSubs:      make(map[string]*subscriptionEntry[OrderbookEvent]),
-		priceSubs:          make(map[string]*subscriptionEntry[PriceEvent]),
+		priceSubs:          make(map[string]*subscriptionEntry[PriceChangeEvent]),
 		midpointSubs:       make(map[string]*subscriptionEntry[MidpointEvent]),
 		lastTradeSubs:      make(map[string]*subscriptionEntry[LastTradePriceEvent]),
 		tickSizeSubs:       make(map[string]*subscriptionEntry[TickSizeChangeEvent]),
@@ -416,20 +425,141 @@ func newTestClient() *clientImpl {
 
 // --------------- processEvent ---------------
 
+// processRaw feeds raw to processEvent as the JSON frame the server sends.
+func processRaw(c *clientImpl, raw map[string]interface{}) {
+	msg, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	c.processEvent(msg)
+}
+
+var benchmarkFrames = [][]byte{
+	[]byte(`{"event_type":"book","asset_id":"tok1","market":"0xabc","bids":[{"price":"0.48","size":"120"},{"price":"0.49","size":"80"}],"asks":[{"price":"0.52","size":"60"},{"price":"0.51","size":"40"}],"hash":"h1","timestamp":"1700000000000"}`),
+	[]byte(`{"event_type":"price_change","market":"0xabc","price_changes":[{"asset_id":"tok1","price":"0.50","side":"BUY","size":"10","best_bid":"0.49","best_ask":"0.51"},{"asset_id":"tok2","price":"0.50","side":"SELL","size":"10"}],"timestamp":"1700000000001"}`),
+	[]byte(`[{"event_type":"last_trade_price","asset_id":"tok1","price":"0.50","side":"BUY","size":"5","timestamp":"1700000000002"},{"event_type":"best_bid_ask","asset_id":"tok1","best_bid":"0.49","best_ask":"0.51","timestamp":"1700000000003"}]`),
+}
+
+func BenchmarkProcessMessage(b *testing.B) {
+	c := newTestClient()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, frame := range benchmarkFrames {
+			c.processMessage(frame)
+		}
+	}
+}
+
+// loadStreamFixture reads testdata/stream.jsonl, a synthetic mix of market
+// and user channel frames, one frame per line. See testdata/README.md.
+func loadStreamFixture(tb testing.TB) [][]byte {
+	tb.Helper()
+	data, err := os.ReadFile("testdata/stream.jsonl")
+	if err != nil {
+		tb.Fatalf("read stream fixture: %v", err)
+	}
+	return bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+}
+
+func BenchmarkProcessStreamFixture(b *testing.B) {
+	frames := loadStreamFixture(b)
+	c := newTestClient()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, frame := range frames {
+			c.processMessage(frame)
+		}
+		drainGlobal(c)
+	}
+}
+
+// drainGlobal empties the global event channels so benchmarks keep
+// delivering instead of dropping on full buffers.
+func drainGlobal(c *clientImpl) {
+	for {
+		select {
+		case <-c.orderbookCh:
+		case <-c.priceCh:
+		case <-c.lastTradeCh:
+		case <-c.tickSizeCh:
+		case <-c.bestBidAskCh:
+		case <-c.tradeCh:
+		case <-c.orderCh:
+		default:
+			return
+		}
+	}
+}
+
+func TestProcessStreamFixture(t *testing.T) {
+	c := newTestClient()
+	for _, frame := range loadStreamFixture(t) {
+		c.processMessage(frame)
+	}
+	counts := map[string]int{
+		"book":   len(c.orderbookCh),
+		"price":  len(c.priceCh),
+		"trade":  len(c.tradeCh),
+		"order":  len(c.orderCh),
+		"last":   len(c.lastTradeCh),
+		"bidask": len(c.bestBidAskCh),
+	}
+	for kind, n := range counts {
+		if n == 0 {
+			t.Errorf("no %s events decoded from the stream fixture", kind)
+		}
+	}
+	for len(c.tradeCh) > 0 {
+		trade := <-c.tradeCh
+		if trade.MatchTime == "" || len(trade.MakerOrders) == 0 || trade.MakerOrders[0].Price != "0.51" {
+			t.Errorf("numeric trade fields not decoded: %+v", trade)
+		}
+	}
+	for len(c.orderCh) > 0 {
+		if order := <-c.orderCh; order.Timestamp == "" {
+			t.Errorf("numeric order timestamp not decoded: %+v", order)
+		}
+	}
+}
+
+func TestFlexString(t *testing.T) {
+	var got struct {
+		Quoted flexString `json:"quoted"`
+		Number flexString `json:"number"`
+		Big    flexString `json:"big"`
+		Null   flexString `json:"null"`
+	}
+	data := `{"quoted":"0.50","number":0.570,"big":1672290701000123456,"null":null}`
+	if err := json.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Quoted != "0.50" || got.Number != "0.570" || got.Big != "1672290701000123456" || got.Null != "" {
+		t.Errorf("unexpected values: %+v", got)
+	}
+	if err := json.Unmarshal([]byte(`{"quoted":true}`), &got); err == nil {
+		t.Error("expected an error for a boolean")
+	}
+}
+
 func TestProcessEvent_Price(t *testing.T) {
 	c := newTestClient()
-	ch := make(chan PriceEvent, 5)
-	c.priceSubs["p1"] = &subscriptionEntry[PriceEvent]{
+	ch := make(chan PriceChangeEvent, 5)
+	c.priceSubs["p1"] = &subscriptionEntry[PriceChangeEvent]{
 		id: "p1", ch: ch, errCh: make(chan error, 5),
 	}
 
-	raw := map[string]interface{}{"event_type": "price", "asset_id": "tok1", "price": "0.55"}
-	c.processEvent(raw)
+	raw := map[string]interface{}{
+		"event_type":    "price",
+		"price_changes": []interface{}{map[string]interface{}{"asset_id": "tok1", "price": "0.55"}},
+	}
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
-		if ev.AssetID != "tok1" {
-			t.Fatalf("expected tok1, got %s", ev.AssetID)
+		if ev.AssetId != "tok1" {
+			t.Fatalf("expected tok1, got %s", ev.AssetId)
 		}
 	case <-time.After(100 * time.Millisecond):
 		t.Fatal("timeout waiting for price event")
@@ -438,24 +568,81 @@ func TestProcessEvent_Price(t *testing.T) {
 
 func TestProcessEvent_PriceChange(t *testing.T) {
 	c := newTestClient()
-	ch := make(chan PriceEvent, 5)
-	c.priceSubs["p1"] = &subscriptionEntry[PriceEvent]{
+	ch := make(chan PriceChangeEvent, 5)
+	c.priceSubs["p1"] = &subscriptionEntry[PriceChangeEvent]{
 		id: "p1", ch: ch, errCh: make(chan error, 5),
 	}
 
-	raw := map[string]interface{}{"event_type": "price_change", "asset_id": "tok2", "price": "0.60"}
-	c.processEvent(raw)
+	raw := map[string]interface{}{
+		"event_type":    "price_change",
+		"price_changes": []interface{}{map[string]interface{}{"asset_id": "tok2", "price": "0.60"}},
+	}
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
-		if ev.AssetID != "tok2" {
-			t.Fatalf("expected tok2, got %s", ev.AssetID)
+		if ev.AssetId != "tok2" {
+			t.Fatalf("expected tok2, got %s", ev.AssetId)
 		}
 	case <-time.After(100 * time.Millisecond):
 		t.Fatal("timeout")
 	}
 }
 
+func TestLatestPriceSnapshot(t *testing.T) {
+	c := newTestClient()
+	if _, ok := c.LatestPrice("tok1"); ok {
+		t.Fatal("expected no snapshot before any event")
+	}
+
+	processRaw(c, map[string]interface{}{
+		"event_type": "price_change",
+		"market":     "m1",
+		"timestamp":  "1700000000",
+		"price_changes": []interface{}{
+			map[string]interface{}{"asset_id": "tok1", "price": "0.55", "side": "BUY", "size": "10", "best_bid": "0.54", "best_ask": "0.56"},
+			map[string]interface{}{"asset_id": "tok2", "price": "0.45", "side": "SELL", "size": "5"},
+		},
+	})
+	processRaw(c, map[string]interface{}{
+		"event_type": "best_bid_ask",
+		"asset_id":   "tok1",
+		"best_bid":   "0.53",
+		"best_ask":   "0.57",
+		"spread":     "0.04",
+		"timestamp":  "1700000001",
+	})
+
+	snap, ok := c.LatestPrice("tok1")
+	if !ok {
+		t.Fatal("expected snapshot for tok1")
+	}
+	if snap.Market != "m1" || snap.Price != "0.55" || snap.Side != "BUY" || snap.Size != "10" {
+		t.Errorf("unexpected price fields: %+v", snap)
+	}
+	if snap.BestBid != "0.53" || snap.BestAsk != "0.57" || snap.Spread != "0.04" || snap.Timestamp != "1700000001" {
+		t.Errorf("expected best bid/ask update to apply: %+v", snap)
+	}
+	if snap.UpdatedAt.IsZero() {
+		t.Error("expected UpdatedAt to be set")
+	}
+
+	all := c.LatestPrices()
+	if len(all) != 2 || all["tok2"].Price != "0.45" {
+		t.Fatalf("unexpected snapshots: %+v", all)
+	}
+	delete(all, "tok1")
+	if _, ok := c.LatestPrice("tok1"); !ok {
+		t.Fatal("LatestPrices must return a copy")
+	}
+
+	c.addMarketRefs([]string{"tok1"}, false)
+	c.removeMarketRefs([]string{"tok1"})
+	if _, ok := c.LatestPrice("tok1"); ok {
+		t.Error("expected snapshot dropped after the last unsubscribe")
+	}
+}
+
 func TestProcessEvent_Book(t *testing.T) {
 	c := newTestClient()
 	ch := make(chan OrderbookEvent, 5)
@@ -471,7 +658,7 @@ func TestProcessEvent_Book(t *testing.T) {
 		"asks":       []interface{}{map[string]interface{}{"price": "0.6", "size": "10"}},
 		"timestamp":  "1700000000",
 	}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -485,6 +672,7 @@ func TestProcessEvent_Book(t *testing.T) {
 
 func TestProcessEvent_BookGeneratesMidpoint(t *testing.T) {
 	c := newTestClient()
+	c.WithSyntheticMidpoints(true)
 	midCh := make(chan MidpointEvent, 5)
 	c.midpointSubs["mid1"] = &subscriptionEntry[MidpointEvent]{
 		id: "mid1", ch: midCh, errCh: make(chan error, 5),
@@ -493,10 +681,16 @@ func TestProcessEvent_BookGeneratesMidpoint(t *testing.T) {
 	raw := map[string]interface{}{
 		"event_type": "book",
 		"asset_id":   "tok1",
-		"bids":       []interface{}{map[string]interface{}{"price": "0.4", "size": "10"}},
-		"asks":       []interface{}{map[string]interface{}{"price": "0.6", "size": "10"}},
+		"bids": []interface{}{
+			map[string]interface{}{"price": "0.3", "size": "10"},
+			map[string]interface{}{"price": "0.4", "size": "10"},
+		},
+		"asks": []interface{}{
+			map[string]interface{}{"price": "0.7", "size": "10"},
+			map[string]interface{}{"price": "0.6", "size": "10"},
+		},
 	}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-midCh:
@@ -506,11 +700,44 @@ func TestProcessEvent_BookGeneratesMidpoint(t *testing.T) {
 		if ev.Midpoint != "0.5" {
 			t.Fatalf("expected midpoint 0.5, got %s", ev.Midpoint)
 		}
+		if !ev.Synthetic {
+			t.Error("expected synthetic midpoint")
+		}
 	case <-time.After(100 * time.Millisecond):
 		t.Fatal("timeout waiting for midpoint")
 	}
 }
 
+func TestProcessEvent_SyntheticMidpointDisabledByDefault(t *testing.T) {
+	c := newTestClient()
+	midCh := make(chan MidpointEvent, 5)
+	c.midpointSubs["mid1"] = &subscriptionEntry[MidpointEvent]{
+		id: "mid1", ch: midCh, errCh: make(chan error, 5),
+	}
+
+	processRaw(c, map[string]interface{}{
+		"event_type": "book",
+		"asset_id":   "tok1",
+		"bids":       []interface{}{map[string]interface{}{"price": "0.4", "size": "10"}},
+		"asks":       []interface{}{map[string]interface{}{"price": "0.6", "size": "10"}},
+	})
+	processRaw(c, map[string]interface{}{"event_type": "midpoint", "asset_id": "tok1", "midpoint": "0.52"})
+
+	select {
+	case ev := <-midCh:
+		if ev.Midpoint != "0.52" || ev.Synthetic {
+			t.Fatalf("expected only the server midpoint, got %+v", ev)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout waiting for midpoint")
+	}
+	select {
+	case ev := <-midCh:
+		t.Fatalf("unexpected extra midpoint: %+v", ev)
+	default:
+	}
+}
+
 func TestProcessEvent_LastTradePrice(t *testing.T) {
 	c := newTestClient()
 	ch := make(chan LastTradePriceEvent, 5)
@@ -519,7 +746,7 @@ func TestProcessEvent_LastTradePrice(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "last_trade_price", "asset_id": "tok1", "price": "0.55"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -539,7 +766,7 @@ func TestProcessEvent_TickSizeChange(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "tick_size_change", "asset_id": "tok1", "tick_size": "0.01"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -559,7 +786,7 @@ func TestProcessEvent_BestBidAsk(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "best_bid_ask", "asset_id": "tok1", "best_bid": "0.5", "best_ask": "0.6"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -579,7 +806,7 @@ func TestProcessEvent_Trade(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "trade", "asset_id": "tok1", "side": "BUY", "size": "10", "price": "0.5"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -591,6 +818,77 @@ func TestProcessEvent_Trade(t *testing.T) {
 	}
 }
 
+const userTradeFixture = `{
+	"asset_id": "52114319501245915516055106046884209969926127482827954674443846427813813222426",
+	"event_type": "trade",
+	"id": "28c4d2eb-bbea-40e7-a9f0-b2fdb56b2c2e",
+	"last_update": "1672290701",
+	"maker_orders": [
+		{
+			"asset_id": "52114319501245915516055106046884209969926127482827954674443846427813813222426",
+			"matched_amount": "10",
+			"order_id": "0xff354cd7ca7539dfa9c28d90943ab5779a4eac34b9b37a757d7b32bdfb11790b",
+			"outcome": "YES",
+			"owner": "9180014b-33c8-9240-a14b-bdca11c0a465",
+			"price": 0.57
+		}
+	],
+	"market": "0xbd31dc8a20211944f6b70f31557f1001557b59905b7738480ca09bd4532f84af",
+	"matchtime": 1672290701,
+	"outcome": "YES",
+	"owner": "9180014b-33c8-9240-a14b-bdca11c0a465",
+	"price": "0.57",
+	"side": "BUY",
+	"size": "10",
+	"status": "MATCHED",
+	"taker_order_id": "0x06bc63e346ed4ceddce9efd6b3af37c8f8f440c92fe7da6b2d0f9e4ccbc50c42",
+	"timestamp": "1672290701",
+	"trade_owner": "9180014b-33c8-9240-a14b-bdca11c0a465",
+	"type": "TRADE"
+}`
+
+func TestProcessEvent_TradeFixture(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan TradeEvent, 5)
+	c.tradeSubs["tr1"] = &subscriptionEntry[TradeEvent]{
+		id: "tr1", ch: ch, errCh: make(chan error, 5),
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(userTradeFixture), &raw); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+	processRaw(c, raw)
+
+	select {
+	case ev := <-ch:
+		if ev.ID != "28c4d2eb-bbea-40e7-a9f0-b2fdb56b2c2e" || ev.Status != "MATCHED" || ev.Type != "TRADE" {
+			t.Errorf("unexpected trade identity: %+v", ev)
+		}
+		if ev.Market != "0xbd31dc8a20211944f6b70f31557f1001557b59905b7738480ca09bd4532f84af" || ev.Outcome != "YES" {
+			t.Errorf("unexpected market fields: %+v", ev)
+		}
+		if ev.Price != "0.57" || ev.Size != "10" || ev.Side != "BUY" {
+			t.Errorf("unexpected price fields: %+v", ev)
+		}
+		if ev.MatchTime != "1672290701" || ev.LastUpdate != "1672290701" || ev.Timestamp != "1672290701" {
+			t.Errorf("unexpected times: match=%s update=%s ts=%s", ev.MatchTime, ev.LastUpdate, ev.Timestamp)
+		}
+		if ev.TakerOrderID == "" || ev.TradeOwner == "" {
+			t.Errorf("missing taker fields: %+v", ev)
+		}
+		if len(ev.MakerOrders) != 1 {
+			t.Fatalf("expected 1 maker order, got %d", len(ev.MakerOrders))
+		}
+		maker := ev.MakerOrders[0]
+		if maker.Price != "0.57" || maker.MatchedAmount != "10" || maker.OrderID == "" {
+			t.Errorf("unexpected maker order: %+v", maker)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout")
+	}
+}
+
 func TestProcessEvent_Order(t *testing.T) {
 	c := newTestClient()
 	ch := make(chan OrderEvent, 5)
@@ -599,7 +897,7 @@ func TestProcessEvent_Order(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "order", "asset_id": "tok1", "side": "SELL", "size": "5"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -611,6 +909,60 @@ func TestProcessEvent_Order(t *testing.T) {
 	}
 }
 
+const userOrderFixture = `{
+	"asset_id": "52114319501245915516055106046884209969926127482827954674443846427813813222426",
+	"associate_trades": null,
+	"event_type": "order",
+	"id": "0xff354cd7ca7539dfa9c28d90943ab5779a4eac34b9b37a757d7b32bdfb11790b",
+	"market": "0xbd31dc8a20211944f6b70f31557f1001557b59905b7738480ca09bd4532f84af",
+	"order_owner": "9180014b-33c8-9240-a14b-bdca11c0a465",
+	"original_size": "10",
+	"outcome": "YES",
+	"owner": "9180014b-33c8-9240-a14b-bdca11c0a465",
+	"price": "0.57",
+	"side": "SELL",
+	"size_matched": "4",
+	"status": "LIVE",
+	"timestamp": 1672290687,
+	"type": "UPDATE"
+}`
+
+func TestProcessEvent_OrderFixture(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan OrderEvent, 5)
+	c.orderSubs["or1"] = &subscriptionEntry[OrderEvent]{
+		id: "or1", ch: ch, errCh: make(chan error, 5),
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(userOrderFixture), &raw); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+	processRaw(c, raw)
+
+	select {
+	case ev := <-ch:
+		if ev.ID != "0xff354cd7ca7539dfa9c28d90943ab5779a4eac34b9b37a757d7b32bdfb11790b" || ev.Market == "" || ev.AssetID == "" {
+			t.Errorf("unexpected order identity: %+v", ev)
+		}
+		if ev.Side != "SELL" || ev.Price != "0.57" || ev.OriginalSize != "10" || ev.SizeMatched != "4" {
+			t.Errorf("unexpected order fields: %+v", ev)
+		}
+		if ev.Status != OrderStatusLive || ev.Type != OrderEventUpdate {
+			t.Errorf("unexpected status/type: %s/%s", ev.Status, ev.Type)
+		}
+		remaining, err := ev.RemainingSize()
+		if err != nil || remaining.String() != "6" {
+			t.Errorf("RemainingSize = %s, %v; want 6", remaining, err)
+		}
+		if got := ev.Time().Unix(); got != 1672290687 {
+			t.Errorf("Time = %d, want 1672290687", got)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout")
+	}
+}
+
 func TestProcessEvent_NewMarket(t *testing.T) {
 	c := newTestClient()
 	ch := make(chan NewMarketEvent, 5)
@@ -619,7 +971,7 @@ func TestProcessEvent_NewMarket(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "new_market", "market": "m1", "assets_ids": []interface{}{"a1", "a2"}}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -642,7 +994,7 @@ func TestProcessEvent_NewMarket_AltAssetIDs(t *testing.T) {
 	}
 
 	raw := map[string]interface{}{"event_type": "new_market", "market": "m1", "asset_ids": []interface{}{"a1"}}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -668,7 +1020,7 @@ func TestProcessEvent_MarketResolved(t *testing.T) {
 		"winning_asset_id": "a1",
 		"winning_outcome":  "Yes",
 	}
-	c.processEvent(raw)
+	processRaw(c, raw)
 
 	select {
 	case ev := <-ch:
@@ -684,7 +1036,7 @@ func TestProcessEvent_Unknown(t *testing.T) {
 	c := newTestClient()
 	// Should not panic on unknown event type
 	raw := map[string]interface{}{"event_type": "unknown_type", "data": "test"}
-	c.processEvent(raw)
+	processRaw(c, raw)
 }
 
 // --------------- ConnectionState ---------------
@@ -726,15 +1078,52 @@ func TestConnectionState_EmptyDefault(t *testing.T) {
 	}
 }
 
+func TestWaitUntilConnected(t *testing.T) {
+	c := newTestClient()
+	c.setConnState(ChannelMarket, ConnectionConnected, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.WaitUntilConnected(ctx, ChannelMarket); err != nil {
+		t.Fatalf("market already connected: %v", err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		c.setConnState(ChannelUser, ConnectionConnecting, 0)
+		c.setConnState(ChannelUser, ConnectionConnected, 0)
+	}()
+	if err := c.WaitUntilConnected(ctx, ChannelUser); err != nil {
+		t.Fatalf("WaitUntilConnected(user): %v", err)
+	}
+
+	c.setConnState(ChannelMarket, ConnectionReconnecting, 1)
+	short, cancelShort := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelShort()
+	if err := c.WaitUntilConnected(short, ChannelMarket); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitUntilConnected = %v, want deadline exceeded", err)
+	}
+	if err := c.WaitUntilConnected(ctx, "unknown"); err == nil {
+		t.Fatal("expected an error for an unknown channel")
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = c.Close()
+	}()
+	if err := c.WaitUntilConnected(ctx, ChannelMarket); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("WaitUntilConnected after Close = %v, want ErrClientClosed", err)
+	}
+}
+
 // --------------- trySendGlobal ---------------
 
 func TestTrySendGlobal_NilChannel(t *testing.T) {
-	trySendGlobal[int](nil, 42)
+	trySendGlobal[int](newTestClient(), nil, 42)
 }
 
 func TestTrySendGlobal_Normal(t *testing.T) {
 	ch := make(chan int, 1)
-	trySendGlobal(ch, 42)
+	trySendGlobal(newTestClient(), ch, 42)
 	select {
 	case v := <-ch:
 		if v != 42 {
@@ -748,7 +1137,17 @@ func TestTrySendGlobal_Normal(t *testing.T) {
 func TestTrySendGlobal_FullChannel(t *testing.T) {
 	ch := make(chan int, 1)
 	ch <- 1
-	trySendGlobal(ch, 2) // should not block
+	trySendGlobal(newTestClient(), ch, 2) // should not block
+}
+
+func TestTrySendGlobal_Closing(t *testing.T) {
+	c := newTestClient()
+	c.closing.Store(true)
+	ch := make(chan int, 1)
+	trySendGlobal(c, ch, 42)
+	if len(ch) != 0 {
+		t.Fatal("expected send to be dropped while closing")
+	}
 }
 
 // --------------- authPayload ---------------
@@ -847,6 +1246,101 @@ func TestDeauthenticate(t *testing.T) {
 	}
 }
 
+func TestUpdateAuth(t *testing.T) {
+	received := make(chan SubscriptionRequest, 4)
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			var req SubscriptionRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			received <- req
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.userURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	c.apiKey = &auth.APIKey{Key: "old", Secret: "s", Passphrase: "p"}
+	defer c.Close()
+	if _, err := c.SubscribeUserOrdersStream(context.Background(), []string{"m1"}); err != nil {
+		t.Fatalf("SubscribeUserOrdersStream failed: %v", err)
+	}
+	if first := <-received; first.Auth == nil || first.Auth.APIKey != "old" {
+		t.Fatalf("unexpected subscription: %+v", first)
+	}
+
+	if err := c.UpdateAuth(&auth.APIKey{Key: "new", Secret: "s2", Passphrase: "p2"}); err != nil {
+		t.Fatalf("UpdateAuth failed: %v", err)
+	}
+	select {
+	case req := <-received:
+		if req.Auth == nil || req.Auth.APIKey != "new" || len(req.Markets) != 1 || req.Markets[0] != "m1" {
+			t.Errorf("unexpected re-auth: %+v", req)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("user channel was not re-authenticated")
+	}
+	if last := c.getLastAuth(); last == nil || last.APIKey != "new" {
+		t.Errorf("last auth = %+v", last)
+	}
+	if err := c.UpdateAuth(&auth.APIKey{Key: "partial"}); err == nil {
+		t.Error("expected error for incomplete credentials")
+	}
+}
+
+func TestResubscribeGroupsCustomFeatures(t *testing.T) {
+	received := make(chan SubscriptionRequest, 8)
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			var req SubscriptionRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			received <- req
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	defer c.Close()
+
+	c.addMarketRefs([]string{"a", "b"}, false)
+	if got := c.addMarketRefs([]string{"b", "c"}, true); len(got) != 2 || got[0] != "b" || got[1] != "c" {
+		t.Fatalf("custom upgrade should resubscribe b and c, got %v", got)
+	}
+	if err := c.ensureConn(ChannelMarket); err != nil {
+		t.Fatalf("ensureConn failed: %v", err)
+	}
+	c.resubscribe(ChannelMarket)
+
+	groups := map[bool][]string{}
+	for i := 0; i < 2; i++ {
+		select {
+		case req := <-received:
+			custom := req.CustomFeatureEnabled != nil && *req.CustomFeatureEnabled
+			groups[custom] = append(groups[custom], req.AssetIDs...)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for resubscription %d", i+1)
+		}
+	}
+	sort.Strings(groups[true])
+	if len(groups[false]) != 1 || groups[false][0] != "a" {
+		t.Errorf("plain assets = %v, want [a]", groups[false])
+	}
+	if len(groups[true]) != 2 || groups[true][0] != "b" || groups[true][1] != "c" {
+		t.Errorf("custom assets = %v, want [b c]", groups[true])
+	}
+
+	c.removeMarketRefs([]string{"c"})
+	if plain, custom := c.splitCustomAssets([]string{"a", "b", "c"}); len(plain) != 2 || len(custom) != 1 || custom[0] != "b" {
+		t.Errorf("after release: plain %v custom %v", plain, custom)
+	}
+}
+
 // --------------- addMarketRefs / removeMarketRefs ---------------
 
 func TestAddMarketRefs(t *testing.T) {
@@ -916,7 +1410,7 @@ func TestRemoveUserRefs(t *testing.T) {
 
 func TestApplySubscription_NilRequest(t *testing.T) {
 	c := newTestClient()
-	err := c.applySubscription(nil, OperationSubscribe)
+	err := c.applySubscription(context.Background(), nil, OperationSubscribe)
 	if err == nil {
 		t.Fatal("expected error for nil request")
 	}
@@ -924,7 +1418,7 @@ func TestApplySubscription_NilRequest(t *testing.T) {
 
 func TestApplySubscription_NoTypeNoIDs(t *testing.T) {
 	c := newTestClient()
-	err := c.applySubscription(&SubscriptionRequest{}, OperationSubscribe)
+	err := c.applySubscription(context.Background(), &SubscriptionRequest{}, OperationSubscribe)
 	if err == nil || !strings.Contains(err.Error(), "type is required") {
 		t.Fatalf("expected type required error, got %v", err)
 	}
@@ -937,7 +1431,7 @@ func TestApplySubscription_InferMarketType(t *testing.T) {
 		AssetIDs:  []string{"a1"},
 	}
 	// Will fail at ensureConn (no real WS), but should pass validation
-	err := c.applySubscription(req, OperationSubscribe)
+	err := c.applySubscription(context.Background(), req, OperationSubscribe)
 	if err != nil && strings.Contains(err.Error(), "type is required") {
 		t.Fatalf("should have inferred market type: %v", err)
 	}
@@ -950,7 +1444,7 @@ func TestApplySubscription_InferUserType(t *testing.T) {
 		Operation: OperationSubscribe,
 		Markets:   []string{"m1"},
 	}
-	err := c.applySubscription(req, OperationSubscribe)
+	err := c.applySubscription(context.Background(), req, OperationSubscribe)
 	if err != nil && strings.Contains(err.Error(), "type is required") {
 		t.Fatalf("should have inferred user type: %v", err)
 	}
@@ -959,7 +1453,7 @@ func TestApplySubscription_InferUserType(t *testing.T) {
 func TestApplySubscription_MarketMissingAssets(t *testing.T) {
 	c := newTestClient()
 	req := &SubscriptionRequest{Type: ChannelMarket}
-	err := c.applySubscription(req, OperationSubscribe)
+	err := c.applySubscription(context.Background(), req, OperationSubscribe)
 	if err == nil || !strings.Contains(err.Error(), "assetIDs required") {
 		t.Fatalf("expected assetIDs required, got %v", err)
 	}
@@ -968,16 +1462,43 @@ func TestApplySubscription_MarketMissingAssets(t *testing.T) {
 func TestApplySubscription_UserMissingMarkets(t *testing.T) {
 	c := newTestClient()
 	req := &SubscriptionRequest{Type: ChannelUser}
-	err := c.applySubscription(req, OperationSubscribe)
+	err := c.applySubscription(context.Background(), req, OperationSubscribe)
 	if err == nil || !strings.Contains(err.Error(), "markets required") {
 		t.Fatalf("expected markets required, got %v", err)
 	}
 }
 
+func TestSubscriptionSentinelErrors(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	if _, err := c.SubscribeOrderbookStream(ctx, nil); !errors.Is(err, ErrNoAssetIDs) {
+		t.Errorf("expected ErrNoAssetIDs, got %v", err)
+	}
+	if _, err := c.SubscribeUserOrdersStream(ctx, nil); !errors.Is(err, ErrNoMarkets) {
+		t.Errorf("expected ErrNoMarkets, got %v", err)
+	}
+	if _, err := c.SubscribeUserOrdersStream(ctx, []string{"m1"}); !errors.Is(err, ErrAuthRequired) {
+		t.Errorf("expected ErrAuthRequired, got %v", err)
+	}
+	if err := c.writeMessage(ChannelMarket, []byte("PING")); !errors.Is(err, ErrNotConnected) {
+		t.Errorf("expected ErrNotConnected, got %v", err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	c.marketURL = "ws://" + ln.Addr().String()
+	_ = ln.Close()
+	if err := c.ensureConn(ChannelMarket); !errors.Is(err, ErrNotConnected) {
+		t.Errorf("expected a dial failure to wrap ErrNotConnected, got %v", err)
+	}
+}
+
 func TestApplySubscription_UnknownChannel(t *testing.T) {
 	c := newTestClient()
 	req := &SubscriptionRequest{Type: "unknown"}
-	err := c.applySubscription(req, OperationSubscribe)
+	err := c.applySubscription(context.Background(), req, OperationSubscribe)
 	if err == nil || !strings.Contains(err.Error(), "unknown") {
 		t.Fatalf("expected unknown channel error, got %v", err)
 	}
@@ -1036,3 +1557,441 @@ func TestConcurrentClose(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestSubscribe_CancelledContext(t *testing.T) {
+	c := newTestClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.Subscribe(ctx, NewMarketSubscription([]string{"a1"}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(c.marketRefs) != 0 {
+		t.Fatalf("cancelled subscribe should not add refs, got %v", c.marketRefs)
+	}
+}
+
+func TestSubscribe_DialHonorsDeadline(t *testing.T) {
+	// Accept TCP connections but never complete the websocket handshake.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	c := newTestClient()
+	c.userURL = "ws://" + ln.Addr().String() + "/ws/user"
+	c.apiKey = &auth.APIKey{Key: "k", Secret: "s", Passphrase: "p"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = c.Subscribe(ctx, &SubscriptionRequest{Type: ChannelUser, Markets: []string{"m1"}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("subscribe did not return promptly: %v", elapsed)
+	}
+}
+
+func TestUnsubscribe_CancelledContext(t *testing.T) {
+	c := newTestClient()
+	c.marketRefs["a1"] = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.Unsubscribe(ctx, NewMarketUnsubscribe([]string{"a1"}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if c.marketRefs["a1"] != 1 {
+		t.Fatalf("cancelled unsubscribe should keep refs, got %v", c.marketRefs)
+	}
+}
+
+func TestUnsubscribeAsset(t *testing.T) {
+	c := newTestClient()
+	// Two book streams on a1 (one also on a2) and a price stream on a2 only.
+	both := newSubscriptionEntry[OrderbookEvent](c, ChannelMarket, Orderbook, []string{"a1", "a2"}, nil)
+	single := newSubscriptionEntry[OrderbookEvent](c, ChannelMarket, Orderbook, []string{"a1"}, nil)
+	other := newSubscriptionEntry[PriceChangeEvent](c, ChannelMarket, PriceChange, []string{"a2"}, nil)
+	c.orderbookSubs[both.id] = both
+	c.orderbookSubs[single.id] = single
+	c.priceSubs[other.id] = other
+	c.marketRefs["a1"] = 2
+	c.marketRefs["a2"] = 2
+
+	if err := c.UnsubscribeAsset(" a1 "); err != nil {
+		t.Fatalf("UnsubscribeAsset failed: %v", err)
+	}
+	for _, sub := range []*subscriptionEntry[OrderbookEvent]{both, single} {
+		var notice AssetUnsubscribedError
+		if err := <-sub.errCh; !errors.As(err, &notice) || notice.AssetID != "a1" {
+			t.Errorf("expected AssetUnsubscribedError for a1, got %v", err)
+		}
+		if _, ok := <-sub.ch; ok {
+			t.Error("expected stream channel to be closed")
+		}
+	}
+	if len(c.orderbookSubs) != 0 || len(c.priceSubs) != 1 {
+		t.Errorf("unexpected subs: %d book, %d price", len(c.orderbookSubs), len(c.priceSubs))
+	}
+	if _, ok := c.marketRefs["a1"]; ok || c.marketRefs["a2"] != 1 {
+		t.Errorf("unexpected refs: %v", c.marketRefs)
+	}
+
+	// Closing an affected stream afterwards must not release refs again.
+	closeMarketStream(c, both, []string{"a1", "a2"}, c.orderbookSubs)
+	if c.marketRefs["a2"] != 1 {
+		t.Errorf("refs released twice: %v", c.marketRefs)
+	}
+
+	if err := c.UnsubscribeAsset(""); err == nil {
+		t.Error("expected error for empty asset")
+	}
+}
+
+func TestCloseAndDrain(t *testing.T) {
+	t.Run("DeliversBufferedEvents", func(t *testing.T) {
+		c := newTestClient()
+		sub := newSubscriptionEntry[OrderbookEvent](c, ChannelMarket, Orderbook, []string{"a1"}, nil)
+		c.orderbookSubs[sub.id] = sub
+		for i := 0; i < 3; i++ {
+			sub.trySend(OrderbookEvent{AssetID: "a1"})
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- c.CloseAndDrain(context.Background()) }()
+
+		received := 0
+		for range sub.ch {
+			received++
+		}
+		if received != 3 {
+			t.Errorf("received %d events, want 3", received)
+		}
+		if err := <-done; err != nil {
+			t.Fatalf("CloseAndDrain failed: %v", err)
+		}
+		if err := c.ensureConn(ChannelMarket); !errors.Is(err, ErrClientClosed) {
+			t.Errorf("expected ErrClientClosed, got %v", err)
+		}
+	})
+
+	t.Run("GivesUpAtDeadline", func(t *testing.T) {
+		c := newTestClient()
+		sub := newSubscriptionEntry[OrderbookEvent](c, ChannelMarket, Orderbook, []string{"a1"}, nil)
+		c.orderbookSubs[sub.id] = sub
+		sub.trySend(OrderbookEvent{AssetID: "a1"})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := c.CloseAndDrain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		select {
+		case <-c.done:
+		default:
+			t.Fatal("client was not shut down")
+		}
+	})
+}
+
+func TestCloseRacesDispatch(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		c := newTestClient()
+		sub := newSubscriptionEntry[OrderbookEvent](c, ChannelMarket, Orderbook, []string{"a1"}, nil)
+		c.orderbookSubs[sub.id] = sub
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				for k := 0; k < 100; k++ {
+					c.dispatchOrderbook(OrderbookEvent{AssetID: "a1"})
+					c.dispatchTrade(TradeEvent{Market: "m1"})
+				}
+			}()
+		}
+		close(start)
+		_ = c.Close()
+		wg.Wait()
+	}
+}
+
+// TestDispatchAfterClose covers a read loop iteration that finishes after
+// shutdown closed the global channels: every dispatch must drop the event
+// instead of panicking with a send on a closed channel.
+func TestDispatchAfterClose(t *testing.T) {
+	c := newTestClient()
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	c.dispatchOrderbook(OrderbookEvent{AssetID: "a1"})
+	c.dispatchPrice(PriceEvent{PriceChanges: []PriceChangeEvent{{AssetId: "a1", Price: "0.5"}}})
+	c.dispatchMidpoint(MidpointEvent{AssetID: "a1"})
+	c.dispatchLastTrade(LastTradePriceEvent{AssetID: "a1"})
+	c.dispatchTickSize(TickSizeChangeEvent{AssetID: "a1"})
+	c.dispatchBestBidAsk(BestBidAskEvent{AssetID: "a1"})
+	c.dispatchNewMarket(NewMarketEvent{ID: "m1"})
+	c.dispatchMarketResolved(MarketResolvedEvent{ID: "m1"})
+	c.dispatchTrade(TradeEvent{Market: "m1"})
+	c.dispatchOrder(OrderEvent{Market: "m1"})
+	c.processMessage([]byte(`[{"event_type":"book","asset_id":"a1"},{"event_type":"order","market":"m1"}]`))
+}
+
+func TestLatestBestBidAsk(t *testing.T) {
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			var req SubscriptionRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			if req.Operation == OperationUnsubscribe {
+				continue
+			}
+			for _, id := range req.AssetIDs {
+				_ = conn.WriteJSON(map[string]string{"event_type": "best_bid_ask", "asset_id": id, "best_bid": "0.48", "best_ask": "0.52"})
+			}
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.setReadTimeout(time.Second)
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	event, err := c.LatestBestBidAsk(ctx, "a1")
+	if err != nil {
+		t.Fatalf("LatestBestBidAsk failed: %v", err)
+	}
+	if event.AssetID != "a1" || event.BestBid != "0.48" || event.BestAsk != "0.52" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	c.subMu.Lock()
+	subs, refs := len(c.bestBidAskSubs), len(c.marketRefs)
+	c.subMu.Unlock()
+	if subs != 0 || refs != 0 {
+		t.Errorf("subscription not released: %d streams, %d asset refs", subs, refs)
+	}
+
+	if _, err := c.LatestBestBidAsk(ctx, " "); !errors.Is(err, ErrNoAssetIDs) {
+		t.Errorf("expected ErrNoAssetIDs, got %v", err)
+	}
+}
+
+func TestLatestMidpointAlreadySubscribed(t *testing.T) {
+	var subscribes atomic.Int32
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			var req SubscriptionRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			if req.Operation == OperationUnsubscribe {
+				continue
+			}
+			n := subscribes.Add(1)
+			for _, id := range req.AssetIDs {
+				_ = conn.WriteJSON(map[string]string{"event_type": "midpoint", "asset_id": id, "midpoint": fmt.Sprintf("0.5%d", n)})
+			}
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.setReadTimeout(time.Second)
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	stream, err := c.SubscribeMidpointsStream(ctx, []string{"a1"})
+	if err != nil {
+		t.Fatalf("SubscribeMidpointsStream failed: %v", err)
+	}
+	defer stream.Close()
+	select {
+	case <-stream.C:
+	case <-ctx.Done():
+		t.Fatal("no initial midpoint")
+	}
+
+	event, err := c.LatestMidpoint(ctx, "a1")
+	if err != nil {
+		t.Fatalf("LatestMidpoint failed: %v", err)
+	}
+	if event.AssetID != "a1" || event.Midpoint != "0.52" {
+		t.Errorf("expected a fresh snapshot, got %+v", event)
+	}
+	c.subMu.Lock()
+	refs := c.marketRefs["a1"]
+	c.subMu.Unlock()
+	if refs != 1 {
+		t.Errorf("existing subscription lost its ref: %d", refs)
+	}
+}
+
+func TestLatestMidpointHonorsContext(t *testing.T) {
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.setReadTimeout(time.Second)
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.LatestMidpoint(ctx, "a1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestSubscribeAll(t *testing.T) {
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer s.Close()
+
+	c := newTestClient()
+	c.disablePing = true
+	c.setReadTimeout(time.Second)
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	c.userURL = c.marketURL
+	c.apiKey = &auth.APIKey{Key: "k", Secret: "s", Passphrase: "p"}
+	defer c.Close()
+
+	if _, err := c.SubscribeAll(context.Background(), nil, nil); !errors.Is(err, ErrNoAssetIDs) {
+		t.Fatalf("expected ErrNoAssetIDs, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := c.SubscribeAll(ctx, []string{"a1"}, []string{"m1"})
+	if err != nil {
+		t.Fatalf("SubscribeAll failed: %v", err)
+	}
+	c.processMessage([]byte(`[{"event_type":"book","asset_id":"a1"},{"event_type":"order","market":"m1","id":"o1"},{"event_type":"trade","market":"m1","id":"t1"}]`))
+
+	got := make(map[EventType]Event)
+	timeout := time.After(2 * time.Second)
+	for len(got) < 3 {
+		select {
+		case event := <-events:
+			got[event.Kind] = event
+		case <-timeout:
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if e := got[Orderbook]; e.Orderbook == nil || e.Orderbook.AssetID != "a1" {
+		t.Errorf("unexpected orderbook event: %+v", e)
+	}
+	if e := got[UserOrders]; e.Order == nil || e.Order.ID != "o1" {
+		t.Errorf("unexpected order event: %+v", e)
+	}
+	if e := got[UserTrades]; e.Trade == nil || e.Trade.ID != "t1" {
+		t.Errorf("unexpected trade event: %+v", e)
+	}
+
+	cancel()
+	for range events {
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.subMu.Lock()
+		open := len(c.orderbookSubs) + len(c.orderSubs) + len(c.tradeSubs)
+		c.subMu.Unlock()
+		if open == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d streams still open after cancel", open)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSubscribeStreamWriteHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		// Never read, so a large enough subscription fills the socket
+		// buffers and blocks the client's write.
+		<-release
+	})
+	defer s.Close()
+	defer close(release)
+
+	c := newTestClient()
+	c.disablePing = true
+	c.setReadTimeout(5 * time.Second)
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	defer c.Close()
+	if err := c.ensureConn(ChannelMarket); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+
+	assertRolledBack := func(t *testing.T) {
+		t.Helper()
+		c.subMu.Lock()
+		refs, subs := len(c.marketRefs), len(c.orderbookSubs)
+		c.subMu.Unlock()
+		if refs != 0 || subs != 0 {
+			t.Fatalf("subscription not rolled back: %d asset refs, %d streams", refs, subs)
+		}
+	}
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := c.SubscribeOrderbookStream(ctx, []string{"a1"}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+		assertRolledBack(t)
+	})
+
+	t.Run("BlockedWrite", func(t *testing.T) {
+		assetIDs := make([]string, 100000)
+		for i := range assetIDs {
+			assetIDs[i] = fmt.Sprintf("%070d", i)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := c.SubscribeOrderbookStream(ctx, assetIDs)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Fatalf("subscribe did not return promptly: %v", elapsed)
+		}
+		assertRolledBack(t)
+	})
+}
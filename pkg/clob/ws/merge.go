@@ -0,0 +1,113 @@
+package ws
+
+import (
+	"context"
+	"sync"
+)
+
+// Event is one message of a SubscribeAll stream. Kind is Orderbook,
+// UserOrders or UserTrades and tells which stream it came from; exactly one
+// of Orderbook, Order, Trade or Err is set. Err carries asynchronous
+// errors of that stream, such as LaggedError, which the channel-based
+// Subscribe methods drop.
+type Event struct {
+	Kind      EventType
+	Orderbook *OrderbookEvent
+	Order     *OrderEvent
+	Trade     *TradeEvent
+	Err       error
+}
+
+func (c *clientImpl) SubscribeAll(ctx context.Context, assetIDs, markets []string) (<-chan Event, error) {
+	if len(assetIDs) == 0 && len(markets) == 0 {
+		return nil, ErrNoAssetIDs
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// Every stream is bound to subCtx, so canceling it closes the streams
+	// already opened when a later subscription fails.
+	subCtx, cancel := context.WithCancel(ctx)
+
+	var forwarders []func(context.Context, chan<- Event)
+	if len(assetIDs) > 0 {
+		stream, err := c.SubscribeOrderbookStream(subCtx, assetIDs)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		forwarders = append(forwarders, forwardEvents(stream, Orderbook, func(e Event, v OrderbookEvent) Event {
+			e.Orderbook = &v
+			return e
+		}))
+	}
+	if len(markets) > 0 {
+		orders, err := c.SubscribeUserOrdersStream(subCtx, markets)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		forwarders = append(forwarders, forwardEvents(orders, UserOrders, func(e Event, v OrderEvent) Event {
+			e.Order = &v
+			return e
+		}))
+		trades, err := c.SubscribeUserTradesStream(subCtx, markets)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		forwarders = append(forwarders, forwardEvents(trades, UserTrades, func(e Event, v TradeEvent) Event {
+			e.Trade = &v
+			return e
+		}))
+	}
+
+	out := make(chan Event, defaultStreamBuffer)
+	var wg sync.WaitGroup
+	for _, forward := range forwarders {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forward(subCtx, out)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+		close(out)
+	}()
+	return out, nil
+}
+
+// forwardEvents returns a loop copying stream's events and errors to out,
+// tagged with kind, until the stream is closed or ctx is done.
+func forwardEvents[T any](stream *Stream[T], kind EventType, wrap func(Event, T) Event) func(context.Context, chan<- Event) {
+	return func(ctx context.Context, out chan<- Event) {
+		defer stream.Close()
+		events, errs := stream.C, stream.Err
+		for events != nil {
+			var event Event
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-events:
+				if !ok {
+					events = nil
+					continue
+				}
+				event = wrap(Event{Kind: kind}, v)
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				event = Event{Kind: kind, Err: err}
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ws
+
+import "time"
+
+// DefaultReconnectJitter randomizes each reconnect delay by ±20% so that
+// many clients dropped by the same server blip do not reconnect in lockstep.
+const DefaultReconnectJitter = 0.2
+
+// Option configures a Client created by NewClient. Options take precedence
+// over the CLOB_WS_* environment variables.
+type Option func(*clientImpl)
+
+// WithReconnect enables or disables automatic reconnection (default on).
+func WithReconnect(enabled bool) Option {
+	return func(c *clientImpl) { c.reconnect = enabled }
+}
+
+// WithReconnectDelay sets the first reconnect delay and the cap it grows to
+// (defaults 2s and 30s). Zero values keep the defaults.
+func WithReconnectDelay(initial, max time.Duration) Option {
+	return func(c *clientImpl) {
+		if initial > 0 {
+			c.reconnectDelay = initial
+		}
+		if max > 0 {
+			c.reconnectMaxDelay = max
+		}
+	}
+}
+
+// WithReconnectMax sets how many reconnect attempts are made before giving
+// up (default 5). Zero or a negative value retries forever.
+func WithReconnectMax(attempts int) Option {
+	return func(c *clientImpl) { c.reconnectMax = attempts }
+}
+
+// WithReconnectMultiplier sets the factor the reconnect delay grows by after
+// each failed attempt (default 2).
+func WithReconnectMultiplier(multiplier float64) Option {
+	return func(c *clientImpl) {
+		if multiplier > 0 {
+			c.reconnectMultiplier = multiplier
+		}
+	}
+}
+
+// WithReconnectJitter sets the fraction (0 to 1) by which each reconnect
+// delay is randomized (default DefaultReconnectJitter). Zero disables jitter.
+func WithReconnectJitter(jitter float64) Option {
+	return func(c *clientImpl) { c.reconnectJitter = clampJitter(jitter) }
+}
+
+func clampJitter(jitter float64) float64 {
+	switch {
+	case jitter < 0:
+		return 0
+	case jitter > 1:
+		return 1
+	default:
+		return jitter
+	}
+}
@@ -0,0 +1,54 @@
+package ws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconnectOptions(t *testing.T) {
+	c := newTestClient()
+	for _, opt := range []Option{
+		WithReconnect(false),
+		WithReconnectDelay(100*time.Millisecond, 5*time.Second),
+		WithReconnectMax(0),
+		WithReconnectMultiplier(3),
+		WithReconnectJitter(0.5),
+	} {
+		opt(c)
+	}
+	if c.reconnect || c.reconnectMax != 0 {
+		t.Errorf("reconnect = %v, max = %d", c.reconnect, c.reconnectMax)
+	}
+	b := c.reconnectBackoff()
+	if b.Base != 100*time.Millisecond || b.Max != 5*time.Second || b.Factor != 3 || b.Jitter != 0.5 {
+		t.Errorf("unexpected backoff: %+v", b)
+	}
+
+	WithReconnectDelay(0, 0)(c)
+	if c.reconnectDelay != 100*time.Millisecond || c.reconnectMaxDelay != 5*time.Second {
+		t.Error("zero delays should keep the current values")
+	}
+	WithReconnectJitter(2)(c)
+	if c.reconnectJitter != 1 {
+		t.Errorf("jitter = %v, want clamped to 1", c.reconnectJitter)
+	}
+}
+
+func TestReconnectBackoffJitter(t *testing.T) {
+	c := newTestClient()
+	c.reconnectDelay = time.Second
+	c.reconnectJitter = DefaultReconnectJitter
+	b := c.reconnectBackoff()
+	distinct := make(map[time.Duration]struct{})
+	for i := 0; i < 20; i++ {
+		d := b.Next()
+		b.Reset()
+		if d < 800*time.Millisecond || d > 1200*time.Millisecond {
+			t.Fatalf("delay %s outside ±20%% of 1s", d)
+		}
+		distinct[d] = struct{}{}
+	}
+	if len(distinct) < 2 {
+		t.Error("expected jittered delays to vary")
+	}
+}
@@ -0,0 +1,144 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/logger"
+)
+
+// RecordedFrame is one line of a recording written by RecordTo: a raw event
+// frame as read from the server, with the time it was received.
+type RecordedFrame struct {
+	Time    time.Time       `json:"time"`
+	Channel Channel         `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// RecordTo writes every event frame the client receives to w as a JSON line
+// holding a RecordedFrame. Heartbeat replies and frames that are not valid
+// JSON are not recorded. Writes are serialized across channels; the first
+// write error stops recording. The recording can be played back with
+// NewReplayClient.
+func RecordTo(w io.Writer) Option {
+	return func(c *clientImpl) {
+		if w == nil {
+			c.recorder = nil
+			return
+		}
+		c.recorder = &frameRecorder{enc: json.NewEncoder(w)}
+	}
+}
+
+type frameRecorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+}
+
+func (r *frameRecorder) record(channel Channel, message []byte) {
+	message = bytes.TrimSpace(message)
+	if len(message) == 0 || (message[0] != '{' && message[0] != '[') || !json.Valid(message) {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	frame := RecordedFrame{Time: time.Now(), Channel: channel, Data: message}
+	if err := r.enc.Encode(frame); err != nil {
+		r.err = err
+		logger.Error("ws recording stopped: %v", err)
+	}
+}
+
+// WithReplaySpeed sets how fast NewReplayClient plays a recording back
+// relative to the recorded pacing: 1 (the default) keeps the original gaps
+// between frames, 2 halves them, and zero or a negative value delivers
+// frames as fast as possible. It has no effect on live clients.
+func WithReplaySpeed(speed float64) Option {
+	return func(c *clientImpl) { c.replaySpeed = speed }
+}
+
+// ReplayClient is a Client fed from a recording made with RecordTo instead
+// of a live connection, for reproducible tests and backtests. It never dials
+// or sends anything: subscriptions only register streams, user streams need
+// no credentials, and both channels report ConnectionConnected until Close.
+//
+// Subscribe first, then call Replay to deliver the recorded frames. As with
+// a live client, events are dropped for streams whose buffers are full, so
+// consumers must keep up when replaying as fast as possible.
+type ReplayClient struct {
+	Client
+
+	c   *clientImpl
+	mu  sync.Mutex
+	dec *json.Decoder
+}
+
+// NewReplayClient returns a ReplayClient that plays the recording read from
+// r. Frames are decoded lazily by Replay.
+func NewReplayClient(r io.Reader, opts ...Option) (*ReplayClient, error) {
+	if r == nil {
+		return nil, errors.New("replay reader is required")
+	}
+	c := newClientImpl("", nil, nil, append([]Option{WithReplaySpeed(1)}, opts...)...)
+	c.replaying = true
+	c.reconnect = false
+	c.setConnState(ChannelMarket, ConnectionConnected, 0)
+	c.setConnState(ChannelUser, ConnectionConnected, 0)
+	return &ReplayClient{Client: c, c: c, dec: json.NewDecoder(r)}, nil
+}
+
+// Replay delivers the remaining recorded frames to the subscribed streams,
+// pacing them as configured by WithReplaySpeed, and returns nil once the
+// recording is exhausted. It returns ctx's error if ctx is done first,
+// ErrClientClosed if the client is closed, and an error for a malformed
+// recording. Frames already delivered are not replayed by a later call.
+func (r *ReplayClient) Replay(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	speed := r.c.replaySpeed
+	var prev time.Time
+	for {
+		var frame RecordedFrame
+		if err := r.dec.Decode(&frame); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decode recorded frame: %w", err)
+		}
+		if speed > 0 && !prev.IsZero() && frame.Time.After(prev) {
+			wait := time.Duration(float64(frame.Time.Sub(prev)) / speed)
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-r.c.stopContext().Done():
+				timer.Stop()
+				return ErrClientClosed
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if r.c.closing.Load() {
+			return ErrClientClosed
+		}
+		prev = frame.Time
+		r.c.processMessage(frame.Data)
+	}
+}
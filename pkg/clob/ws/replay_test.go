@@ -0,0 +1,167 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		_, _, _ = conn.ReadMessage()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"event_type":"book","asset_id":"tok1","bids":[{"price":"0.4","size":"10"}],"asks":[]}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"event_type":"trade","id":"t1","asset_id":"tok1","market":"m1","price":0.4,"size":"10","side":"BUY"}`))
+		_, _, _ = conn.ReadMessage()
+	})
+	defer s.Close()
+
+	var recording bytes.Buffer
+	c := newTestClient()
+	c.marketURL = "ws" + strings.TrimPrefix(s.URL, "http")
+	c.disablePing = true
+	c.setReadTimeout(time.Second)
+	RecordTo(&recording)(c)
+
+	book, err := c.SubscribeOrderbookStream(context.Background(), []string{"tok1"})
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	select {
+	case <-book.C:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for live book")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for len(c.tradeCh) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	var frames []RecordedFrame
+	dec := json.NewDecoder(bytes.NewReader(recording.Bytes()))
+	for dec.More() {
+		var frame RecordedFrame
+		if err := dec.Decode(&frame); err != nil {
+			t.Fatalf("decode recording: %v", err)
+		}
+		frames = append(frames, frame)
+	}
+	if len(frames) != 2 || frames[0].Channel != ChannelMarket || frames[0].Time.IsZero() {
+		t.Fatalf("unexpected recording: %+v", frames)
+	}
+
+	replay, err := NewReplayClient(&recording, WithReplaySpeed(0))
+	if err != nil {
+		t.Fatalf("NewReplayClient: %v", err)
+	}
+	defer replay.Close()
+	books, err := replay.SubscribeOrderbookStream(context.Background(), []string{"tok1"})
+	if err != nil {
+		t.Fatalf("replay subscribe book: %v", err)
+	}
+	trades, err := replay.SubscribeUserTradesStream(context.Background(), []string{"m1"})
+	if err != nil {
+		t.Fatalf("replay subscribe trades without credentials: %v", err)
+	}
+	if state := replay.ConnectionState(ChannelUser); state != ConnectionConnected {
+		t.Errorf("user state = %s, want connected", state)
+	}
+	if err := replay.Replay(context.Background()); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+
+	select {
+	case event := <-books.C:
+		if event.AssetID != "tok1" || len(event.Bids) != 1 {
+			t.Errorf("unexpected replayed book: %+v", event)
+		}
+	default:
+		t.Error("book was not replayed")
+	}
+	select {
+	case event := <-trades.C:
+		if event.ID != "t1" || event.Price != "0.4" {
+			t.Errorf("unexpected replayed trade: %+v", event)
+		}
+	default:
+		t.Error("trade was not replayed")
+	}
+}
+
+func TestReplayPacing(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var recording bytes.Buffer
+	enc := json.NewEncoder(&recording)
+	for i, gap := range []time.Duration{0, 80 * time.Millisecond, 160 * time.Millisecond} {
+		data := json.RawMessage(`{"event_type":"last_trade_price","asset_id":"tok1","price":"0.` + string(rune('1'+i)) + `"}`)
+		if err := enc.Encode(RecordedFrame{Time: start.Add(gap), Channel: ChannelMarket, Data: data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	replay := func(speed float64) time.Duration {
+		client, err := NewReplayClient(bytes.NewReader(recording.Bytes()), WithReplaySpeed(speed))
+		if err != nil {
+			t.Fatalf("NewReplayClient: %v", err)
+		}
+		defer client.Close()
+		stream, err := client.SubscribeLastTradePricesStream(context.Background(), []string{"tok1"})
+		if err != nil {
+			t.Fatalf("subscribe: %v", err)
+		}
+		began := time.Now()
+		if err := client.Replay(context.Background()); err != nil {
+			t.Fatalf("Replay: %v", err)
+		}
+		elapsed := time.Since(began)
+		for _, want := range []string{"0.1", "0.2", "0.3"} {
+			if event := <-stream.C; event.Price != want {
+				t.Errorf("price = %s, want %s", event.Price, want)
+			}
+		}
+		return elapsed
+	}
+
+	if elapsed := replay(1); elapsed < 150*time.Millisecond {
+		t.Errorf("recorded pacing took %s, want at least 160ms", elapsed)
+	}
+	if elapsed := replay(0); elapsed > 100*time.Millisecond {
+		t.Errorf("unpaced replay took %s", elapsed)
+	}
+
+	client, err := NewReplayClient(bytes.NewReader(recording.Bytes()))
+	if err != nil {
+		t.Fatalf("NewReplayClient: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Replay(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Replay with expiring ctx = %v, want deadline exceeded", err)
+	}
+	_ = client.Close()
+	if err := client.Replay(context.Background()); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Replay after Close = %v, want ErrClientClosed", err)
+	}
+}
+
+func TestReplayMalformedRecording(t *testing.T) {
+	client, err := NewReplayClient(strings.NewReader("{not json}\n"))
+	if err != nil {
+		t.Fatalf("NewReplayClient: %v", err)
+	}
+	defer client.Close()
+	if err := client.Replay(context.Background()); err == nil {
+		t.Fatal("expected an error for a malformed recording")
+	}
+	if _, err := NewReplayClient(nil); err == nil {
+		t.Fatal("expected an error for a nil reader")
+	}
+}
@@ -30,3 +30,13 @@ func (e LaggedError) Error() string {
 	}
 	return fmt.Sprintf("clobws subscription lagged, missed %d messages (channel=%s type=%s)", e.Count, e.Channel, e.EventType)
 }
+
+// AssetUnsubscribedError is delivered on a stream's Err channel, just before
+// the stream is closed, when its asset is removed with UnsubscribeAsset.
+type AssetUnsubscribedError struct {
+	AssetID string
+}
+
+func (e AssetUnsubscribedError) Error() string {
+	return fmt.Sprintf("clobws asset %s unsubscribed", e.AssetID)
+}
@@ -2,11 +2,13 @@ package ws
 
 import (
 	"sync"
+	"time"
 )
 
 const (
 	defaultStreamBuffer = 100
 	defaultErrBuffer    = 10
+	drainPollInterval   = 10 * time.Millisecond
 )
 
 type subscriptionEntry[T any] struct {
@@ -14,6 +16,7 @@ type subscriptionEntry[T any] struct {
 	channel   Channel
 	event     EventType
 	assets    map[string]struct{}
+	assetIDs  []string // as subscribed, for releasing market refs
 	markets   map[string]struct{}
 	ch        chan T
 	errCh     chan error
@@ -98,6 +101,34 @@ func (s *subscriptionEntry[T]) close() bool {
 	return true
 }
 
+// closeWithError delivers err on the error channel, evicting the oldest
+// pending error if the buffer is full, then closes the stream.
+func (s *subscriptionEntry[T]) closeWithError(err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	for delivered := false; !delivered; {
+		select {
+		case s.errCh <- err:
+			delivered = true
+		default:
+			select {
+			case <-s.errCh:
+			default:
+			}
+		}
+	}
+	s.closeOnce.Do(func() {
+		s.closed = true
+		close(s.ch)
+		close(s.errCh)
+	})
+	return true
+}
+
 func makeIDSet(ids []string) map[string]struct{} {
 	if len(ids) == 0 {
 		return nil
@@ -131,3 +162,11 @@ func closeSubMap[T any](subs map[string]*subscriptionEntry[T]) {
 		delete(subs, key)
 	}
 }
+
+func pendingSubMap[T any](subs map[string]*subscriptionEntry[T]) int {
+	n := 0
+	for _, sub := range subs {
+		n += len(sub.ch)
+	}
+	return n
+}
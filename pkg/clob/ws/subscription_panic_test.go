@@ -241,11 +241,11 @@ func TestSubscriptionPanic_ConcurrentDispatchAndClose(t *testing.T) {
 
 	// Create multiple subscriptions
 	for i := 0; i < 10; i++ {
-		entry := &subscriptionEntry[PriceEvent]{
+		entry := &subscriptionEntry[PriceChangeEvent]{
 			id:      string(rune(i)),
 			channel: ChannelMarket,
 			event:   Price,
-			ch:      make(chan PriceEvent, 10),
+			ch:      make(chan PriceChangeEvent, 10),
 			errCh:   make(chan error, 5),
 		}
 		c.priceSubs[entry.id] = entry
@@ -266,7 +266,7 @@ func TestSubscriptionPanic_ConcurrentDispatchAndClose(t *testing.T) {
 			}()
 
 			for j := 0; j < 100; j++ {
-				event := PriceEvent{AssetID: "test", Price: "0.5"}
+				event := PriceEvent{PriceChanges: []PriceChangeEvent{{AssetId: "test", Price: "0.5"}}}
 				c.dispatchPrice(event)
 				time.Sleep(1 * time.Millisecond)
 			}
@@ -1,5 +1,12 @@
 package ws
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 // Event types.
 
 type EventType string
@@ -91,7 +98,7 @@ func NewMarketUnsubscribe(assetIDs []string) *SubscriptionRequest {
 func NewUserSubscription(markets []string) *SubscriptionRequest {
 	initial := true
 	return &SubscriptionRequest{
-		Type:        ChannelSubscribe,
+		Type:        ChannelUser,
 		Operation:   OperationSubscribe,
 		Markets:     markets,
 		InitialDump: &initial,
@@ -147,11 +154,20 @@ type PriceChangeEvent struct {
 	Price   string `json:"price"`
 	Side    string `json:"side"`
 	Size    string `json:"size"`
+	// Market and Timestamp are copied from the enclosing price_change message.
+	Market    string `json:"market,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
 }
 
+// MidpointEvent is a mid-price update. Server midpoint events match the REST
+// /midpoint endpoint. When synthetic midpoints are enabled (see
+// Client.WithSyntheticMidpoints), the client also derives one from each book
+// snapshot as (best bid + best ask) / 2; those events have Synthetic set and
+// may differ from REST, e.g. for one-sided or wide books.
 type MidpointEvent struct {
-	AssetID  string `json:"asset_id"`
-	Midpoint string `json:"midpoint"`
+	AssetID   string `json:"asset_id"`
+	Midpoint  string `json:"midpoint"`
+	Synthetic bool   `json:"-"`
 }
 
 type TickSizeChangeEvent struct {
@@ -181,6 +197,21 @@ type BestBidAskEvent struct {
 	Timestamp string `json:"timestamp,omitempty"`
 }
 
+// PriceSnapshot is the latest known price state for an asset, assembled from
+// price_change and best_bid_ask events.
+type PriceSnapshot struct {
+	AssetID   string
+	Market    string
+	Price     string
+	Side      string
+	Size      string
+	BestBid   string
+	BestAsk   string
+	Spread    string
+	Timestamp string
+	UpdatedAt time.Time
+}
+
 type EventMessage struct {
 	ID          string `json:"id"`
 	Ticker      string `json:"ticker"`
@@ -215,17 +246,62 @@ type MarketResolvedEvent struct {
 	Timestamp      string        `json:"timestamp,omitempty"`
 }
 
+// TradeEvent is a fill on the user channel. It is pushed when one of the
+// account's orders is matched and again on each status change (MATCHED,
+// MINED, CONFIRMED, RETRYING, FAILED).
 type TradeEvent struct {
-	AssetID   string `json:"asset_id"`
-	Price     string `json:"price"`
-	Size      string `json:"size"`
-	Side      string `json:"side"`
-	Timestamp string `json:"timestamp"`
-	ID        string `json:"id,omitempty"`
-	Market    string `json:"market,omitempty"`
-	Status    string `json:"status,omitempty"`
+	ID           string            `json:"id,omitempty"`
+	AssetID      string            `json:"asset_id"`
+	Market       string            `json:"market,omitempty"`
+	Outcome      string            `json:"outcome,omitempty"`
+	Price        string            `json:"price"`
+	Size         string            `json:"size"`
+	Side         string            `json:"side"`
+	Status       string            `json:"status,omitempty"`
+	Type         string            `json:"type,omitempty"` // TRADE
+	EventType    string            `json:"event_type,omitempty"`
+	Owner        string            `json:"owner,omitempty"`
+	TradeOwner   string            `json:"trade_owner,omitempty"`
+	TraderSide   string            `json:"trader_side,omitempty"` // MAKER or TAKER
+	TakerOrderID string            `json:"taker_order_id,omitempty"`
+	MakerOrders  []TradeMakerOrder `json:"maker_orders,omitempty"`
+	FeeRateBps   string            `json:"fee_rate_bps,omitempty"`
+	MatchTime    string            `json:"matchtime,omitempty"`
+	LastUpdate   string            `json:"last_update,omitempty"`
+	Timestamp    string            `json:"timestamp"`
 }
 
+// TradeMakerOrder is a resting order filled by a trade.
+type TradeMakerOrder struct {
+	OrderID       string `json:"order_id"`
+	AssetID       string `json:"asset_id"`
+	Owner         string `json:"owner,omitempty"`
+	MakerAddress  string `json:"maker_address,omitempty"`
+	Outcome       string `json:"outcome,omitempty"`
+	Price         string `json:"price"`
+	MatchedAmount string `json:"matched_amount"`
+	FeeRateBps    string `json:"fee_rate_bps,omitempty"`
+	Side          string `json:"side,omitempty"`
+}
+
+// Order update types carried in OrderEvent.Type.
+const (
+	OrderEventPlacement    = "PLACEMENT"
+	OrderEventUpdate       = "UPDATE"
+	OrderEventCancellation = "CANCELLATION"
+)
+
+// Order statuses carried in OrderEvent.Status.
+const (
+	OrderStatusLive     = "LIVE"
+	OrderStatusMatched  = "MATCHED"
+	OrderStatusCanceled = "CANCELED"
+)
+
+// OrderEvent is an update to one of the account's orders on the user channel.
+// It is pushed when the order is placed, partially or fully matched, and
+// cancelled. Sizes and prices are decimal strings; timestamps are unix
+// seconds.
 type OrderEvent struct {
 	ID              string   `json:"id"`
 	AssetID         string   `json:"asset_id"`
@@ -247,3 +323,31 @@ type OrderEvent struct {
 	AssociateTrades []string `json:"associate_trades"`
 	EventType       string   `json:"event_type"`
 }
+
+// RemainingSize returns the unfilled size, OriginalSize minus SizeMatched.
+func (e OrderEvent) RemainingSize() (decimal.Decimal, error) {
+	original, err := decimal.NewFromString(e.OriginalSize)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	matched := decimal.Zero
+	if e.SizeMatched != "" {
+		if matched, err = decimal.NewFromString(e.SizeMatched); err != nil {
+			return decimal.Zero, err
+		}
+	}
+	return original.Sub(matched), nil
+}
+
+// Time returns Timestamp as a time.Time, or the zero time if it is not set.
+// Millisecond timestamps are accepted as well.
+func (e OrderEvent) Time() time.Time {
+	ts, err := strconv.ParseInt(e.Timestamp, 10, 64)
+	if err != nil || ts <= 0 {
+		return time.Time{}
+	}
+	if ts > 1e12 {
+		return time.UnixMilli(ts)
+	}
+	return time.Unix(ts, 0)
+}
@@ -0,0 +1,104 @@
+// Package config holds the contract addresses of every Polymarket deployment,
+// keyed by chain ID. Other packages (auth, ctf, clob) resolve addresses
+// through Lookup instead of hardcoding them.
+package config
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Chain IDs of the supported deployments.
+const (
+	PolygonChainID int64 = 137
+	AmoyChainID    int64 = 80002
+)
+
+// Exchange contract addresses, as hex strings so they can be constants. The
+// Polygon and Amoy configs below are built from them.
+const (
+	PolygonExchangeAddress        = "0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"
+	PolygonNegRiskExchangeAddress = "0xC5d563A36AE78145C45a50134d48A1215220f80a"
+	AmoyExchangeAddress           = "0xdFE02Eb6733538f8Ea35D585af8DE5958AD99E40"
+	AmoyNegRiskExchangeAddress    = "0xC5d563A36AE78145C45a50134d48A1215220f80a"
+)
+
+// ChainConfig lists the contracts of a Polymarket deployment.
+type ChainConfig struct {
+	ChainID int64
+	// Exchange is the CTF exchange that verifies binary market orders.
+	Exchange common.Address
+	// NegRiskExchange is the exchange that verifies neg-risk market orders.
+	NegRiskExchange common.Address
+	// NegRiskAdapter wraps conditional tokens for neg-risk markets.
+	NegRiskAdapter common.Address
+	// ConditionalTokens is the Gnosis CTF contract holding outcome tokens.
+	ConditionalTokens common.Address
+	// Collateral is the USDC token used as collateral.
+	Collateral common.Address
+	// ProxyFactory deploys Polymarket proxy (Magic/email) wallets. It is nil
+	// on chains without proxy wallet support.
+	ProxyFactory *common.Address
+	// SafeFactory deploys Gnosis Safe wallets.
+	SafeFactory common.Address
+	// Multicall3 is the Multicall3 aggregator.
+	Multicall3 common.Address
+}
+
+// Exchanges returns the exchange and neg-risk exchange, the contracts an
+// order may be signed for and the spenders that need collateral approval.
+func (c ChainConfig) Exchanges() []common.Address {
+	return []common.Address{c.Exchange, c.NegRiskExchange}
+}
+
+// Polygon is the Polygon mainnet deployment.
+var Polygon = ChainConfig{
+	ChainID:           PolygonChainID,
+	Exchange:          common.HexToAddress(PolygonExchangeAddress),
+	NegRiskExchange:   common.HexToAddress(PolygonNegRiskExchangeAddress),
+	NegRiskAdapter:    common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
+	ConditionalTokens: common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045"),
+	Collateral:        common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"),
+	ProxyFactory:      ptrAddress(common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052")),
+	SafeFactory:       common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
+	Multicall3:        common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+}
+
+// Amoy is the Polygon Amoy testnet deployment.
+var Amoy = ChainConfig{
+	ChainID:           AmoyChainID,
+	Exchange:          common.HexToAddress(AmoyExchangeAddress),
+	NegRiskExchange:   common.HexToAddress(AmoyNegRiskExchangeAddress),
+	NegRiskAdapter:    common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296"),
+	ConditionalTokens: common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
+	Collateral:        common.HexToAddress("0x9c4e1703476e875070ee25b56a58b008cfb8fa78"),
+	SafeFactory:       common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
+	Multicall3:        common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+}
+
+var chains = map[int64]ChainConfig{
+	PolygonChainID: Polygon,
+	AmoyChainID:    Amoy,
+}
+
+// Lookup returns the deployment for chainID. ok is false for chains without
+// a Polymarket deployment.
+func Lookup(chainID int64) (ChainConfig, bool) {
+	cfg, ok := chains[chainID]
+	return cfg, ok
+}
+
+// ChainIDs returns the IDs of all supported chains in ascending order.
+func ChainIDs() []int64 {
+	ids := make([]int64, 0, len(chains))
+	for id := range chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func ptrAddress(addr common.Address) *common.Address {
+	return &addr
+}
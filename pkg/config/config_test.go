@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestLookup(t *testing.T) {
+	for _, id := range ChainIDs() {
+		cfg, ok := Lookup(id)
+		if !ok {
+			t.Fatalf("Lookup(%d) failed", id)
+		}
+		if cfg.ChainID != id {
+			t.Errorf("Lookup(%d).ChainID = %d", id, cfg.ChainID)
+		}
+		if cfg.Exchange == cfg.NegRiskExchange || cfg.Exchange == (common.Address{}) || cfg.Collateral == (common.Address{}) {
+			t.Errorf("chain %d has incomplete addresses: %+v", id, cfg)
+		}
+	}
+	if ids := ChainIDs(); len(ids) != 2 || ids[0] != PolygonChainID || ids[1] != AmoyChainID {
+		t.Errorf("ChainIDs() = %v", ids)
+	}
+	if _, ok := Lookup(1); ok {
+		t.Error("expected no deployment for chain 1")
+	}
+	if Polygon.ProxyFactory == nil || Amoy.ProxyFactory != nil {
+		t.Error("proxy wallets are only supported on Polygon")
+	}
+}
@@ -0,0 +1,129 @@
+package ctf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// fakeBackend records sent transactions and mines them immediately. Calls
+// are answered by call when set.
+type fakeBackend struct {
+	sent []*types.Transaction
+	call func(ethereum.CallMsg) ([]byte, error)
+}
+
+func (b *fakeBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeBackend) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if b.call != nil {
+		return b.call(msg)
+	}
+	return nil, nil
+}
+
+func (b *fakeBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 60000, nil
+}
+
+func (b *fakeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(30_000_000_000), nil
+}
+
+func (b *fakeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1_000_000_000), nil
+}
+
+func (b *fakeBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1)}, nil
+}
+
+func (b *fakeBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return uint64(len(b.sent)), nil
+}
+
+func (b *fakeBackend) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error { <-quit; return nil }), nil
+}
+
+func (b *fakeBackend) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	return &types.Receipt{TxHash: hash, BlockNumber: big.NewInt(42), Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func TestSetUSDCAllowance(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(PolygonChainID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &fakeBackend{}
+	client, err := NewClientWithBackend(backend, opts, PolygonChainID)
+	if err != nil {
+		t.Fatalf("NewClientWithBackend failed: %v", err)
+	}
+
+	receipt, err := client.SetUSDCAllowance(context.Background(), PolygonExchange, MaxAllowance)
+	if err != nil {
+		t.Fatalf("SetUSDCAllowance failed: %v", err)
+	}
+	if receipt.BlockNumber != 42 || len(backend.sent) != 1 || receipt.TransactionHash != backend.sent[0].Hash() {
+		t.Fatalf("unexpected receipt %+v (sent %d)", receipt, len(backend.sent))
+	}
+
+	tx := backend.sent[0]
+	if tx.To() == nil || *tx.To() != PolygonUSDC {
+		t.Fatalf("approve sent to %v, want %s", tx.To(), PolygonUSDC.Hex())
+	}
+	data := tx.Data()
+	selector := crypto.Keccak256([]byte("approve(address,uint256)"))[:4]
+	if len(data) != 68 || !bytes.Equal(data[:4], selector) {
+		t.Fatalf("unexpected calldata %x", data)
+	}
+	if common.BytesToAddress(data[4:36]) != PolygonExchange {
+		t.Errorf("spender = %x", data[4:36])
+	}
+	if new(big.Int).SetBytes(data[36:]).Cmp(MaxAllowance) != 0 {
+		t.Errorf("amount = %x", data[36:])
+	}
+}
+
+func TestSetUSDCAllowanceValidation(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient()
+	if _, err := client.SetUSDCAllowance(ctx, PolygonExchange, nil); !errors.Is(err, ErrMissingU256Value) {
+		t.Errorf("expected ErrMissingU256Value, got %v", err)
+	}
+	if _, err := client.SetUSDCAllowance(ctx, common.Address{}, big.NewInt(1)); err == nil {
+		t.Error("expected error for zero spender")
+	}
+	if _, err := client.SetUSDCAllowance(ctx, PolygonNegRiskExchange, big.NewInt(1)); !errors.Is(err, ErrMissingBackend) {
+		t.Errorf("expected ErrMissingBackend, got %v", err)
+	}
+}
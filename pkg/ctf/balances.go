@@ -0,0 +1,101 @@
+package ctf
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	multicall3ABI = `[{"inputs":[{"internalType":"bool","name":"requireSuccess","type":"bool"},{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call[]","name":"calls","type":"tuple[]"}],"name":"tryBlockAndAggregate","outputs":[{"internalType":"uint256","name":"blockNumber","type":"uint256"},{"internalType":"bytes32","name":"blockHash","type":"bytes32"},{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+	balanceABI    = `[{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address[]","name":"accounts","type":"address[]"},{"internalType":"uint256[]","name":"ids","type":"uint256[]"}],"name":"balanceOfBatch","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"}]`
+)
+
+// multicallCall and multicallResult mirror the Multicall3 Call and Result
+// tuples.
+type multicallCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Balances reads the USDC balance of wallet and its conditional token
+// balances for tokenIDs in a single Multicall3 call, so every value comes
+// from the same block. wallet is usually a proxy or Safe address derived
+// with the auth package. Balances are in base units (USDC has 6 decimals)
+// and keyed by the decimal token ID.
+func (c *clientImpl) Balances(ctx context.Context, wallet common.Address, tokenIDs []*big.Int) (BalancesResponse, error) {
+	if c.backend == nil || c.multicall == nil {
+		return BalancesResponse{}, ErrMissingBackend
+	}
+	if wallet == (common.Address{}) {
+		return BalancesResponse{}, fmt.Errorf("wallet is required")
+	}
+	for _, id := range tokenIDs {
+		if id == nil {
+			return BalancesResponse{}, ErrMissingU256Value
+		}
+	}
+
+	usdcData, err := c.balances.Pack("balanceOf", wallet)
+	if err != nil {
+		return BalancesResponse{}, fmt.Errorf("pack balanceOf: %w", err)
+	}
+	calls := []multicallCall{{Target: c.config.Collateral, CallData: usdcData}}
+	if len(tokenIDs) > 0 {
+		accounts := make([]common.Address, len(tokenIDs))
+		for i := range accounts {
+			accounts[i] = wallet
+		}
+		batchData, err := c.balances.Pack("balanceOfBatch", accounts, tokenIDs)
+		if err != nil {
+			return BalancesResponse{}, fmt.Errorf("pack balanceOfBatch: %w", err)
+		}
+		calls = append(calls, multicallCall{Target: c.config.ConditionalTokens, CallData: batchData})
+	}
+
+	var out []interface{}
+	if err := c.multicall.Call(&bind.CallOpts{Context: ctx}, &out, "tryBlockAndAggregate", true, calls); err != nil {
+		return BalancesResponse{}, fmt.Errorf("call multicall: %w", err)
+	}
+	if len(out) != 3 {
+		return BalancesResponse{}, fmt.Errorf("unexpected multicall output length %d", len(out))
+	}
+	blockNumber, _ := out[0].(*big.Int)
+	results := *abi.ConvertType(out[2], new([]multicallResult)).(*[]multicallResult)
+	if len(results) != len(calls) {
+		return BalancesResponse{}, fmt.Errorf("multicall returned %d results for %d calls", len(results), len(calls))
+	}
+
+	resp := BalancesResponse{Wallet: wallet, Tokens: make(map[string]*big.Int, len(tokenIDs))}
+	if blockNumber != nil {
+		resp.BlockNumber = blockNumber.Uint64()
+	}
+	usdc, err := c.balances.Unpack("balanceOf", results[0].ReturnData)
+	if err != nil || len(usdc) != 1 {
+		return BalancesResponse{}, fmt.Errorf("decode balanceOf: %v", err)
+	}
+	resp.USDC, _ = usdc[0].(*big.Int)
+	if len(tokenIDs) > 0 {
+		batch, err := c.balances.Unpack("balanceOfBatch", results[1].ReturnData)
+		if err != nil || len(batch) != 1 {
+			return BalancesResponse{}, fmt.Errorf("decode balanceOfBatch: %v", err)
+		}
+		amounts, _ := batch[0].([]*big.Int)
+		if len(amounts) != len(tokenIDs) {
+			return BalancesResponse{}, fmt.Errorf("balanceOfBatch returned %d balances for %d tokens", len(amounts), len(tokenIDs))
+		}
+		for i, id := range tokenIDs {
+			resp.Tokens[id.String()] = amounts[i]
+		}
+	}
+	return resp, nil
+}
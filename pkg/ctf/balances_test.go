@@ -0,0 +1,82 @@
+package ctf
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBalances(t *testing.T) {
+	multicallABI, _ := abi.JSON(strings.NewReader(multicall3ABI))
+	balances, _ := abi.JSON(strings.NewReader(balanceABI))
+	wallet := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tokenIDs := []*big.Int{big.NewInt(11), new(big.Int).Lsh(big.NewInt(1), 200)}
+
+	backend := &fakeBackend{call: func(msg ethereum.CallMsg) ([]byte, error) {
+		if msg.To == nil || *msg.To != Multicall3 {
+			t.Fatalf("call sent to %v, want Multicall3", msg.To)
+		}
+		method := multicallABI.Methods["tryBlockAndAggregate"]
+		args, err := method.Inputs.Unpack(msg.Data[4:])
+		if err != nil {
+			t.Fatalf("unpack multicall input: %v", err)
+		}
+		calls := *abi.ConvertType(args[1], new([]multicallCall)).(*[]multicallCall)
+		if len(calls) != 2 || calls[0].Target != PolygonUSDC || calls[1].Target != PolygonConditionalTokens {
+			t.Fatalf("unexpected calls: %+v", calls)
+		}
+		batchArgs, err := balances.Methods["balanceOfBatch"].Inputs.Unpack(calls[1].CallData[4:])
+		if err != nil {
+			t.Fatalf("unpack balanceOfBatch: %v", err)
+		}
+		if accounts := batchArgs[0].([]common.Address); len(accounts) != 2 || accounts[1] != wallet {
+			t.Errorf("accounts = %v", accounts)
+		}
+
+		usdc, _ := balances.Methods["balanceOf"].Outputs.Pack(big.NewInt(1_500_000))
+		batch, _ := balances.Methods["balanceOfBatch"].Outputs.Pack([]*big.Int{big.NewInt(7), big.NewInt(0)})
+		return method.Outputs.Pack(big.NewInt(123), [32]byte{}, []multicallResult{
+			{Success: true, ReturnData: usdc},
+			{Success: true, ReturnData: batch},
+		})
+	}}
+	client, err := NewClientWithBackend(backend, nil, PolygonChainID)
+	if err != nil {
+		t.Fatalf("NewClientWithBackend failed: %v", err)
+	}
+
+	resp, err := client.Balances(context.Background(), wallet, tokenIDs)
+	if err != nil {
+		t.Fatalf("Balances failed: %v", err)
+	}
+	if resp.BlockNumber != 123 || resp.Wallet != wallet || resp.USDC.Int64() != 1_500_000 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Tokens["11"].Int64() != 7 || resp.Tokens[tokenIDs[1].String()].Sign() != 0 {
+		t.Errorf("tokens = %v", resp.Tokens)
+	}
+}
+
+func TestBalancesValidation(t *testing.T) {
+	ctx := context.Background()
+	wallet := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	if _, err := NewClient().Balances(ctx, wallet, nil); !errors.Is(err, ErrMissingBackend) {
+		t.Errorf("expected ErrMissingBackend, got %v", err)
+	}
+	client, err := NewClientWithBackend(&fakeBackend{}, nil, PolygonChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Balances(ctx, common.Address{}, nil); err == nil {
+		t.Error("expected error for zero wallet")
+	}
+	if _, err := client.Balances(ctx, wallet, []*big.Int{nil}); !errors.Is(err, ErrMissingU256Value) {
+		t.Errorf("expected ErrMissingU256Value, got %v", err)
+	}
+}
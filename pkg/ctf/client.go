@@ -1,6 +1,11 @@
 package ctf
 
-import "context"
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // Client defines the CTF interface.
 type Client interface {
@@ -14,4 +19,11 @@ type Client interface {
 	MergePositions(ctx context.Context, req *MergePositionsRequest) (MergePositionsResponse, error)
 	RedeemPositions(ctx context.Context, req *RedeemPositionsRequest) (RedeemPositionsResponse, error)
 	RedeemNegRisk(ctx context.Context, req *RedeemNegRiskRequest) (RedeemNegRiskResponse, error)
+	// SetUSDCAllowance approves spender (e.g. PolygonExchange or PolygonNegRiskExchange)
+	// to transfer up to amount of the chain's USDC collateral on behalf of the transactor.
+	SetUSDCAllowance(ctx context.Context, spender common.Address, amount *big.Int) (*Receipt, error)
+
+	// Balances reads the USDC and conditional token balances of wallet in a
+	// single multicall. It requires a backend but no transactor.
+	Balances(ctx context.Context, wallet common.Address, tokenIDs []*big.Int) (BalancesResponse, error)
 }
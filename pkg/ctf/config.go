@@ -1,45 +1,57 @@
 package ctf
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/config"
+)
 
 // Chain IDs.
 const (
-	PolygonChainID int64 = 137
-	AmoyChainID    int64 = 80002
+	PolygonChainID = config.PolygonChainID
+	AmoyChainID    = config.AmoyChainID
+)
+
+// Contract addresses, taken from pkg/config. The exchange and neg-risk
+// exchange are the spenders that must be approved for USDC (see
+// Client.SetUSDCAllowance) before trading.
+var (
+	PolygonUSDC              = config.Polygon.Collateral
+	PolygonConditionalTokens = config.Polygon.ConditionalTokens
+	PolygonExchange          = config.Polygon.Exchange
+	PolygonNegRiskExchange   = config.Polygon.NegRiskExchange
+	PolygonNegRiskAdapter    = config.Polygon.NegRiskAdapter
+	AmoyUSDC                 = config.Amoy.Collateral
+	AmoyConditionalTokens    = config.Amoy.ConditionalTokens
+	AmoyExchange             = config.Amoy.Exchange
+	AmoyNegRiskExchange      = config.Amoy.NegRiskExchange
+	AmoyNegRiskAdapter       = config.Amoy.NegRiskAdapter
+
+	// Multicall3 is deployed at the same address on Polygon and Amoy.
+	Multicall3 = config.Polygon.Multicall3
 )
 
 type contractConfig struct {
 	ConditionalTokens common.Address
+	Collateral        common.Address
 	NegRiskAdapter    *common.Address
-}
-
-var contractConfigs = map[int64]contractConfig{
-	PolygonChainID: {
-		ConditionalTokens: common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045"),
-	},
-	AmoyChainID: {
-		ConditionalTokens: common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
-	},
-}
-
-var negRiskConfigs = map[int64]contractConfig{
-	PolygonChainID: {
-		ConditionalTokens: common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045"),
-		NegRiskAdapter:    ptrAddress(common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296")),
-	},
-	AmoyChainID: {
-		ConditionalTokens: common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
-		NegRiskAdapter:    ptrAddress(common.HexToAddress("0xd91E80cF2E7be2e162c6513ceD06f1dD0dA35296")),
-	},
+	Multicall3        common.Address
 }
 
 func resolveConfig(chainID int64, negRisk bool) (contractConfig, bool) {
+	chain, ok := config.Lookup(chainID)
+	if !ok {
+		return contractConfig{}, false
+	}
+	cfg := contractConfig{
+		ConditionalTokens: chain.ConditionalTokens,
+		Collateral:        chain.Collateral,
+		Multicall3:        chain.Multicall3,
+	}
 	if negRisk {
-		cfg, ok := negRiskConfigs[chainID]
-		return cfg, ok
+		cfg.NegRiskAdapter = ptrAddress(chain.NegRiskAdapter)
 	}
-	cfg, ok := contractConfigs[chainID]
-	return cfg, ok
+	return cfg, true
 }
 
 func ptrAddress(addr common.Address) *common.Address {
@@ -16,6 +16,7 @@ import (
 
 const (
 	conditionalTokensABI = `[{"inputs":[{"internalType":"address","name":"oracle","type":"address"},{"internalType":"bytes32","name":"questionId","type":"bytes32"},{"internalType":"uint256","name":"outcomeSlotCount","type":"uint256"}],"name":"prepareCondition","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"collateralToken","type":"address"},{"internalType":"bytes32","name":"parentCollectionId","type":"bytes32"},{"internalType":"bytes32","name":"conditionId","type":"bytes32"},{"internalType":"uint256[]","name":"partition","type":"uint256[]"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"splitPosition","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"collateralToken","type":"address"},{"internalType":"bytes32","name":"parentCollectionId","type":"bytes32"},{"internalType":"bytes32","name":"conditionId","type":"bytes32"},{"internalType":"uint256[]","name":"partition","type":"uint256[]"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"mergePositions","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"collateralToken","type":"address"},{"internalType":"bytes32","name":"parentCollectionId","type":"bytes32"},{"internalType":"bytes32","name":"conditionId","type":"bytes32"},{"internalType":"uint256[]","name":"indexSets","type":"uint256[]"}],"name":"redeemPositions","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	erc20ABI             = `[{"inputs":[{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"approve","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
 	negRiskAdapterABI    = `[{"inputs":[{"internalType":"bytes32","name":"conditionId","type":"bytes32"},{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"name":"redeemPositions","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
 )
 
@@ -34,8 +35,16 @@ type clientImpl struct {
 	txOpts            *bind.TransactOpts
 	conditionalTokens *bind.BoundContract
 	negRiskAdapter    *bind.BoundContract
+	collateral        *bind.BoundContract
+	multicall         *bind.BoundContract
+	balances          abi.ABI
+	config            contractConfig
 }
 
+// MaxAllowance is the largest ERC20 allowance (2^256 - 1), commonly used for
+// unlimited approvals.
+var MaxAllowance = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
 // NewClient creates a lightweight CTF client for ID calculations.
 // Transaction methods require a backend and transactor.
 func NewClient() Client {
@@ -75,11 +84,31 @@ func newClientWithConfig(backend Backend, txOpts *bind.TransactOpts, chainID int
 		neg = bind.NewBoundContract(*cfg.NegRiskAdapter, negABI, backend, backend, backend)
 	}
 
+	erc20, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse erc20 ABI: %w", err)
+	}
+	collateral := bind.NewBoundContract(cfg.Collateral, erc20, backend, backend, backend)
+
+	multicallABI, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse multicall ABI: %w", err)
+	}
+	multicall := bind.NewBoundContract(cfg.Multicall3, multicallABI, backend, backend, backend)
+	balances, err := abi.JSON(strings.NewReader(balanceABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse balance ABI: %w", err)
+	}
+
 	return &clientImpl{
 		backend:           backend,
 		txOpts:            txOpts,
 		conditionalTokens: contract,
 		negRiskAdapter:    neg,
+		collateral:        collateral,
+		multicall:         multicall,
+		balances:          balances,
+		config:            cfg,
 	}, nil
 }
 
@@ -206,6 +235,23 @@ func (c *clientImpl) RedeemNegRisk(ctx context.Context, req *RedeemNegRiskReques
 	return RedeemNegRiskResponse{TransactionHash: tx.Hash, BlockNumber: tx.BlockNumber}, nil
 }
 
+func (c *clientImpl) SetUSDCAllowance(ctx context.Context, spender common.Address, amount *big.Int) (*Receipt, error) {
+	if amount == nil {
+		return nil, ErrMissingU256Value
+	}
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("amount must be non-negative")
+	}
+	if spender == (common.Address{}) {
+		return nil, fmt.Errorf("spender is required")
+	}
+	tx, err := c.transact(ctx, c.collateral, "approve", spender, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &Receipt{TransactionHash: tx.Hash, BlockNumber: tx.BlockNumber}, nil
+}
+
 type txResult struct {
 	Hash        common.Hash
 	BlockNumber uint64
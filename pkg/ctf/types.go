@@ -89,4 +89,18 @@ type (
 		TransactionHash common.Hash
 		BlockNumber     uint64
 	}
+	// BalancesResponse holds on-chain balances read at BlockNumber. USDC and
+	// Tokens are in base units; Tokens is keyed by the decimal token ID.
+	BalancesResponse struct {
+		Wallet      common.Address
+		BlockNumber uint64
+		USDC        *big.Int
+		Tokens      map[string]*big.Int
+	}
 )
+
+// Receipt identifies a mined transaction.
+type Receipt struct {
+	TransactionHash common.Hash
+	BlockNumber     uint64
+}
@@ -1,16 +1,30 @@
 package data
 
-import "context"
+import (
+	"context"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // Client defines the Data API interface.
 type Client interface {
+	// WithAuth returns a client that signs its requests with L2 headers from
+	// signer and apiKey. The receiver is left unauthenticated.
+	WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client
 	Health(ctx context.Context) (string, error)
 	Positions(ctx context.Context, req *PositionsRequest) (PositionsResponse, error)
+	PositionsAll(ctx context.Context, req *PositionsRequest) (PositionsResponse, error)
 	Trades(ctx context.Context, req *TradesRequest) (TradesResponse, error)
+	TradesAll(ctx context.Context, req *TradesRequest) (TradesResponse, error)
 	Activity(ctx context.Context, req *ActivityRequest) (ActivityResponse, error)
 	Holders(ctx context.Context, req *HoldersRequest) (HoldersResponse, error)
 	Value(ctx context.Context, req *ValueRequest) (ValueResponse, error)
 	ClosedPositions(ctx context.Context, req *ClosedPositionsRequest) (ClosedPositionsResponse, error)
+	// Portfolio fetches the open positions, closed positions and value of
+	// user concurrently and totals their exposure and PnL. When an endpoint
+	// fails the rest of the portfolio is still returned, marked Partial.
+	Portfolio(ctx context.Context, user common.Address) (Portfolio, error)
 	Traded(ctx context.Context, req *TradedRequest) (TradedResponse, error)
 	OpenInterest(ctx context.Context, req *OpenInterestRequest) (OpenInterestResponse, error)
 	LiveVolume(ctx context.Context, req *LiveVolumeRequest) (LiveVolumeResponse, error)
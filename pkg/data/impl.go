@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
@@ -38,6 +39,13 @@ func NewClient(httpClient *transport.Client) Client {
 	return &clientImpl{httpClient: httpClient}
 }
 
+func (c *clientImpl) WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client {
+	// Clone the transport so the receiver keeps making public calls.
+	httpClient := c.httpClient.CloneWithBaseURL(c.httpClient.BaseURL())
+	httpClient.SetAuth(signer, apiKey)
+	return &clientImpl{httpClient: httpClient}
+}
+
 func (c *clientImpl) Health(ctx context.Context) (string, error) {
 	var resp HealthResponse
 	if err := c.httpClient.Get(ctx, "", nil, &resp); err != nil {
@@ -85,6 +93,19 @@ func (c *clientImpl) Positions(ctx context.Context, req *PositionsRequest) (Posi
 	return resp, err
 }
 
+func (c *clientImpl) PositionsAll(ctx context.Context, req *PositionsRequest) (PositionsResponse, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	pageSize, start := streamBounds(req.Limit, req.Offset)
+	return collectStream(StreamWithPageSize(ctx, start, pageSize, func(ctx context.Context, offset int) ([]Position, error) {
+		nextReq := *req
+		nextReq.Limit = &pageSize
+		nextReq.Offset = &offset
+		return c.Positions(ctx, &nextReq)
+	}))
+}
+
 func (c *clientImpl) Trades(ctx context.Context, req *TradesRequest) (TradesResponse, error) {
 	if req == nil {
 		return nil, ErrMissingRequest
@@ -113,6 +134,19 @@ func (c *clientImpl) Trades(ctx context.Context, req *TradesRequest) (TradesResp
 	return resp, err
 }
 
+func (c *clientImpl) TradesAll(ctx context.Context, req *TradesRequest) (TradesResponse, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	pageSize, start := streamBounds(req.Limit, req.Offset)
+	return collectStream(StreamWithPageSize(ctx, start, pageSize, func(ctx context.Context, offset int) ([]Trade, error) {
+		nextReq := *req
+		nextReq.Limit = &pageSize
+		nextReq.Offset = &offset
+		return c.Trades(ctx, &nextReq)
+	}))
+}
+
 func (c *clientImpl) Activity(ctx context.Context, req *ActivityRequest) (ActivityResponse, error) {
 	if req == nil {
 		return nil, ErrMissingRequest
@@ -175,6 +209,9 @@ func (c *clientImpl) Value(ctx context.Context, req *ValueRequest) (ValueRespons
 	if req == nil {
 		return nil, ErrMissingRequest
 	}
+	if req.User == (common.Address{}) {
+		return nil, ErrMissingUser
+	}
 	q := url.Values{}
 	q.Set("user", req.User.Hex())
 	addHashSlice(q, "market", req.Markets)
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -212,6 +213,53 @@ func TestOpenInterestSuccess(t *testing.T) {
 	}
 }
 
+func TestValueQueryAndDecoding(t *testing.T) {
+	user := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	market := common.HexToHash("0x01")
+	key := "/value?market=" + market.Hex() + "&user=" + user.Hex()
+	doer := &staticDoer{responses: map[string]string{
+		key: `[{"user":"` + user.Hex() + `","value":1234.56}]`,
+	}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	resp, err := client.Value(context.Background(), &ValueRequest{User: user, Markets: []common.Hash{market}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp) != 1 || resp[0].User != user || resp[0].Value.String() != "1234.56" {
+		t.Errorf("unexpected value response: %+v", resp)
+	}
+}
+
+func TestValueMissingUser(t *testing.T) {
+	client := NewClient(transport.NewClient(&staticDoer{}, "http://example"))
+	if _, err := client.Value(context.Background(), &ValueRequest{}); !errors.Is(err, ErrMissingUser) {
+		t.Fatalf("expected ErrMissingUser, got %v", err)
+	}
+}
+
+func TestOpenInterestQueryAndDecoding(t *testing.T) {
+	m1 := common.HexToHash("0x01")
+	m2 := common.HexToHash("0x02")
+	key := "/oi?market=" + m1.Hex() + "%2C" + m2.Hex()
+	doer := &staticDoer{responses: map[string]string{
+		key: `[{"market":"` + m1.Hex() + `","value":100.5},{"market":"GLOBAL","value":9000}]`,
+	}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	resp, err := client.OpenInterest(context.Background(), &OpenInterestRequest{Markets: []common.Hash{m1, m2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(resp))
+	}
+	if resp[0].Market.ID != m1 || resp[0].Value.String() != "100.5" {
+		t.Errorf("unexpected market entry: %+v", resp[0])
+	}
+	if !resp[1].Market.Global {
+		t.Errorf("expected global entry, got %+v", resp[1])
+	}
+}
+
 func TestHoldersSuccess(t *testing.T) {
 	doer := &staticDoer{responses: map[string]string{
 		"/holders": `[]`,
@@ -226,6 +274,37 @@ func TestHoldersSuccess(t *testing.T) {
 	}
 }
 
+func TestHoldersQueryAndDecoding(t *testing.T) {
+	m1 := common.HexToHash("0x01")
+	m2 := common.HexToHash("0x02")
+	key := "/holders?limit=5&market=" + m1.Hex() + "%2C" + m2.Hex() + "&minBalance=10"
+	doer := &staticDoer{responses: map[string]string{
+		key: `[{"token":"123","holders":[{"proxyWallet":"0x00000000000000000000000000000000000000aa","asset":"123","amount":1500.5,"outcomeIndex":0,"name":"whale"}]},{"token":"456","holders":[]}]`,
+	}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	resp, err := client.Holders(context.Background(), &HoldersRequest{
+		Markets:    []common.Hash{m1, m2},
+		Limit:      intPtr(5),
+		MinBalance: intPtr(10),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 token groups, got %d", len(resp))
+	}
+	if resp[0].Token.String() != "123" || len(resp[0].Holders) != 1 {
+		t.Fatalf("unexpected first group: %+v", resp[0])
+	}
+	holder := resp[0].Holders[0]
+	if holder.ProxyWallet != common.HexToAddress("0xaa") || holder.Amount.String() != "1500.5" {
+		t.Errorf("unexpected holder: %+v", holder)
+	}
+	if holder.Name == nil || *holder.Name != "whale" {
+		t.Errorf("expected holder name to decode")
+	}
+}
+
 func TestBuildersVolumeSuccess(t *testing.T) {
 	doer := &staticDoer{responses: map[string]string{
 		"/v1/builders/volume": `[{"builder":"test","volume":"100"}]`,
@@ -593,3 +672,93 @@ func TestTradeFilterConstructors(t *testing.T) {
 		t.Errorf("expected TOKENS, got %s", tokens.FilterType)
 	}
 }
+
+func TestTradesAllWalksOffsets(t *testing.T) {
+	doer := &staticDoer{responses: map[string]string{
+		"/trades?limit=2&offset=0&side=": `[{"side":"BUY","title":"a"},{"side":"BUY","title":"b"}]`,
+		"/trades?limit=2&offset=2&side=": `[{"side":"SELL","title":"c"}]`,
+	}}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	resp, err := client.TradesAll(context.Background(), &TradesRequest{Limit: intPtr(2)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp) != 3 || resp[2].Title != "c" {
+		t.Errorf("unexpected trades: %+v", resp)
+	}
+}
+
+func TestPositionsAllNilRequest(t *testing.T) {
+	client := NewClient(transport.NewClient(&staticDoer{responses: map[string]string{}}, "http://example"))
+	if _, err := client.PositionsAll(context.Background(), nil); !errors.Is(err, ErrMissingRequest) {
+		t.Errorf("expected ErrMissingRequest, got %v", err)
+	}
+}
+
+func TestStreamStopsOnEmptyPage(t *testing.T) {
+	pages := [][]int{{1, 2}, {3}, {}}
+	var offsets []int
+	fetch := func(ctx context.Context, offset int) ([]int, error) {
+		offsets = append(offsets, offset)
+		return pages[len(offsets)-1], nil
+	}
+	var got []int
+	for res := range Stream(context.Background(), fetch) {
+		if res.Err != nil {
+			t.Fatalf("unexpected error: %v", res.Err)
+		}
+		got = append(got, res.Item)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 items, got %v", got)
+	}
+	if len(offsets) != 3 || offsets[1] != 2 || offsets[2] != 3 {
+		t.Errorf("unexpected offsets: %v", offsets)
+	}
+}
+
+func TestStreamPropagatesError(t *testing.T) {
+	fetch := func(ctx context.Context, offset int) ([]int, error) {
+		return nil, errors.New("boom")
+	}
+	res := <-Stream(context.Background(), fetch)
+	if res.Err == nil || res.Err.Error() != "boom" {
+		t.Errorf("expected boom error, got %v", res.Err)
+	}
+}
+
+type headerDoer struct {
+	headers []http.Header
+}
+
+func (d *headerDoer) Do(req *http.Request) (*http.Response, error) {
+	d.headers = append(d.headers, req.Header.Clone())
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`{"data":"OK"}`)), Header: make(http.Header)}, nil
+}
+
+func TestWithAuthSignsRequests(t *testing.T) {
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+	apiKey := &auth.APIKey{Key: "k1", Secret: "c2VjcmV0", Passphrase: "p1"}
+	doer := &headerDoer{}
+	public := NewClient(transport.NewClient(doer, "http://example"))
+	authed := public.WithAuth(signer, apiKey)
+
+	ctx := context.Background()
+	_, _ = authed.Health(ctx)
+	_, _ = public.Health(ctx)
+	if len(doer.headers) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doer.headers))
+	}
+	if got := doer.headers[0].Get(auth.HeaderPolyAPIKey); got != "k1" {
+		t.Errorf("authenticated request API key = %q, want k1", got)
+	}
+	if doer.headers[0].Get(auth.HeaderPolySignature) == "" {
+		t.Error("authenticated request is not signed")
+	}
+	if got := doer.headers[1].Get(auth.HeaderPolyAPIKey); got != "" {
+		t.Errorf("public request carries API key %q", got)
+	}
+}
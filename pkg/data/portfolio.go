@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
+)
+
+// closedPositionsPageSize is the largest limit /closed-positions accepts.
+const closedPositionsPageSize = 50
+
+// Portfolio combines a user's open positions, closed positions and position
+// value as reported by the Data API.
+type Portfolio struct {
+	User            common.Address
+	Positions       []Position
+	ClosedPositions []ClosedPosition
+	// Value is the current value of the open positions from /value.
+	Value types.Decimal
+	// Exposure is the cost basis still at risk, the sum of InitialValue over
+	// the open positions.
+	Exposure types.Decimal
+	// UnrealizedPnl sums CashPnl over the open positions.
+	UnrealizedPnl types.Decimal
+	// RealizedPnl sums RealizedPnl over the closed positions and the partial
+	// exits of the open positions.
+	RealizedPnl types.Decimal
+	// Partial reports that at least one endpoint failed; the fields it feeds
+	// are left empty and the error returned by Portfolio says which.
+	Partial bool
+}
+
+// Portfolio fetches every open and closed position of user and the value
+// of its positions concurrently and aggregates them.
+//
+// A failing endpoint does not discard the others: the portfolio is
+// returned with what was fetched, Partial set, and an error joining each
+// failure.
+func (c *clientImpl) Portfolio(ctx context.Context, user common.Address) (Portfolio, error) {
+	if user == (common.Address{}) {
+		return Portfolio{}, ErrMissingUser
+	}
+
+	var (
+		positions                          PositionsResponse
+		closed                             []ClosedPosition
+		values                             ValueResponse
+		positionsErr, closedErr, valuesErr error
+	)
+	// Siblings are not canceled on failure so the others can still be used.
+	var g errgroup.Group
+	g.Go(func() error {
+		positions, positionsErr = c.PositionsAll(ctx, &PositionsRequest{User: user})
+		if positionsErr != nil {
+			positionsErr = fmt.Errorf("positions: %w", positionsErr)
+		}
+		return positionsErr
+	})
+	g.Go(func() error {
+		closed, closedErr = collectStream(StreamWithPageSize(ctx, 0, closedPositionsPageSize, func(ctx context.Context, offset int) ([]ClosedPosition, error) {
+			limit := closedPositionsPageSize
+			return c.ClosedPositions(ctx, &ClosedPositionsRequest{User: user, Limit: &limit, Offset: &offset})
+		}))
+		if closedErr != nil {
+			closedErr = fmt.Errorf("closed positions: %w", closedErr)
+		}
+		return closedErr
+	})
+	g.Go(func() error {
+		values, valuesErr = c.Value(ctx, &ValueRequest{User: user})
+		if valuesErr != nil {
+			valuesErr = fmt.Errorf("value: %w", valuesErr)
+		}
+		return valuesErr
+	})
+
+	var err error
+	if g.Wait() != nil {
+		err = errors.Join(positionsErr, closedErr, valuesErr)
+	}
+
+	portfolio := Portfolio{
+		User:            user,
+		Positions:       positions,
+		ClosedPositions: closed,
+		Partial:         err != nil,
+	}
+	for _, pos := range positions {
+		portfolio.Exposure = portfolio.Exposure.Add(pos.InitialValue)
+		portfolio.UnrealizedPnl = portfolio.UnrealizedPnl.Add(pos.CashPnl)
+		portfolio.RealizedPnl = portfolio.RealizedPnl.Add(pos.RealizedPnl)
+	}
+	for _, pos := range closed {
+		portfolio.RealizedPnl = portfolio.RealizedPnl.Add(pos.RealizedPnl)
+	}
+	for _, v := range values {
+		portfolio.Value = portfolio.Value.Add(v.Value)
+	}
+	return portfolio, err
+}
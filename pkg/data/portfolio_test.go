@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+func TestPortfolio(t *testing.T) {
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	positions := `[
+		{"asset":"1","size":"10","initialValue":"4","cashPnl":"1.5","realizedPnl":"0.25"},
+		{"asset":"2","size":"5","initialValue":"2","cashPnl":"-0.5","realizedPnl":"0"}
+	]`
+	closed := `[{"asset":"3","realizedPnl":"3"},{"asset":"4","realizedPnl":"-1"}]`
+
+	t.Run("Aggregates", func(t *testing.T) {
+		doer := &staticDoer{responses: map[string]string{
+			"/positions":        positions,
+			"/closed-positions": closed,
+			"/value":            `[{"user":"0x1111111111111111111111111111111111111111","value":"7.5"}]`,
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+
+		portfolio, err := client.Portfolio(context.Background(), user)
+		if err != nil {
+			t.Fatalf("Portfolio failed: %v", err)
+		}
+		if portfolio.Partial {
+			t.Error("expected a complete portfolio")
+		}
+		if len(portfolio.Positions) != 2 || len(portfolio.ClosedPositions) != 2 {
+			t.Fatalf("got %d positions and %d closed positions", len(portfolio.Positions), len(portfolio.ClosedPositions))
+		}
+		checks := map[string]struct{ got, want decimal.Decimal }{
+			"Value":         {portfolio.Value, decimal.RequireFromString("7.5")},
+			"Exposure":      {portfolio.Exposure, decimal.RequireFromString("6")},
+			"UnrealizedPnl": {portfolio.UnrealizedPnl, decimal.RequireFromString("1")},
+			"RealizedPnl":   {portfolio.RealizedPnl, decimal.RequireFromString("2.25")},
+		}
+		for name, c := range checks {
+			if !c.got.Equal(c.want) {
+				t.Errorf("%s = %s, want %s", name, c.got, c.want)
+			}
+		}
+	})
+
+	t.Run("PartialOnFailure", func(t *testing.T) {
+		doer := &staticDoer{responses: map[string]string{
+			"/positions":        positions,
+			"/closed-positions": closed,
+		}}
+		client := NewClient(transport.NewClient(doer, "http://example"))
+
+		portfolio, err := client.Portfolio(context.Background(), user)
+		if err == nil || !strings.Contains(err.Error(), "value:") {
+			t.Fatalf("expected value error, got %v", err)
+		}
+		if !portfolio.Partial {
+			t.Error("expected Partial to be set")
+		}
+		if len(portfolio.Positions) != 2 || !portfolio.RealizedPnl.Equal(decimal.RequireFromString("2.25")) {
+			t.Errorf("expected the fetched parts to be kept, got %+v", portfolio)
+		}
+		if !portfolio.Value.IsZero() {
+			t.Errorf("Value = %s, want zero", portfolio.Value)
+		}
+	})
+
+	t.Run("MissingUser", func(t *testing.T) {
+		client := NewClient(transport.NewClient(&staticDoer{}, "http://example"))
+		if _, err := client.Portfolio(context.Background(), common.Address{}); err != ErrMissingUser {
+			t.Fatalf("expected ErrMissingUser, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package data
+
+import "context"
+
+// DefaultStreamPageSize is the page size used by the *All methods when the
+// request does not set a limit.
+const DefaultStreamPageSize = 100
+
+// Result wraps a streamed item or an error.
+type Result[T any] struct {
+	Item T
+	Err  error
+}
+
+// StreamFetch fetches a page of data starting at the given offset.
+type StreamFetch[T any] func(ctx context.Context, offset int) ([]T, error)
+
+// Stream streams items by walking offsets from zero until an empty page is returned.
+func Stream[T any](ctx context.Context, fetch StreamFetch[T]) <-chan Result[T] {
+	return StreamWithPageSize(ctx, 0, 0, fetch)
+}
+
+// StreamWithPageSize streams items starting from offset. When pageSize is positive,
+// a page shorter than pageSize ends the stream without an extra request.
+func StreamWithPageSize[T any](ctx context.Context, offset, pageSize int, fetch StreamFetch[T]) <-chan Result[T] {
+	out := make(chan Result[T], 1) // Buffered to prevent goroutine leak if consumer stops receiving
+	go func() {
+		defer close(out)
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if offset < 0 {
+			offset = 0
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				select {
+				case out <- Result[T]{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			items, err := fetch(ctx, offset)
+			if err != nil {
+				select {
+				case out <- Result[T]{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, item := range items {
+				select {
+				case out <- Result[T]{Item: item}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if len(items) == 0 || (pageSize > 0 && len(items) < pageSize) {
+				return
+			}
+			offset += len(items)
+		}
+	}()
+	return out
+}
+
+func streamBounds(limit, offset *int) (int, int) {
+	pageSize := DefaultStreamPageSize
+	if limit != nil && *limit > 0 {
+		pageSize = *limit
+	}
+	start := 0
+	if offset != nil {
+		start = *offset
+	}
+	return pageSize, start
+}
+
+func collectStream[T any](ch <-chan Result[T]) ([]T, error) {
+	var results []T
+	for res := range ch {
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		results = append(results, res.Item)
+	}
+	return results, nil
+}
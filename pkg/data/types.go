@@ -216,16 +216,7 @@ type (
 )
 
 // BoundedIntError indicates a numeric parameter is outside allowed bounds.
-type BoundedIntError struct {
-	Value     int
-	Min       int
-	Max       int
-	ParamName string
-}
-
-func (e BoundedIntError) Error() string {
-	return fmt.Sprintf("%s must be between %d and %d (got %d)", e.ParamName, e.Min, e.Max, e.Value)
-}
+type BoundedIntError = types.BoundedIntError
 
 // FlexibleTime supports both date-only and full RFC3339 timestamps.
 type FlexibleTime struct {
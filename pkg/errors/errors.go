@@ -17,6 +17,7 @@ const (
 	CodeMissingBuilderConfig ErrorCode = "AUTH-003"
 	CodeInvalidSignature     ErrorCode = "AUTH-004"
 	CodeUnauthorized         ErrorCode = "AUTH-005"
+	CodeInvalidAPISecret     ErrorCode = "AUTH-006"
 
 	// Wallet derivation error codes (WALLET-xxx)
 	CodeProxyWalletUnsupported ErrorCode = "WALLET-001"
@@ -36,6 +37,7 @@ const (
 	CodeBadRequest          ErrorCode = "NET-002"
 	CodeCircuitOpen         ErrorCode = "NET-003"
 	CodeTooManyRequests     ErrorCode = "NET-004"
+	CodeServiceUnavailable  ErrorCode = "NET-005"
 
 	// Data API error codes (DATA-xxx)
 	CodeMissingRequest      ErrorCode = "DATA-001"
@@ -101,6 +103,8 @@ var (
 	ErrInvalidSignature = New(CodeInvalidSignature, "invalid signature")
 	// ErrUnauthorized is returned when authentication fails.
 	ErrUnauthorized = New(CodeUnauthorized, "unauthorized")
+	// ErrInvalidAPISecret is returned when an API secret is not valid base64.
+	ErrInvalidAPISecret = New(CodeInvalidAPISecret, "api secret is not valid base64")
 )
 
 // Wallet derivation errors
@@ -139,6 +143,8 @@ var (
 	ErrCircuitOpen = New(CodeCircuitOpen, "circuit breaker is open")
 	// ErrTooManyRequests is returned when too many requests are made in half-open state.
 	ErrTooManyRequests = New(CodeTooManyRequests, "too many requests in half-open state")
+	// ErrServiceUnavailable is returned when the API is down for maintenance or returns a non-JSON error page.
+	ErrServiceUnavailable = New(CodeServiceUnavailable, "service unavailable")
 )
 
 // Data API errors
@@ -70,6 +70,7 @@ func TestErrorDefinitions(t *testing.T) {
 		{"ErrMissingBuilderConfig", ErrMissingBuilderConfig, CodeMissingBuilderConfig},
 		{"ErrInvalidSignature", ErrInvalidSignature, CodeInvalidSignature},
 		{"ErrUnauthorized", ErrUnauthorized, CodeUnauthorized},
+		{"ErrInvalidAPISecret", ErrInvalidAPISecret, CodeInvalidAPISecret},
 
 		// Wallet derivation errors
 		{"ErrProxyWalletUnsupported", ErrProxyWalletUnsupported, CodeProxyWalletUnsupported},
@@ -191,6 +192,7 @@ func TestErrorMessageUniqueness(t *testing.T) {
 		ErrMissingBuilderConfig,
 		ErrInvalidSignature,
 		ErrUnauthorized,
+		ErrInvalidAPISecret,
 		ErrProxyWalletUnsupported,
 		ErrSafeWalletUnsupported,
 		ErrInsufficientFunds,
@@ -5,11 +5,18 @@ package gamma
 
 import (
 	"context"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 )
 
 // Client defines the interface for the Polymarket Gamma metadata service.
 // It is primarily a read-only API used for discovery and metadata retrieval.
 type Client interface {
+	// WithAuth returns a client that signs its requests with L2 headers from
+	// signer and apiKey, for endpoints that return more to authenticated
+	// callers. The receiver is left unauthenticated.
+	WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client
+
 	// -- System Status --
 
 	// Status returns the current operational status of the Gamma service.
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
 
@@ -296,6 +297,13 @@ func (c *clientImpl) EventTags(ctx context.Context, req *EventTagsRequest) ([]Ta
 	return resp, err
 }
 
+func (c *clientImpl) WithAuth(signer auth.Signer, apiKey *auth.APIKey) Client {
+	// Clone the transport so the receiver keeps making public calls.
+	httpClient := c.httpClient.CloneWithBaseURL(c.httpClient.BaseURL())
+	httpClient.SetAuth(signer, apiKey)
+	return &clientImpl{httpClient: httpClient}
+}
+
 func buildMarketsQuery(req *MarketsRequest) url.Values {
 	q := url.Values{}
 	if req != nil {
@@ -314,6 +322,7 @@ func buildMarketsQuery(req *MarketsRequest) url.Values {
 		addString(q, "tag_id", req.TagID)
 		addString(q, "tag_slug", req.TagSlug)
 		addBool(q, "related_tags", req.RelatedTags)
+		addString(q, "category", req.Category)
 		addBool(q, "cyom", req.Cyom)
 		addString(q, "uma_resolution_status", req.UmaResolutionStatus)
 		addString(q, "game_id", req.GameID)
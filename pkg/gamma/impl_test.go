@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 )
 
@@ -187,3 +188,103 @@ func TestGammaMethods(t *testing.T) {
 		_, _ = client.GetEvent(ctx, "1")
 	})
 }
+
+func TestBuildMarketsQueryFilters(t *testing.T) {
+	q := buildMarketsQuery(&MarketsRequest{
+		TagSlug:      "politics",
+		TagID:        "2",
+		Category:     "Politics",
+		StartDateMin: "2026-01-01T00:00:00Z",
+		EndDateMax:   "2026-12-31T00:00:00Z",
+	})
+	want := map[string]string{
+		"tag_slug":       "politics",
+		"tag_id":         "2",
+		"category":       "Politics",
+		"start_date_min": "2026-01-01T00:00:00Z",
+		"end_date_max":   "2026-12-31T00:00:00Z",
+	}
+	for key, value := range want {
+		if got := q.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+	if len(q) != len(want) {
+		t.Errorf("unexpected query %v", q.Encode())
+	}
+}
+
+func TestMarketTokenIDsAndOutcomes(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		m := Market{ClobTokenIds: `["123", " 456 ", ""]`, Outcomes: `["Yes","No"]`}
+		ids := m.TokenIDs()
+		if len(ids) != 2 || ids[0] != "123" || ids[1] != "456" {
+			t.Fatalf("unexpected token ids: %v", ids)
+		}
+		outcomes := m.OutcomeNames()
+		if len(outcomes) != 2 || outcomes[0] != "Yes" || outcomes[1] != "No" {
+			t.Fatalf("unexpected outcomes: %v", outcomes)
+		}
+	})
+
+	t.Run("Numbers keep precision", func(t *testing.T) {
+		big := "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+		m := Market{ClobTokenIds: "[" + big + ", 42]"}
+		ids := m.TokenIDs()
+		if len(ids) != 2 || ids[0] != big || ids[1] != "42" {
+			t.Fatalf("unexpected token ids: %v", ids)
+		}
+	})
+
+	t.Run("Empty or malformed", func(t *testing.T) {
+		for _, raw := range []string{"", "  ", "not-json", `{"a":1}`} {
+			if ids := (Market{ClobTokenIds: raw}).TokenIDs(); ids != nil {
+				t.Errorf("TokenIDs(%q) = %v, want nil", raw, ids)
+			}
+		}
+	})
+
+	t.Run("ParsedTokens", func(t *testing.T) {
+		m := Market{ClobTokenIds: `["1","2"]`, Outcomes: `["Yes","No"]`}
+		tokens := m.ParsedTokens()
+		if len(tokens) != 2 || tokens[1].TokenID != "2" || tokens[1].Outcome != "No" {
+			t.Fatalf("unexpected tokens: %+v", tokens)
+		}
+	})
+}
+
+type headerDoer struct {
+	headers []http.Header
+}
+
+func (d *headerDoer) Do(req *http.Request) (*http.Response, error) {
+	d.headers = append(d.headers, req.Header.Clone())
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`{"data":"OK"}`)), Header: make(http.Header)}, nil
+}
+
+func TestWithAuthSignsRequests(t *testing.T) {
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+	apiKey := &auth.APIKey{Key: "k1", Secret: "c2VjcmV0", Passphrase: "p1"}
+	doer := &headerDoer{}
+	public := NewClient(transport.NewClient(doer, "http://example"))
+	authed := public.WithAuth(signer, apiKey)
+
+	ctx := context.Background()
+	_, _ = authed.Status(ctx)
+	_, _ = public.Status(ctx)
+	if len(doer.headers) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doer.headers))
+	}
+	if got := doer.headers[0].Get(auth.HeaderPolyAPIKey); got != "k1" {
+		t.Errorf("authenticated request API key = %q, want k1", got)
+	}
+	if doer.headers[0].Get(auth.HeaderPolySignature) == "" {
+		t.Error("authenticated request is not signed")
+	}
+	if got := doer.headers[1].Get(auth.HeaderPolyAPIKey); got != "" {
+		t.Errorf("public request carries API key %q", got)
+	}
+}
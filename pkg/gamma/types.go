@@ -1,6 +1,9 @@
 package gamma
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strings"
+)
 
 // Request parameters
 type MarketsRequest struct {
@@ -19,6 +22,7 @@ type MarketsRequest struct {
 	TagID               string   `json:"tag_id,omitempty"`
 	TagSlug             string   `json:"tag_slug,omitempty"`
 	RelatedTags         *bool    `json:"related_tags,omitempty"`
+	Category            string   `json:"category,omitempty"` // e.g. "Politics"
 	Cyom                *bool    `json:"cyom,omitempty"`
 	UmaResolutionStatus string   `json:"uma_resolution_status,omitempty"`
 	GameID              string   `json:"game_id,omitempty"`
@@ -229,12 +233,11 @@ func (m *Market) ParsedTokens() []Token {
 	if len(m.Tokens) > 0 {
 		return m.Tokens
 	}
-	var ids []string
-	if err := json.Unmarshal([]byte(m.ClobTokenIds), &ids); err != nil {
+	ids := m.TokenIDs()
+	if ids == nil {
 		return nil
 	}
-	var outcomes []string
-	_ = json.Unmarshal([]byte(m.Outcomes), &outcomes)
+	outcomes := m.OutcomeNames()
 
 	tokens := make([]Token, len(ids))
 	for i, id := range ids {
@@ -246,6 +249,48 @@ func (m *Market) ParsedTokens() []Token {
 	return tokens
 }
 
+// TokenIDs decodes ClobTokenIds into a slice of token IDs. Both string and
+// numeric array entries are accepted; numbers keep their full precision.
+// Returns nil if the field is empty or malformed.
+func (m Market) TokenIDs() []string {
+	return parseStringList(m.ClobTokenIds)
+}
+
+// OutcomeNames decodes the Outcomes field into a slice of outcome labels.
+// Returns nil if the field is empty or malformed.
+func (m Market) OutcomeNames() []string {
+	return parseStringList(m.Outcomes)
+}
+
+// parseStringList decodes a JSON-encoded array whose entries are strings or
+// numbers. Empty entries are dropped.
+func parseStringList(raw string) []string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var entries []interface{}
+	if err := dec.Decode(&entries); err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		var value string
+		switch v := entry.(type) {
+		case string:
+			value = strings.TrimSpace(v)
+		case json.Number:
+			value = v.String()
+		}
+		if value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
 type Tag struct {
 	ID    string `json:"id"`
 	Label string `json:"label"`
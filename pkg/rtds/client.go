@@ -28,8 +28,15 @@ type Client interface {
 	UnsubscribeComments(ctx context.Context, commentType *CommentType) error
 	UnsubscribeOrdersMatched(ctx context.Context) error
 	UnsubscribeRaw(ctx context.Context, sub *Subscription) error
+	// ConnectionState returns the current connection status.
 	ConnectionState() ConnectionState
+	// ConnectionStateStream returns a stream of connection state transitions,
+	// starting with the current state. Reconnect attempts are reported as
+	// ConnectionReconnecting with their attempt number.
 	ConnectionStateStream(ctx context.Context) (*Stream[ConnectionStateEvent], error)
+	// WaitUntilConnected blocks until the connection is ConnectionConnected,
+	// ctx is done or the client is closed.
+	WaitUntilConnected(ctx context.Context) error
 	SubscriptionCount() int
 	Close() error
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/logger"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
 	"github.com/gorilla/websocket"
 )
 
@@ -26,6 +27,7 @@ const (
 const (
 	connDisconnected int32 = iota
 	connConnected
+	connReconnecting
 )
 
 // Use unified error definitions from pkg/errors
@@ -33,6 +35,9 @@ var (
 	ErrInvalidSubscription = sdkerrors.ErrInvalidSubscription
 )
 
+// ErrClientClosed is returned when waiting on a client that has been closed.
+var ErrClientClosed = errors.New("rtds client is closed")
+
 const (
 	defaultStreamBuffer = 100
 	defaultErrBuffer    = 10
@@ -110,9 +115,11 @@ type clientImpl struct {
 	closeOnce sync.Once
 	closing   atomic.Bool
 
-	reconnect      bool
-	reconnectDelay time.Duration
-	reconnectMax   int
+	reconnect         bool
+	reconnectDelay    time.Duration
+	reconnectMaxDelay time.Duration
+	reconnectJitter   float64
+	reconnectMax      int
 
 	stateMu     sync.Mutex
 	stateSubs   map[string]*stateSubscription
@@ -129,7 +136,7 @@ type clientImpl struct {
 	auth   *auth.APIKey
 }
 
-func NewClient(url string) (Client, error) {
+func NewClient(url string, opts ...Option) (Client, error) {
 	if url == "" {
 		url = ProdURL
 	}
@@ -144,6 +151,12 @@ func NewClient(url string) (Client, error) {
 			reconnectDelay = time.Duration(ms) * time.Millisecond
 		}
 	}
+	reconnectJitter := DefaultReconnectJitter
+	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_RECONNECT_JITTER")); raw != "" {
+		if jitter, err := strconv.ParseFloat(raw, 64); err == nil {
+			reconnectJitter = clampJitter(jitter)
+		}
+	}
 	reconnectMax := 5
 	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_RECONNECT_MAX")); raw != "" {
 		if max, err := strconv.Atoi(raw); err == nil {
@@ -152,17 +165,23 @@ func NewClient(url string) (Client, error) {
 	}
 
 	c := &clientImpl{
-		url:            url,
-		done:           make(chan struct{}),
-		connReady:      make(chan struct{}),
-		stateSubs:      make(map[string]*stateSubscription),
-		subRefs:        make(map[string]int),
-		subDetails:     make(map[string]Subscription),
-		subs:           make(map[string]*subscriptionEntry),
-		subsByKey:      make(map[string]map[string]*subscriptionEntry),
-		reconnect:      reconnect,
-		reconnectDelay: reconnectDelay,
-		reconnectMax:   reconnectMax,
+		url:             url,
+		done:            make(chan struct{}),
+		connReady:       make(chan struct{}),
+		stateSubs:       make(map[string]*stateSubscription),
+		subRefs:         make(map[string]int),
+		subDetails:      make(map[string]Subscription),
+		subs:            make(map[string]*subscriptionEntry),
+		subsByKey:       make(map[string]map[string]*subscriptionEntry),
+		reconnect:       reconnect,
+		reconnectDelay:  reconnectDelay,
+		reconnectJitter: reconnectJitter,
+		reconnectMax:    reconnectMax,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
 	}
 
 	go c.run()
@@ -189,7 +208,6 @@ func (c *clientImpl) connect() error {
 	c.closeConn()
 	conn, _, err := websocket.DefaultDialer.Dial(c.url, nil)
 	if err != nil {
-		c.setState(ConnectionDisconnected)
 		return err
 	}
 	c.conn = conn
@@ -199,23 +217,22 @@ func (c *clientImpl) connect() error {
 }
 
 func (c *clientImpl) run() {
-	attempts := 0
+	// Reconnect delays are fixed at reconnectDelay unless reconnectMaxDelay
+	// opts into doubling up to it; either way randomized by reconnectJitter.
+	backoff := c.reconnectBackoff()
 	for {
 		if c.closing.Load() {
 			c.signalDone()
 			return
 		}
 		if err := c.connect(); err != nil {
-			if !c.shouldReconnect(attempts) {
-				c.signalDone()
+			if !c.waitReconnect(backoff) {
 				return
 			}
-			attempts++
-			time.Sleep(c.reconnectDelay)
 			continue
 		}
 
-		attempts = 0
+		backoff.Reset()
 		c.resubscribeAll()
 
 		if err := c.readLoop(); err != nil {
@@ -223,17 +240,42 @@ func (c *clientImpl) run() {
 				c.signalDone()
 				return
 			}
-			if !c.shouldReconnect(attempts) {
-				c.signalDone()
+			if !c.waitReconnect(backoff) {
 				return
 			}
-			attempts++
-			time.Sleep(c.reconnectDelay)
 			continue
 		}
 	}
 }
 
+// waitReconnect reports ConnectionReconnecting and sleeps before the next
+// dial. When no attempts are left it reports ConnectionDisconnected, signals
+// done and returns false.
+func (c *clientImpl) waitReconnect(backoff *transport.Backoff) bool {
+	if c.closing.Load() {
+		c.signalDone()
+		return false
+	}
+	if !c.shouldReconnect(backoff.Attempt()) {
+		c.setState(ConnectionDisconnected)
+		c.signalDone()
+		return false
+	}
+	c.setStateAttempt(ConnectionReconnecting, backoff.Attempt()+1)
+	time.Sleep(backoff.Next())
+	return true
+}
+
+// reconnectBackoff builds the reconnect delay policy: a fixed
+// reconnectDelay, or one doubling up to reconnectMaxDelay when that is
+// larger.
+func (c *clientImpl) reconnectBackoff() *transport.Backoff {
+	if c.reconnectMaxDelay <= c.reconnectDelay {
+		return transport.NewBackoff(c.reconnectDelay, c.reconnectDelay, 1, c.reconnectJitter)
+	}
+	return transport.NewBackoff(c.reconnectDelay, c.reconnectMaxDelay, 2, c.reconnectJitter)
+}
+
 func (c *clientImpl) shouldReconnect(attempts int) bool {
 	if !c.reconnect {
 		return false
@@ -273,7 +315,6 @@ func (c *clientImpl) readLoop() error {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
 			logger.Error("rtds read error: %v", err)
-			c.setState(ConnectionDisconnected)
 			return err
 		}
 
@@ -513,10 +554,14 @@ func (c *clientImpl) UnsubscribeRaw(ctx context.Context, sub *Subscription) erro
 }
 
 func (c *clientImpl) ConnectionState() ConnectionState {
-	if atomic.LoadInt32(&c.state) == connConnected {
+	switch atomic.LoadInt32(&c.state) {
+	case connConnected:
 		return ConnectionConnected
+	case connReconnecting:
+		return ConnectionReconnecting
+	default:
+		return ConnectionDisconnected
 	}
-	return ConnectionDisconnected
 }
 
 func (c *clientImpl) SubscriptionCount() int {
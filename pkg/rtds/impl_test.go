@@ -3,9 +3,11 @@ package rtds
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -69,6 +71,7 @@ func TestRtdsMessageUnmarshal(t *testing.T) {
 		t.Fatalf("Unmarshal failed: %v", err)
 	}
 }
+
 // --------------- newTestClient helper ---------------
 
 func newTestClient() *clientImpl {
@@ -390,6 +393,34 @@ func TestSetState_BackToDisconnected(t *testing.T) {
 	}
 }
 
+func TestWaitUntilConnected(t *testing.T) {
+	c := newTestClient()
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		c.setState(ConnectionConnected)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.WaitUntilConnected(ctx); err != nil {
+		t.Fatalf("WaitUntilConnected: %v", err)
+	}
+
+	c.setState(ConnectionDisconnected)
+	short, cancelShort := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelShort()
+	if err := c.WaitUntilConnected(short); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitUntilConnected = %v, want deadline exceeded", err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_ = c.Close()
+	}()
+	if err := c.WaitUntilConnected(ctx); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("WaitUntilConnected after Close = %v, want ErrClientClosed", err)
+	}
+}
+
 // --------------- SubscriptionCount ---------------
 
 func TestSubscriptionCount_Empty(t *testing.T) {
@@ -808,3 +839,104 @@ func TestSignalDone_Idempotent(t *testing.T) {
 	c.signalDone()
 	c.signalDone() // should not panic
 }
+
+// --------------- reconnect options ---------------
+
+func TestReconnectOptions(t *testing.T) {
+	c := newTestClient()
+	WithReconnect(true)(c)
+	WithReconnectDelay(50*time.Millisecond, time.Second)(c)
+	WithReconnectMax(0)(c)
+	WithReconnectJitter(0.3)(c)
+	b := c.reconnectBackoff()
+	if b.Base != 50*time.Millisecond || b.Max != time.Second || b.Jitter != 0.3 {
+		t.Errorf("unexpected backoff: %+v", b)
+	}
+	if !c.shouldReconnect(100) {
+		t.Error("expected unlimited retries")
+	}
+	WithReconnect(false)(c)
+	if c.shouldReconnect(0) {
+		t.Error("expected reconnect disabled")
+	}
+}
+
+func TestReconnectDelayFixedByDefault(t *testing.T) {
+	s := mockWSServer(t, func(c *websocket.Conn) {})
+	defer s.Close()
+	client, err := NewClient("ws"+strings.TrimPrefix(s.URL, "http"), WithReconnectJitter(0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+	c := client.(*clientImpl)
+
+	b := c.reconnectBackoff()
+	for attempt := 0; attempt < 5; attempt++ {
+		if d := b.Next(); d != 2*time.Second {
+			t.Fatalf("attempt %d delay = %s, want 2s", attempt, d)
+		}
+	}
+
+	c = newTestClient()
+	WithReconnectDelay(time.Second, 4*time.Second)(c)
+	WithReconnectJitter(0)(c)
+	b = c.reconnectBackoff()
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		if d := b.Next(); d != want {
+			t.Fatalf("delay = %s, want %s", d, want)
+		}
+	}
+}
+
+func TestConnectionStateStream_ReportsReconnect(t *testing.T) {
+	var conns atomic.Int32
+	drop := make(chan struct{})
+	s := mockWSServer(t, func(c *websocket.Conn) {
+		if conns.Add(1) == 1 {
+			<-drop // drop the first connection once the test is watching
+			return
+		}
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	defer s.Close()
+
+	client, err := NewClient("ws"+strings.TrimPrefix(s.URL, "http"),
+		WithReconnectDelay(10*time.Millisecond, 10*time.Millisecond), WithReconnectJitter(0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+	stream, err := client.ConnectionStateStream(context.Background())
+	if err != nil {
+		t.Fatalf("ConnectionStateStream failed: %v", err)
+	}
+	defer stream.Close()
+	close(drop)
+
+	sawReconnect := false
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event := <-stream.C:
+			if event.State == ConnectionReconnecting {
+				if event.Attempt != 1 {
+					t.Errorf("expected attempt 1, got %d", event.Attempt)
+				}
+				sawReconnect = true
+			}
+			if event.State == ConnectionConnected && sawReconnect {
+				if conns.Load() < 2 {
+					t.Fatalf("expected a second connection, got %d", conns.Load())
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out; saw reconnecting=%v, state %s", sawReconnect, client.ConnectionState())
+		}
+	}
+}
@@ -14,29 +14,39 @@ const (
 )
 
 type stateSubscription struct {
-	id        string
-	ch        chan ConnectionStateEvent
-	errCh     chan error
-	closed    atomic.Bool
-	closeOnce sync.Once
+	id    string
+	ch    chan ConnectionStateEvent
+	errCh chan error
+	// mu orders sends against close, so a state change racing Close or a
+	// cancelled stream never sends on a closed channel.
+	mu     sync.RWMutex
+	closed bool
 }
 
 func (s *stateSubscription) trySend(event ConnectionStateEvent) {
-	if s.closed.Load() {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
 		return
 	}
-	defer func() {
-		_ = recover()
-	}()
 	select {
 	case s.ch <- event:
-		return
 	default:
-		s.notifyLag(1)
+		s.sendLag(1)
 	}
 }
 
 func (s *stateSubscription) notifyLag(count int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return
+	}
+	s.sendLag(count)
+}
+
+// sendLag reports dropped events; the caller holds mu.
+func (s *stateSubscription) sendLag(count int) {
 	if count <= 0 {
 		return
 	}
@@ -47,13 +57,14 @@ func (s *stateSubscription) notifyLag(count int) {
 }
 
 func (s *stateSubscription) close() bool {
-	if s.closed.Swap(true) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
 		return false
 	}
-	s.closeOnce.Do(func() {
-		close(s.ch)
-		close(s.errCh)
-	})
+	s.closed = true
+	close(s.ch)
+	close(s.errCh)
 	return true
 }
 
@@ -98,16 +109,61 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 	return stream, nil
 }
 
+func (c *clientImpl) WaitUntilConnected(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	stream, err := c.ConnectionStateStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	lagged := stream.Err
+	for {
+		if c.ConnectionState() == ConnectionConnected {
+			return nil
+		}
+		if c.closing.Load() {
+			return ErrClientClosed
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.done:
+			return ErrClientClosed
+		case _, ok := <-stream.C:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return ErrClientClosed
+			}
+		case _, ok := <-lagged:
+			// Dropped state events only mean the state must be re-read.
+			if !ok {
+				lagged = nil
+			}
+		}
+	}
+}
+
 func (c *clientImpl) setState(state ConnectionState) {
+	c.setStateAttempt(state, 0)
+}
+
+func (c *clientImpl) setStateAttempt(state ConnectionState, attempt int) {
 	switch state {
 	case ConnectionConnected:
 		atomic.StoreInt32(&c.state, connConnected)
+	case ConnectionReconnecting:
+		atomic.StoreInt32(&c.state, connReconnecting)
 	default:
 		atomic.StoreInt32(&c.state, connDisconnected)
 	}
 
 	event := ConnectionStateEvent{
 		State:    state,
+		Attempt:  attempt,
 		Recorded: time.Now().UnixMilli(),
 	}
 	c.stateMu.Lock()
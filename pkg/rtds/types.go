@@ -14,12 +14,18 @@ type ConnectionState string
 const (
 	ConnectionDisconnected ConnectionState = "disconnected"
 	ConnectionConnected    ConnectionState = "connected"
+	// ConnectionReconnecting is reported while the client waits to redial
+	// after a dropped connection or a failed dial.
+	ConnectionReconnecting ConnectionState = "reconnecting"
 )
 
 // ConnectionStateEvent captures connection transitions.
 type ConnectionStateEvent struct {
-	State    ConnectionState `json:"state"`
-	Recorded int64           `json:"recorded"`
+	State ConnectionState `json:"state"`
+	// Attempt is the reconnect attempt about to be made; it is only set
+	// for ConnectionReconnecting.
+	Attempt  int   `json:"attempt,omitempty"`
+	Recorded int64 `json:"recorded"`
 }
 
 // RtdsMessage is the raw RTDS message wrapper.
@@ -56,11 +62,10 @@ func (s Subscription) MarshalJSON() ([]byte, error) {
 	}
 	if s.Filters != nil {
 		filters := s.Filters
-		if raw, ok := s.Filters.(string); ok && s.Topic != string(ChainlinkPrice) {
-			var parsed interface{}
-			if err := json.Unmarshal([]byte(raw), &parsed); err == nil {
-				filters = parsed
-			}
+		// Valid JSON is sent verbatim rather than decoded, which would turn
+		// numeric token IDs into float64 and lose precision.
+		if raw, ok := s.Filters.(string); ok && s.Topic != string(ChainlinkPrice) && json.Valid([]byte(raw)) {
+			filters = json.RawMessage(raw)
 		}
 		payload["filters"] = filters
 	}
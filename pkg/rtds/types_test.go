@@ -46,3 +46,19 @@ func TestSubscriptionFiltersSerialization(t *testing.T) {
 		t.Fatalf("expected raw filters string, got %s", string(data))
 	}
 }
+
+func TestSubscriptionFiltersKeepTokenIDPrecision(t *testing.T) {
+	const tokenID = "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+	sub := Subscription{
+		Topic:   string(CryptoPrice),
+		MsgType: "update",
+		Filters: `{"token_ids": [` + tokenID + `]}`,
+	}
+	data, err := json.Marshal(sub)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"filters":{"token_ids":[`+tokenID+`]}`) {
+		t.Fatalf("token id lost precision: %s", string(data))
+	}
+}
@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultBackoffBase   = 100 * time.Millisecond
+	defaultBackoffFactor = 2.0
+)
+
+// Backoff produces exponentially growing delays for retry loops. The HTTP
+// transport and the WebSocket reconnect loops share it; user code can reuse it
+// for its own retries.
+//
+// The zero value starts at 100ms and doubles without a cap. Backoff is not
+// safe for concurrent use; give each retry loop its own copy.
+type Backoff struct {
+	// Base is the first delay returned by Next (default 100ms).
+	Base time.Duration
+	// Factor multiplies the delay after each attempt (default 2). Use 1 for a fixed delay.
+	Factor float64
+	// Max caps the delay. Zero means no cap.
+	Max time.Duration
+	// Jitter randomizes each delay by up to ±Jitter of its value (0 to 1).
+	Jitter float64
+
+	attempt int
+}
+
+// NewBackoff creates a Backoff with the given policy.
+func NewBackoff(base, max time.Duration, factor, jitter float64) *Backoff {
+	return &Backoff{Base: base, Max: max, Factor: factor, Jitter: jitter}
+}
+
+// Next returns the delay for the current attempt and advances the attempt counter.
+func (b *Backoff) Next() time.Duration {
+	d := b.Delay(b.attempt)
+	b.attempt++
+	return d
+}
+
+// Reset restarts the sequence from Base, typically after a successful attempt.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// Attempt returns how many delays have been handed out since the last Reset.
+func (b *Backoff) Attempt() int {
+	return b.attempt
+}
+
+// Delay returns the delay for a zero-based attempt without changing state.
+func (b *Backoff) Delay(attempt int) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+	base := b.Base
+	if base <= 0 {
+		base = defaultBackoffBase
+	}
+	factor := b.Factor
+	if factor < 1 {
+		factor = defaultBackoffFactor
+	}
+
+	d := float64(base) * math.Pow(factor, float64(attempt))
+	if jitter := math.Min(b.Jitter, 1); jitter > 0 {
+		d *= 1 - jitter + 2*jitter*rand.Float64()
+	}
+	if b.Max > 0 && d > float64(b.Max) {
+		return b.Max
+	}
+	if d >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
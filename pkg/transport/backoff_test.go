@@ -0,0 +1,62 @@
+package transport
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffSequence(t *testing.T) {
+	b := NewBackoff(100*time.Millisecond, time.Second, 2, 0)
+	want := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, w := range want {
+		if got := b.Next(); got != w {
+			t.Fatalf("Next() #%d = %v, want %v", i, got, w)
+		}
+	}
+	if b.Attempt() != len(want) {
+		t.Errorf("Attempt() = %d, want %d", b.Attempt(), len(want))
+	}
+
+	b.Reset()
+	if got := b.Next(); got != 100*time.Millisecond {
+		t.Errorf("after Reset, Next() = %v, want 100ms", got)
+	}
+}
+
+func TestBackoffDefaults(t *testing.T) {
+	var b Backoff
+	if got := b.Delay(0); got != defaultBackoffBase {
+		t.Errorf("Delay(0) = %v, want %v", got, defaultBackoffBase)
+	}
+	if got := b.Delay(3); got != 8*defaultBackoffBase {
+		t.Errorf("Delay(3) = %v, want %v", got, 8*defaultBackoffBase)
+	}
+	if got := b.Delay(10000); got <= 0 {
+		t.Errorf("Delay(10000) overflowed: %v", got)
+	}
+
+	fixed := Backoff{Base: time.Second, Factor: 1}
+	if got := fixed.Delay(5); got != time.Second {
+		t.Errorf("fixed Delay(5) = %v, want 1s", got)
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	b := Backoff{Base: time.Second, Factor: 2, Max: 3 * time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		d := b.Delay(0)
+		if d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Fatalf("jittered delay %v outside [500ms, 1.5s]", d)
+		}
+		if capped := b.Delay(5); capped > 3*time.Second {
+			t.Fatalf("jittered delay %v exceeds Max", capped)
+		}
+	}
+}
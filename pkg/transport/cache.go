@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"container/list"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+)
+
+// conditionalCache remembers the validators and body of GET responses so
+// that repeated requests can be revalidated with If-None-Match and
+// If-Modified-Since and served from memory on 304 Not Modified. It holds at
+// most maxEntries URLs and evicts the least recently used one.
+type conditionalCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // front is most recently used
+	entries    map[string]*list.Element
+}
+
+type cachedResponse struct {
+	key          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+func newConditionalCache(maxEntries int) *conditionalCache {
+	return &conditionalCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+func (c *conditionalCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cachedResponse), true
+}
+
+// store caches body under key when resp carries a validator and may be
+// stored, and forgets key otherwise.
+func (c *conditionalCache) store(key string, resp *http.Response, body []byte) {
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	cacheControl := strings.ToLower(resp.Header.Get("Cache-Control"))
+	if (etag == "" && lastModified == "") || strings.Contains(cacheControl, "no-store") || strings.Contains(cacheControl, "private") {
+		c.remove(key)
+		return
+	}
+	entry := &cachedResponse{key: key, etag: etag, lastModified: lastModified, body: body}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+func (c *conditionalCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// hasCredentials reports whether h authenticates the request, in which case
+// the response is specific to the caller and must not be cached.
+func hasCredentials(h http.Header) bool {
+	for _, key := range []string{
+		auth.HeaderPolyAPIKey,
+		auth.HeaderPolySignature,
+		auth.HeaderPolyAddress,
+		"Authorization",
+	} {
+		if h.Get(key) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *conditionalCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// SetConditionalCache enables conditional GET caching of up to maxEntries
+// URLs. GET responses with an ETag or Last-Modified header are kept, later
+// GETs of the same URL send If-None-Match or If-Modified-Since, and a 304
+// Not Modified reply is decoded from the cached body. This saves bandwidth
+// for pollers of large, mostly static lists such as markets. Authenticated
+// requests and responses marked Cache-Control private or no-store are never
+// cached, so the cache can be shared by clones with different credentials.
+// A maxEntries of zero or less disables the cache, which is the default.
+func (c *Client) SetConditionalCache(maxEntries int) {
+	if maxEntries <= 0 {
+		c.cache = nil
+		return
+	}
+	c.cache = newConditionalCache(maxEntries)
+}
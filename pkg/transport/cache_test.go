@@ -0,0 +1,166 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+)
+
+// etagDoer serves body with a fixed ETag and answers 304 when the request
+// revalidates it.
+func etagDoer(etag, body string) *MockDoer {
+	return &MockDoer{DoFunc: func(req *http.Request) (*http.Response, error) {
+		header := http.Header{"Etag": []string{etag}}
+		if req.Header.Get("If-None-Match") == etag {
+			return &http.Response{StatusCode: http.StatusNotModified, Header: header, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}}
+}
+
+func TestConditionalCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		doer := etagDoer(`"v1"`, `{"count":1}`)
+		client := NewClient(doer, "http://example")
+		for i := 0; i < 2; i++ {
+			var dest map[string]int
+			if err := client.Get(ctx, "/markets", nil, &dest); err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+		}
+		for _, req := range doer.calls {
+			if got := req.Header.Get("If-None-Match"); got != "" {
+				t.Errorf("unexpected If-None-Match %q without a cache", got)
+			}
+		}
+	})
+
+	t.Run("ServesCachedBodyOnNotModified", func(t *testing.T) {
+		doer := etagDoer(`"v1"`, `{"count":1}`)
+		client := NewClient(doer, "http://example")
+		client.SetConditionalCache(8)
+		for i := 0; i < 2; i++ {
+			var dest map[string]int
+			if err := client.Get(ctx, "/markets", nil, &dest); err != nil {
+				t.Fatalf("Get %d failed: %v", i, err)
+			}
+			if dest["count"] != 1 {
+				t.Errorf("Get %d decoded %v", i, dest)
+			}
+		}
+		if len(doer.calls) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(doer.calls))
+		}
+		if got := doer.calls[1].Header.Get("If-None-Match"); got != `"v1"` {
+			t.Errorf("If-None-Match = %q, want %q", got, `"v1"`)
+		}
+	})
+
+	t.Run("LastModified", func(t *testing.T) {
+		const stamp = "Wed, 21 Oct 2026 07:28:00 GMT"
+		doer := &MockDoer{DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("If-Modified-Since") == stamp {
+				return &http.Response{StatusCode: http.StatusNotModified, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+			}
+			header := http.Header{"Last-Modified": []string{stamp}}
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(`"ok"`))}, nil
+		}}
+		client := NewClient(doer, "http://example")
+		client.SetConditionalCache(8)
+		for i := 0; i < 2; i++ {
+			var dest string
+			if err := client.Get(ctx, "/sampling-markets", nil, &dest); err != nil || dest != "ok" {
+				t.Fatalf("Get %d = %q, %v", i, dest, err)
+			}
+		}
+	})
+
+	t.Run("EvictsLeastRecentlyUsed", func(t *testing.T) {
+		client := NewClient(etagDoer(`"v1"`, `{}`), "http://example")
+		client.SetConditionalCache(2)
+		for _, path := range []string{"/a", "/b", "/a", "/c"} {
+			if err := client.Get(ctx, path, nil, nil); err != nil {
+				t.Fatalf("Get %s failed: %v", path, err)
+			}
+		}
+		if client.cache.len() != 2 {
+			t.Fatalf("expected 2 entries, got %d", client.cache.len())
+		}
+		if _, ok := client.cache.get("http://example/b"); ok {
+			t.Error("expected /b to be evicted")
+		}
+		if _, ok := client.cache.get("http://example/a"); !ok {
+			t.Error("expected /a to be kept")
+		}
+	})
+
+	t.Run("SkipsNoStore", func(t *testing.T) {
+		doer := &MockDoer{DoFunc: func(req *http.Request) (*http.Response, error) {
+			header := http.Header{"Etag": []string{`"v1"`}, "Cache-Control": []string{"no-store"}}
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}}
+		client := NewClient(doer, "http://example")
+		client.SetConditionalCache(8)
+		if err := client.Get(ctx, "/markets", nil, nil); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if client.cache.len() != 0 {
+			t.Error("no-store response should not be cached")
+		}
+	})
+
+	t.Run("SkipsPrivate", func(t *testing.T) {
+		doer := &MockDoer{DoFunc: func(req *http.Request) (*http.Response, error) {
+			header := http.Header{"Etag": []string{`"v1"`}, "Cache-Control": []string{"private, max-age=0"}}
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}}
+		client := NewClient(doer, "http://example")
+		client.SetConditionalCache(8)
+		if err := client.Get(ctx, "/markets", nil, nil); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if client.cache.len() != 0 {
+			t.Error("private response should not be cached")
+		}
+	})
+
+	t.Run("SkipsAuthenticated", func(t *testing.T) {
+		signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+		if err != nil {
+			t.Fatalf("NewPrivateKeySigner failed: %v", err)
+		}
+		doer := etagDoer(`"v1"`, `{"count":1}`)
+		client := NewClient(doer, "http://example")
+		client.SetConditionalCache(8)
+		client.SetAuth(signer, &auth.APIKey{Key: "k", Secret: "c2VjcmV0", Passphrase: "p"})
+		for i := 0; i < 2; i++ {
+			if err := client.Get(ctx, "/data/orders", nil, nil); err != nil {
+				t.Fatalf("Get %d failed: %v", i, err)
+			}
+		}
+		if client.cache.len() != 0 {
+			t.Error("authenticated response should not be cached")
+		}
+		if got := doer.calls[1].Header.Get("If-None-Match"); got != "" {
+			t.Errorf("unexpected If-None-Match %q on authenticated request", got)
+		}
+
+		// A clone without credentials shares the cache and must not see the
+		// private response either.
+		public := client.CloneWithBaseURL("http://example")
+		public.SetAuth(nil, nil)
+		var dest map[string]int
+		if err := public.Get(ctx, "/data/orders", nil, &dest); err != nil {
+			t.Fatalf("public Get failed: %v", err)
+		}
+		if got := doer.calls[2].Header.Get("If-None-Match"); got != "" {
+			t.Errorf("public clone revalidated a private entry with %q", got)
+		}
+	})
+}
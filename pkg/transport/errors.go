@@ -1,6 +1,17 @@
 package transport
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/pkg/errors"
+)
+
+// ErrServiceUnavailable is matched by ServiceUnavailableError via errors.Is.
+var ErrServiceUnavailable = sdkerrors.ErrServiceUnavailable
 
 // APIError represents a non-2xx response.
 type APIError struct {
@@ -16,3 +27,74 @@ func (e *APIError) Error() string {
 	}
 	return fmt.Sprintf("api error: %s %s (%d)", e.Method, e.URL, e.StatusCode)
 }
+
+// ServiceUnavailableError is returned when the API answers with a maintenance
+// page: a 503, or an HTML/non-JSON body where JSON was expected.
+type ServiceUnavailableError struct {
+	Status      int
+	Path        string
+	ContentType string
+	// RetryAfter is parsed from the Retry-After header; zero when absent.
+	RetryAfter time.Duration
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	msg := fmt.Sprintf("service unavailable: %s (status=%d", e.Path, e.Status)
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+	return msg + ")"
+}
+
+// StatusCode returns the HTTP status of the response.
+func (e *ServiceUnavailableError) StatusCode() int {
+	return e.Status
+}
+
+// Unwrap allows errors.Is(err, ErrServiceUnavailable).
+func (e *ServiceUnavailableError) Unwrap() error {
+	return sdkerrors.ErrServiceUnavailable
+}
+
+// maintenanceError reports whether resp looks like a maintenance response and
+// builds the matching error. Only 503s and HTML bodies qualify; JSON errors
+// keep flowing through the regular API error path.
+func maintenanceError(resp *http.Response, body []byte, path string) (*ServiceUnavailableError, bool) {
+	contentType := resp.Header.Get("Content-Type")
+	if resp.StatusCode != http.StatusServiceUnavailable && !isHTMLResponse(contentType, body) {
+		return nil, false
+	}
+	return &ServiceUnavailableError{
+		Status:      resp.StatusCode,
+		Path:        path,
+		ContentType: contentType,
+		RetryAfter:  parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}, true
+}
+
+func isHTMLResponse(contentType string, body []byte) bool {
+	if strings.Contains(strings.ToLower(contentType), "text/html") {
+		return true
+	}
+	trimmed := strings.TrimSpace(string(body))
+	return strings.HasPrefix(trimmed, "<")
+}
+
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := at.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+)
+
+// PoolConfig tunes the connection pool of the HTTP transport used by the SDK.
+// Zero fields keep the net/http default.
+type PoolConfig struct {
+	// MaxIdleConns caps idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost caps idle connections per host. The net/http default
+	// of 2 forces concurrent callers to reconnect; raise it when many
+	// goroutines hit the CLOB at once.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost caps all connections per host, including active ones.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection stays in the pool.
+	IdleConnTimeout time.Duration
+}
+
+// DefaultPoolConfig returns pool settings suited to trading workloads: a
+// large per-host idle pool so that bursts of concurrent orders reuse warm
+// TLS connections.
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 32,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
+
+// NewHTTPTransport returns a clone of http.DefaultTransport with cfg applied.
+func NewHTTPTransport(cfg PoolConfig) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.MaxIdleConns > 0 {
+		t.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	}
+	if cfg.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = cfg.MaxConnsPerHost
+	}
+	if cfg.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+	return t
+}
+
+// NewHTTPClient returns an *http.Client with a pooled transport built from
+// cfg and the given overall request timeout (zero for none).
+func NewHTTPClient(cfg PoolConfig, timeout time.Duration) *http.Client {
+	return &http.Client{Transport: NewHTTPTransport(cfg), Timeout: timeout}
+}
@@ -0,0 +1,25 @@
+package transport
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPTransport(t *testing.T) {
+	tr := NewHTTPTransport(PoolConfig{MaxIdleConnsPerHost: 64, MaxConnsPerHost: 128, IdleConnTimeout: time.Minute})
+	if tr.MaxIdleConnsPerHost != 64 || tr.MaxConnsPerHost != 128 || tr.IdleConnTimeout != time.Minute {
+		t.Errorf("pool settings not applied: %+v", tr)
+	}
+	if def := http.DefaultTransport.(*http.Transport); tr.MaxIdleConns != def.MaxIdleConns || tr == def {
+		t.Errorf("expected a clone keeping MaxIdleConns=%d, got %d", def.MaxIdleConns, tr.MaxIdleConns)
+	}
+
+	client := NewHTTPClient(DefaultPoolConfig(), 5*time.Second)
+	if client.Timeout != 5*time.Second {
+		t.Errorf("timeout = %v, want 5s", client.Timeout)
+	}
+	if pooled := client.Transport.(*http.Transport); pooled.MaxIdleConnsPerHost != 32 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want 32", pooled.MaxIdleConnsPerHost)
+	}
+}
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"context"
+	"math"
 	"sync"
 	"time"
 )
@@ -30,6 +31,24 @@ func NewRateLimiter(requestsPerSecond int) *RateLimiter {
 	}
 }
 
+// NewRateLimiterWithBurst creates a rate limiter that refills at rate tokens
+// per second and holds at most burst tokens. Non-positive values fall back to
+// the NewRateLimiter defaults (10/s, burst equal to the rate).
+func NewRateLimiterWithBurst(rate float64, burst int) *RateLimiter {
+	if rate <= 0 {
+		rate = 10
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(rate))
+	}
+	return &RateLimiter{
+		capacity:     burst,
+		tokensPerSec: rate,
+		tokens:       float64(burst),
+		lastRefill:   time.Now(),
+	}
+}
+
 // Start begins the token refill process (no-op for timestamp-based implementation).
 func (rl *RateLimiter) Start() {
 	// No-op: timestamp-based rate limiter doesn't need a background goroutine
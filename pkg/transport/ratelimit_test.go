@@ -282,3 +282,22 @@ func TestRateLimiter_RateEnforcement(t *testing.T) {
 		}
 	})
 }
+
+func TestNewRateLimiterWithBurst(t *testing.T) {
+	rl := NewRateLimiterWithBurst(0.5, 3)
+	if rl.Capacity() != 3 || rl.Available() != 3 {
+		t.Fatalf("capacity/available = %d/%d, want 3/3", rl.Capacity(), rl.Available())
+	}
+	for i := 0; i < 3; i++ {
+		if !rl.TryAcquire() {
+			t.Fatalf("TryAcquire #%d failed within burst", i)
+		}
+	}
+	if rl.TryAcquire() {
+		t.Error("TryAcquire succeeded beyond burst")
+	}
+
+	if def := NewRateLimiterWithBurst(2.5, 0); def.Capacity() != 3 {
+		t.Errorf("default burst = %d, want 3", def.Capacity())
+	}
+}
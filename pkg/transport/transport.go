@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
@@ -40,12 +41,23 @@ type Client struct {
 	httpClient     Doer
 	baseURL        string
 	userAgent      string
+	headers        http.Header  // extra headers sent with every request
+	authMu         sync.RWMutex // guards signer, apiKey and authErr, which may rotate
 	signer         auth.Signer
 	apiKey         *auth.APIKey
+	authErr        error
 	builder        *auth.BuilderConfig
 	useServerTime  bool
 	rateLimiter    *RateLimiter
+	endpointLimits []endpointLimit
 	circuitBreaker *CircuitBreaker
+	cache          *conditionalCache
+}
+
+// endpointLimit applies a rate limiter to requests whose path starts with prefix.
+type endpointLimit struct {
+	prefix  string
+	limiter *RateLimiter
 }
 
 // NewClient creates a new transport client.
@@ -93,6 +105,23 @@ func (c *Client) SetRateLimiter(rl *RateLimiter) {
 	c.rateLimiter = rl
 }
 
+// SetEndpointRateLimiter applies rl to requests whose path starts with
+// pathPrefix, in addition to the client-wide limiter. When several prefixes
+// match, the longest one wins. A nil rl removes the limit for pathPrefix.
+// Pass the same limiter to several clients to share a budget between them.
+func (c *Client) SetEndpointRateLimiter(pathPrefix string, rl *RateLimiter) {
+	limits := make([]endpointLimit, 0, len(c.endpointLimits)+1)
+	for _, limit := range c.endpointLimits {
+		if limit.prefix != pathPrefix {
+			limits = append(limits, limit)
+		}
+	}
+	if rl != nil {
+		limits = append(limits, endpointLimit{prefix: pathPrefix, limiter: rl})
+	}
+	c.endpointLimits = limits
+}
+
 // SetCircuitBreaker sets the circuit breaker for the client.
 func (c *Client) SetCircuitBreaker(cb *CircuitBreaker) {
 	c.circuitBreaker = cb
@@ -107,15 +136,22 @@ func (c *Client) CloneWithBaseURL(baseURL string) *Client {
 	}
 	clone := NewClient(c.httpClient, baseURL)
 	clone.userAgent = c.userAgent
+	clone.headers = c.headers
 	clone.useServerTime = c.useServerTime
-	clone.signer = c.signer
-	clone.apiKey = c.apiKey
+	clone.SetAuth(c.credentials())
 	clone.builder = c.builder
 	clone.rateLimiter = c.rateLimiter
+	clone.endpointLimits = c.endpointLimits
 	clone.circuitBreaker = c.circuitBreaker
+	clone.cache = c.cache
 	return clone
 }
 
+// BaseURL returns the base URL requests are sent to, without a trailing slash.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
 // SetUserAgent sets the User-Agent header value for all subsequent requests.
 func (c *Client) SetUserAgent(userAgent string) {
 	if userAgent != "" {
@@ -123,10 +159,59 @@ func (c *Client) SetUserAgent(userAgent string) {
 	}
 }
 
+// SetHeader adds a header sent with every request, e.g. for an API gateway
+// or CDN in front of the API. An empty value removes it. Headers set per
+// call take precedence, and the POLY_* authentication headers cannot be
+// set this way: those keys are ignored.
+func (c *Client) SetHeader(key, value string) {
+	key = http.CanonicalHeaderKey(strings.TrimSpace(key))
+	if key == "" || strings.HasPrefix(strings.ToUpper(key), "POLY_") {
+		return
+	}
+	// Copy on write: clones share the map.
+	headers := c.headers.Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+	if value == "" {
+		headers.Del(key)
+	} else {
+		headers.Set(key, value)
+	}
+	c.headers = headers
+}
+
 // SetAuth configures the client with credentials for Layer 2 HMAC authentication.
+// It is safe to call while requests are in flight; requests signed after it
+// returns use the new credentials.
+//
+// The API secret is validated here, and an undecodable secret is reported
+// by AuthError and returned by every authenticated request before it is
+// sent.
 func (c *Client) SetAuth(signer auth.Signer, apiKey *auth.APIKey) {
+	var err error
+	if apiKey != nil {
+		err = auth.ValidateSecret(apiKey.Secret)
+	}
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
 	c.signer = signer
 	c.apiKey = apiKey
+	c.authErr = err
+}
+
+// AuthError returns the validation error of the credentials passed to
+// SetAuth, or nil when they are usable.
+func (c *Client) AuthError() error {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.authErr
+}
+
+func (c *Client) credentials() (auth.Signer, *auth.APIKey) {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.signer, c.apiKey
 }
 
 // SetBuilderConfig configures the client for builder attribution headers.
@@ -147,23 +232,43 @@ func (c *Client) Call(ctx context.Context, method, path string, query url.Values
 	if c.circuitBreaker != nil {
 		return c.circuitBreaker.CallWithFailurePredicate(func() error {
 			// Apply rate limiting only after breaker allows the request.
-			if c.rateLimiter != nil {
-				if err := c.rateLimiter.Wait(ctx); err != nil {
-					return fmt.Errorf("rate limiter: %w", err)
-				}
+			if err := c.waitRateLimit(ctx, path); err != nil {
+				return err
 			}
 			return c.doCall(ctx, method, path, query, body, dest, headers)
 		}, shouldCountCircuitBreakerFailure)
 	}
 
 	// Apply rate limiting if configured (no circuit breaker).
+	if err := c.waitRateLimit(ctx, path); err != nil {
+		return err
+	}
+
+	return c.doCall(ctx, method, path, query, body, dest, headers)
+}
+
+// waitRateLimit blocks until the client-wide and endpoint limiters for path
+// allow a request, or ctx is done.
+func (c *Client) waitRateLimit(ctx context.Context, path string) error {
 	if c.rateLimiter != nil {
 		if err := c.rateLimiter.Wait(ctx); err != nil {
 			return fmt.Errorf("rate limiter: %w", err)
 		}
 	}
-
-	return c.doCall(ctx, method, path, query, body, dest, headers)
+	var endpoint *RateLimiter
+	matched := -1
+	for _, limit := range c.endpointLimits {
+		if strings.HasPrefix(path, limit.prefix) && len(limit.prefix) > matched {
+			endpoint = limit.limiter
+			matched = len(limit.prefix)
+		}
+	}
+	if endpoint != nil {
+		if err := endpoint.Wait(ctx); err != nil {
+			return fmt.Errorf("rate limiter %s: %w", path, err)
+		}
+	}
+	return nil
 }
 
 func shouldCountCircuitBreakerFailure(err error) bool {
@@ -221,13 +326,11 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 	}
 
 	var lastErr error
+	// Exponential backoff: 100ms, 200ms, 400ms...
+	backoff := Backoff{Base: defaultMinWait, Factor: 2, Max: defaultMaxWait}
 	for attempt := 0; attempt <= defaultMaxRetries; attempt++ {
 		if attempt > 0 {
-			// Exponential backoff: 100ms, 200ms, 400ms...
-			wait := defaultMinWait * time.Duration(1<<uint(attempt-1))
-			if wait > defaultMaxWait {
-				wait = defaultMaxWait
-			}
+			wait := backoff.Next()
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -247,6 +350,9 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 
 		req.Header.Set("User-Agent", c.userAgent)
 		req.Header.Set("Accept", "application/json")
+		for k, values := range c.headers {
+			req.Header[k] = values
+		}
 		if len(payload) > 0 {
 			req.Header.Set("Content-Type", "application/json")
 		}
@@ -258,7 +364,11 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 
 		// L2 Authentication (only if no custom auth headers provided)
 		// If custom POLY_SIGNATURE is provided, skip auto-L2 auth
-		if c.apiKey != nil && c.signer != nil && req.Header.Get(auth.HeaderPolySignature) == "" {
+		signer, apiKey := c.credentials()
+		if apiKey != nil && signer != nil && req.Header.Get(auth.HeaderPolySignature) == "" {
+			if err := c.AuthError(); err != nil {
+				return fmt.Errorf("failed to sign request: %w", err)
+			}
 			ts := time.Now().Unix()
 			if c.useServerTime {
 				serverTime, err := c.serverTime(ctx)
@@ -275,14 +385,14 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 				message += strings.ReplaceAll(*serialized, "'", "\"")
 			}
 
-			sig, err := auth.SignHMAC(c.apiKey.Secret, message)
+			sig, err := auth.SignHMAC(apiKey.Secret, message)
 			if err != nil {
 				return fmt.Errorf("failed to sign request: %w", err)
 			}
 
-			req.Header.Set(auth.HeaderPolyAddress, c.signer.Address().Hex())
-			req.Header.Set(auth.HeaderPolyAPIKey, c.apiKey.Key)
-			req.Header.Set(auth.HeaderPolyPassphrase, c.apiKey.Passphrase)
+			req.Header.Set(auth.HeaderPolyAddress, signer.Address().Hex())
+			req.Header.Set(auth.HeaderPolyAPIKey, apiKey.Key)
+			req.Header.Set(auth.HeaderPolyPassphrase, apiKey.Passphrase)
 			req.Header.Set(auth.HeaderPolyTimestamp, fmt.Sprintf("%d", ts))
 			req.Header.Set(auth.HeaderPolySignature, sig)
 
@@ -300,6 +410,22 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 			}
 		}
 
+		// The cache is keyed by URL alone and shared by clones, so only
+		// unauthenticated requests may read or populate it.
+		cacheable := c.cache != nil && method == http.MethodGet && !hasCredentials(req.Header)
+		var cached *cachedResponse
+		if cacheable {
+			if entry, ok := c.cache.get(u); ok {
+				cached = entry
+				if entry.etag != "" && req.Header.Get("If-None-Match") == "" {
+					req.Header.Set("If-None-Match", entry.etag)
+				}
+				if entry.lastModified != "" && req.Header.Get("If-Modified-Since") == "" {
+					req.Header.Set("If-Modified-Since", entry.lastModified)
+				}
+			}
+		}
+
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = fmt.Errorf("request failed: %w", err)
@@ -314,11 +440,24 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 			continue
 		}
 
+		if cacheable {
+			switch {
+			case resp.StatusCode == http.StatusNotModified && cached != nil:
+				respBytes = cached.body
+			case resp.StatusCode >= 200 && resp.StatusCode < 300:
+				c.cache.store(u, resp, respBytes)
+			}
+		}
+
 		// Check for error status codes
 		if resp.StatusCode >= 400 {
 			// Check if retryable (429 or 5xx)
 			if resp.StatusCode == 429 || resp.StatusCode >= 500 {
-				lastErr = &httpStatusError{status: resp.StatusCode, body: string(respBytes)}
+				if maintErr, ok := maintenanceError(resp, respBytes, path); ok {
+					lastErr = maintErr
+				} else {
+					lastErr = &httpStatusError{status: resp.StatusCode, body: string(respBytes)}
+				}
 				continue
 			}
 
@@ -339,6 +478,9 @@ func (c *Client) doCall(ctx context.Context, method, path string, query url.Valu
 		// Unmarshal success response
 		if dest != nil {
 			if err := json.Unmarshal(respBytes, dest); err != nil {
+				if maintErr, ok := maintenanceError(resp, respBytes, path); ok {
+					return maintErr
+				}
 				return fmt.Errorf("failed to unmarshal response: %w", err)
 			}
 		}
@@ -359,6 +501,9 @@ func (c *Client) serverTime(ctx context.Context) (int64, error) {
 	}
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Accept", "application/json")
+	for k, values := range c.headers {
+		req.Header[k] = values
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
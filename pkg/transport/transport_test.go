@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/pkg/types"
 )
 
@@ -167,6 +168,164 @@ func TestClient_Call_CircuitBreakerIgnoresClientErrors(t *testing.T) {
 	})
 }
 
+func TestClient_Call_EndpointRateLimiter(t *testing.T) {
+	mock := &MockDoer{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		},
+	}
+	client := NewClient(mock, "http://example.com")
+	client.SetEndpointRateLimiter("/order", NewRateLimiterWithBurst(0.001, 1))
+	client.SetEndpointRateLimiter("/orders", NewRateLimiterWithBurst(0.001, 2))
+	clone := client.CloneWithBaseURL("http://other.com")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Call(ctx, "POST", "/order", nil, nil, nil, nil); err != nil {
+		t.Fatalf("first /order call failed: %v", err)
+	}
+	// The clone shares the endpoint budget.
+	if err := clone.Call(ctx, "POST", "/order", nil, nil, nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected /order to be limited, got %v", err)
+	}
+	// The longest prefix wins, so /orders has its own budget.
+	if err := client.Call(context.Background(), "DELETE", "/orders", nil, nil, nil, nil); err != nil {
+		t.Fatalf("/orders call failed: %v", err)
+	}
+	if err := client.Call(context.Background(), "GET", "/book", nil, nil, nil, nil); err != nil {
+		t.Fatalf("unlimited call failed: %v", err)
+	}
+	if len(mock.calls) != 3 {
+		t.Errorf("doer calls = %d, want 3", len(mock.calls))
+	}
+
+	client.SetEndpointRateLimiter("/order", nil)
+	if err := client.Call(context.Background(), "POST", "/order", nil, nil, nil, nil); err != nil {
+		t.Fatalf("/order call after removing limit failed: %v", err)
+	}
+}
+
+func TestClient_Call_Maintenance(t *testing.T) {
+	t.Run("HTML body on success status", func(t *testing.T) {
+		mock := &MockDoer{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 200,
+					Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+					Body:       io.NopCloser(strings.NewReader("<html><body>Down for maintenance</body></html>")),
+				}, nil
+			},
+		}
+
+		client := NewClient(mock, "http://example.com")
+		var dest map[string]interface{}
+		err := client.Get(context.Background(), "/book", nil, &dest)
+		if !errors.Is(err, ErrServiceUnavailable) {
+			t.Fatalf("expected ErrServiceUnavailable, got %v", err)
+		}
+		var svcErr *ServiceUnavailableError
+		if !errors.As(err, &svcErr) {
+			t.Fatalf("expected *ServiceUnavailableError, got %T", err)
+		}
+		if svcErr.StatusCode() != 200 || svcErr.Path != "/book" {
+			t.Errorf("unexpected error fields: %+v", svcErr)
+		}
+		if len(mock.calls) != 1 {
+			t.Errorf("expected 1 attempt, got %d", len(mock.calls))
+		}
+	})
+
+	t.Run("503 with Retry-After", func(t *testing.T) {
+		mock := &MockDoer{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 503,
+					Header:     http.Header{"Retry-After": []string{"30"}},
+					Body:       io.NopCloser(strings.NewReader("Service Unavailable")),
+				}, nil
+			},
+		}
+
+		client := NewClient(mock, "http://example.com")
+		err := client.Get(context.Background(), "/markets", nil, nil)
+		var svcErr *ServiceUnavailableError
+		if !errors.As(err, &svcErr) {
+			t.Fatalf("expected *ServiceUnavailableError, got %T: %v", err, err)
+		}
+		if svcErr.RetryAfter != 30*time.Second {
+			t.Errorf("RetryAfter = %v, want 30s", svcErr.RetryAfter)
+		}
+		if svcErr.StatusCode() != 503 {
+			t.Errorf("StatusCode() = %d, want 503", svcErr.StatusCode())
+		}
+	})
+
+	t.Run("JSON 5xx keeps status error", func(t *testing.T) {
+		mock := &MockDoer{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 500,
+					Body:       io.NopCloser(strings.NewReader(`{"error":"internal"}`)),
+				}, nil
+			},
+		}
+
+		client := NewClient(mock, "http://example.com")
+		err := client.Get(context.Background(), "/markets", nil, nil)
+		if err == nil || errors.Is(err, ErrServiceUnavailable) {
+			t.Fatalf("expected plain status error, got %v", err)
+		}
+	})
+}
+
+func TestClient_SetAuthInvalidSecret(t *testing.T) {
+	mock := &MockDoer{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		},
+	}
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+	client := NewClient(mock, "http://example.com")
+	client.SetAuth(signer, &auth.APIKey{Key: "k", Secret: "not base64!", Passphrase: "p"})
+	if !errors.Is(client.AuthError(), auth.ErrInvalidAPISecret) {
+		t.Fatalf("AuthError = %v, want ErrInvalidAPISecret", client.AuthError())
+	}
+	if err := client.Get(context.Background(), "/orders", nil, nil); !errors.Is(err, auth.ErrInvalidAPISecret) {
+		t.Errorf("Get error = %v, want ErrInvalidAPISecret", err)
+	}
+	if len(mock.calls) != 0 {
+		t.Errorf("request was sent with an invalid secret")
+	}
+
+	client.SetAuth(signer, &auth.APIKey{Key: "k", Secret: "c2VjcmV0", Passphrase: "p"})
+	if err := client.AuthError(); err != nil {
+		t.Errorf("AuthError after valid secret = %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"-1", 0},
+		{"garbage", 0},
+		{now.Add(45 * time.Second).Format(http.TimeFormat), 45 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
 func TestClientHelpers(t *testing.T) {
 	ctx := context.Background()
 
@@ -320,3 +479,47 @@ func TestMarshalBody(t *testing.T) {
 		_ = client.Post(context.Background(), "/", c.input, nil)
 	}
 }
+
+func TestClient_SetHeader(t *testing.T) {
+	doer := &MockDoer{DoFunc: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`)), Header: make(http.Header)}, nil
+	}}
+	client := NewClient(doer, "http://example")
+	client.SetUserAgent("my-bot/2.0")
+	client.SetHeader("X-Gateway-Route", "eu")
+	client.SetHeader("X-Dropped", "1")
+	client.SetHeader("X-Dropped", "")
+	client.SetHeader(auth.HeaderPolyAPIKey, "spoofed")
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+	client.SetAuth(signer, &auth.APIKey{Key: "k1", Secret: "c2VjcmV0", Passphrase: "p1"})
+
+	clone := client.CloneWithBaseURL("http://other")
+	clone.SetHeader("X-Gateway-Route", "us")
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/markets", nil, nil); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if err := client.CallWithHeaders(ctx, http.MethodGet, "/markets", nil, nil, nil, map[string]string{"X-Gateway-Route": "ap"}); err != nil {
+		t.Fatalf("CallWithHeaders failed: %v", err)
+	}
+	req := doer.calls[0]
+	if got := req.Header.Get("User-Agent"); got != "my-bot/2.0" {
+		t.Errorf("User-Agent = %q", got)
+	}
+	if got := req.Header.Get("X-Gateway-Route"); got != "eu" {
+		t.Errorf("X-Gateway-Route = %q, want eu (clones must not share changes)", got)
+	}
+	if _, ok := req.Header["X-Dropped"]; ok {
+		t.Error("removed header was sent")
+	}
+	if got := req.Header.Get(auth.HeaderPolyAPIKey); got != "k1" {
+		t.Errorf("%s = %q, want the real API key", auth.HeaderPolyAPIKey, got)
+	}
+	if got := doer.calls[1].Header.Get("X-Gateway-Route"); got != "ap" {
+		t.Errorf("per-call header should win, got %q", got)
+	}
+}
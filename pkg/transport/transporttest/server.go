@@ -0,0 +1,225 @@
+// Package transporttest provides an httptest-backed API server for testing
+// code built on transport.Client, such as the CLOB, Gamma and Data clients.
+//
+// Responses are registered per method and path, every request is recorded,
+// and Client returns a *transport.Client wired to the server:
+//
+//	srv := transporttest.NewServer(t).
+//		Handle("GET", "/book", http.StatusOK, clobtypes.OrderBookResponse{AssetID: "123"})
+//	client := clob.NewClient(srv.Client())
+//
+// Requests without a registered route get a 404 with a JSON error body.
+package transporttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport"
+)
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// DecodeJSON unmarshals the request body into v.
+func (r Request) DecodeJSON(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Server is a test API server with canned responses. It is safe for
+// concurrent use.
+type Server struct {
+	// URL is the base URL of the server.
+	URL string
+
+	srv      *httptest.Server
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []Request
+}
+
+// NewServer starts a Server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{routes: make(map[string]http.HandlerFunc)}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Close shuts the server down. It is called automatically when the test
+// that created the server finishes.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a transport client for the server. Each call returns a new
+// client, so auth and other settings can differ between clients.
+func (s *Server) Client() *transport.Client {
+	return transport.NewClient(s.srv.Client(), s.URL)
+}
+
+// Handle registers a canned response for method and path. body is written
+// as-is when it is a []byte or string and JSON-encoded otherwise.
+func (s *Server) Handle(method, path string, status int, body any) *Server {
+	payload, err := encodeBody(body)
+	return s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(payload)
+	})
+}
+
+// HandleFunc registers fn for method and path, replacing any previous
+// handler. A nil fn removes the route. The request body has already been
+// recorded and can be read again by fn.
+func (s *Server) HandleFunc(method, path string, fn http.HandlerFunc) *Server {
+	key := routeKey(method, path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if fn == nil {
+		delete(s.routes, key)
+	} else {
+		s.routes[key] = fn
+	}
+	return s
+}
+
+// Requests returns a copy of every request received, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests received for method and path, in order.
+func (s *Server) RequestsTo(method, path string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Request
+	for _, req := range s.requests {
+		if routeKey(req.Method, req.Path) == routeKey(method, path) {
+			out = append(out, req)
+		}
+	}
+	return out
+}
+
+// LastRequest returns the most recent request for method and path.
+func (s *Server) LastRequest(method, path string) (Request, bool) {
+	reqs := s.RequestsTo(method, path)
+	if len(reqs) == 0 {
+		return Request{}, false
+	}
+	return reqs[len(reqs)-1], true
+}
+
+// AssertJSONBody fails the test unless the last request for method and path
+// has a JSON body equal to want. want may be a JSON string, raw bytes, or a
+// value that is marshaled to JSON; comparison ignores formatting and key
+// order.
+func (s *Server) AssertJSONBody(t testing.TB, method, path string, want any) {
+	t.Helper()
+	req, ok := s.LastRequest(method, path)
+	if !ok {
+		t.Errorf("transporttest: no request for %s %s", method, path)
+		return
+	}
+	wantBytes, err := encodeBody(want)
+	if err != nil {
+		t.Fatalf("transporttest: encode expected body: %v", err)
+	}
+	var got, expected any
+	if err := json.Unmarshal(req.Body, &got); err != nil {
+		t.Errorf("transporttest: %s %s body is not JSON: %v (%s)", method, path, err, req.Body)
+		return
+	}
+	if err := json.Unmarshal(wantBytes, &expected); err != nil {
+		t.Fatalf("transporttest: expected body is not JSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("transporttest: %s %s body = %s, want %s", method, path, req.Body, wantBytes)
+	}
+}
+
+// AssertHeader fails the test unless the last request for method and path
+// carried header key with value want. An empty want only checks that the
+// header is present.
+func (s *Server) AssertHeader(t testing.TB, method, path, key, want string) {
+	t.Helper()
+	req, ok := s.LastRequest(method, path)
+	if !ok {
+		t.Errorf("transporttest: no request for %s %s", method, path)
+		return
+	}
+	values, present := req.Header[http.CanonicalHeaderKey(key)]
+	switch {
+	case !present:
+		t.Errorf("transporttest: %s %s missing header %s", method, path, key)
+	case want != "" && (len(values) == 0 || values[0] != want):
+		t.Errorf("transporttest: %s %s header %s = %q, want %q", method, path, key, values, want)
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	fn := s.routes[routeKey(r.Method, r.URL.Path)]
+	s.mu.Unlock()
+
+	if fn == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"error": fmt.Sprintf("transporttest: no route for %s %s", r.Method, r.URL.Path),
+		})
+		return
+	}
+	fn(w, r)
+}
+
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " /" + strings.TrimLeft(path, "/")
+}
+
+func encodeBody(body any) ([]byte, error) {
+	switch v := body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return json.Marshal(v)
+	}
+}
@@ -0,0 +1,74 @@
+package transporttest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport/transporttest"
+)
+
+func TestServerCannedResponses(t *testing.T) {
+	srv := transporttest.NewServer(t).
+		Handle("GET", "/book", http.StatusOK, clobtypes.OrderBookResponse{MarketID: "m1"}).
+		Handle("DELETE", "/orders", http.StatusOK, `{"canceled":["o1","o2"]}`)
+
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner failed: %v", err)
+	}
+	client := clob.NewClient(srv.Client()).WithAuth(signer, &auth.APIKey{Key: "key", Secret: "c2VjcmV0", Passphrase: "pass"})
+	ctx := context.Background()
+
+	book, err := client.OrderBook(ctx, &clobtypes.BookRequest{TokenID: "123"})
+	if err != nil {
+		t.Fatalf("OrderBook failed: %v", err)
+	}
+	if book.MarketID != "m1" {
+		t.Errorf("market = %q, want m1", book.MarketID)
+	}
+	req, ok := srv.LastRequest("GET", "/book")
+	if !ok || req.Query.Get("token_id") != "123" {
+		t.Errorf("unexpected book request: %+v", req)
+	}
+
+	resp, err := client.CancelOrders(ctx, &clobtypes.CancelOrdersRequest{OrderIDs: []string{"o1", "o2"}})
+	if err != nil {
+		t.Fatalf("CancelOrders failed: %v", err)
+	}
+	if len(resp.Canceled) != 2 {
+		t.Errorf("canceled = %v", resp.Canceled)
+	}
+	srv.AssertJSONBody(t, "DELETE", "/orders", []string{"o1", "o2"})
+	srv.AssertHeader(t, "DELETE", "/orders", auth.HeaderPolyAPIKey, "key")
+	srv.AssertHeader(t, "DELETE", "/orders", "Content-Type", "application/json")
+
+	if _, err := client.Trades(ctx, nil); err == nil {
+		t.Error("expected error for unregistered route")
+	}
+	if got := len(srv.Requests()); got != 3 {
+		t.Errorf("recorded %d requests, want 3", got)
+	}
+}
+
+func TestServerHandleFunc(t *testing.T) {
+	srv := transporttest.NewServer(t)
+	srv.HandleFunc("POST", "/echo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+
+	var out struct {
+		OK bool `json:"ok"`
+	}
+	if err := srv.Client().Post(context.Background(), "echo", map[string]int{"n": 1}, &out); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if !out.OK {
+		t.Error("expected ok response")
+	}
+	srv.AssertJSONBody(t, "POST", "/echo", `{"n": 1}`)
+}
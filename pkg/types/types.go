@@ -43,6 +43,18 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("api error: %s (status=%d)", e.Message, e.Status)
 }
 
+// BoundedIntError indicates a numeric parameter is outside allowed bounds.
+type BoundedIntError struct {
+	Value     int
+	Min       int
+	Max       int
+	ParamName string
+}
+
+func (e BoundedIntError) Error() string {
+	return fmt.Sprintf("%s must be between %d and %d (got %d)", e.ParamName, e.Min, e.Max, e.Value)
+}
+
 // MarshalJSON encodes the U256 as a decimal string.
 func (u U256) MarshalJSON() ([]byte, error) {
 	if u.Int == nil {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 )
@@ -25,6 +26,26 @@ func TestU256(t *testing.T) {
 	}
 }
 
+func TestU256FullWidthTokenID(t *testing.T) {
+	const tokenID = "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+	for _, raw := range []string{`"` + tokenID + `"`, tokenID} {
+		var u U256
+		if err := json.Unmarshal([]byte(raw), &u); err != nil {
+			t.Fatalf("Unmarshal(%s) failed: %v", raw, err)
+		}
+		if u.String() != tokenID {
+			t.Fatalf("Unmarshal(%s) = %s, want %s", raw, u.String(), tokenID)
+		}
+		out, err := json.Marshal(u)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(out) != `"`+tokenID+`"` {
+			t.Errorf("Marshal = %s, want quoted %s", out, tokenID)
+		}
+	}
+}
+
 func TestAddress(t *testing.T) {
 	addrStr := "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
 	var a Address
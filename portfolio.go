@@ -0,0 +1,125 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/data"
+)
+
+// portfolioMidpointBatch is the number of tokens priced per Midpoints call.
+const portfolioMidpointBatch = 100
+
+// PortfolioPosition is a Data API position marked to the live CLOB midpoint.
+type PortfolioPosition struct {
+	data.Position
+	// Mark is the live midpoint, or the position's CurPrice when no live
+	// price could be fetched (see Live).
+	Mark decimal.Decimal
+	// Live reports whether Mark came from the CLOB.
+	Live bool
+	// Cost is Size * AvgPrice.
+	Cost decimal.Decimal
+	// Value is Size * Mark.
+	Value decimal.Decimal
+	// PnL is the unrealized profit or loss, Value - Cost.
+	PnL decimal.Decimal
+}
+
+// Portfolio is a user's open positions marked to market with live CLOB
+// midpoints rather than the Data API's curPrice snapshot.
+type Portfolio struct {
+	User      common.Address
+	Positions []PortfolioPosition
+	// TotalCost, TotalValue and TotalPnL sum the positions' Cost, Value
+	// and PnL.
+	TotalCost  decimal.Decimal
+	TotalValue decimal.Decimal
+	TotalPnL   decimal.Decimal
+}
+
+// GetPortfolio fetches every open position of user from the Data API and
+// prices each one with a live CLOB midpoint, batched through Midpoints.
+//
+// Failing to list positions is returned as an error with an empty
+// portfolio. Failed midpoint batches do not abort the call: the affected
+// positions fall back to their curPrice with Live false, and the returned
+// error joins every batch failure alongside the complete portfolio.
+func (c *Client) GetPortfolio(ctx context.Context, user common.Address) (Portfolio, error) {
+	if c.Data == nil {
+		return Portfolio{}, fmt.Errorf("data client is not configured")
+	}
+	if c.CLOB == nil {
+		return Portfolio{}, fmt.Errorf("clob client is not configured")
+	}
+	positions, err := c.Data.PositionsAll(ctx, &data.PositionsRequest{User: user})
+	if err != nil {
+		return Portfolio{}, err
+	}
+
+	tokenIDs := make([]string, 0, len(positions))
+	seen := make(map[string]struct{}, len(positions))
+	for _, pos := range positions {
+		if pos.Asset.Int == nil {
+			continue
+		}
+		id := pos.Asset.String()
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			tokenIDs = append(tokenIDs, id)
+		}
+	}
+	marks, errs := c.liveMidpoints(ctx, tokenIDs)
+
+	portfolio := Portfolio{User: user, Positions: make([]PortfolioPosition, 0, len(positions))}
+	for _, pos := range positions {
+		entry := PortfolioPosition{Position: pos, Mark: pos.CurPrice}
+		if pos.Asset.Int != nil {
+			if mark, ok := marks[pos.Asset.String()]; ok {
+				entry.Mark, entry.Live = mark, true
+			}
+		}
+		entry.Cost = pos.Size.Mul(pos.AvgPrice)
+		entry.Value = pos.Size.Mul(entry.Mark)
+		entry.PnL = entry.Value.Sub(entry.Cost)
+
+		portfolio.TotalCost = portfolio.TotalCost.Add(entry.Cost)
+		portfolio.TotalValue = portfolio.TotalValue.Add(entry.Value)
+		portfolio.TotalPnL = portfolio.TotalPnL.Add(entry.PnL)
+		portfolio.Positions = append(portfolio.Positions, entry)
+	}
+	return portfolio, errors.Join(errs...)
+}
+
+// liveMidpoints prices tokenIDs in batches and returns the midpoints that were
+// fetched and parsed, with one error per failed batch or unparsable price.
+func (c *Client) liveMidpoints(ctx context.Context, tokenIDs []string) (map[string]decimal.Decimal, []error) {
+	marks := make(map[string]decimal.Decimal, len(tokenIDs))
+	var errs []error
+	for start := 0; start < len(tokenIDs); start += portfolioMidpointBatch {
+		batch := tokenIDs[start:min(start+portfolioMidpointBatch, len(tokenIDs))]
+		resp, err := c.CLOB.Midpoints(ctx, &clobtypes.MidpointsRequest{TokenIDs: batch})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("midpoints for %d tokens: %w", len(batch), err))
+			continue
+		}
+		if len(resp) != len(batch) {
+			errs = append(errs, fmt.Errorf("midpoints returned %d prices for %d tokens", len(resp), len(batch)))
+			continue
+		}
+		for i, mid := range resp {
+			mark, err := decimal.NewFromString(mid.Midpoint)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid midpoint %q for token %s: %w", mid.Midpoint, batch[i], err))
+				continue
+			}
+			marks[batch[i]] = mark
+		}
+	}
+	return marks, errs
+}
@@ -0,0 +1,60 @@
+package polymarket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtest"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/data"
+	"github.com/GoPolymarket/polymarket-go-sdk/pkg/transport/transporttest"
+)
+
+func TestGetPortfolio(t *testing.T) {
+	srv := transporttest.NewServer(t).Handle("GET", "/positions", http.StatusOK, `[
+		{"asset":"111","size":"10","avgPrice":"0.4","curPrice":"0.45"},
+		{"asset":"222","size":"5","avgPrice":"0.8","curPrice":"0.7"}
+	]`)
+	mock := clobtest.NewMockClient()
+	mock.On("Midpoints", clobtypes.MidpointsResponse{{Midpoint: "0.5"}, {Midpoint: "0.6"}}, nil)
+	client := NewClient(WithData(data.NewClient(srv.Client())), WithCLOB(mock))
+	user := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	portfolio, err := client.GetPortfolio(context.Background(), user)
+	if err != nil {
+		t.Fatalf("GetPortfolio failed: %v", err)
+	}
+	if len(portfolio.Positions) != 2 {
+		t.Fatalf("positions = %d, want 2", len(portfolio.Positions))
+	}
+	first := portfolio.Positions[0]
+	if !first.Live || first.Mark.String() != "0.5" || first.Value.String() != "5" || first.PnL.String() != "1" {
+		t.Errorf("unexpected first position: mark %s value %s pnl %s live %v", first.Mark, first.Value, first.PnL, first.Live)
+	}
+	if portfolio.TotalCost.String() != "8" || portfolio.TotalValue.String() != "8" || !portfolio.TotalPnL.IsZero() {
+		t.Errorf("totals = cost %s value %s pnl %s", portfolio.TotalCost, portfolio.TotalValue, portfolio.TotalPnL)
+	}
+	call, ok := mock.LastCall("Midpoints")
+	if !ok {
+		t.Fatal("expected Midpoints call")
+	}
+	if ids := call.Args[0].(*clobtypes.MidpointsRequest).TokenIDs; len(ids) != 2 || ids[0] != "111" || ids[1] != "222" {
+		t.Errorf("token ids = %v", ids)
+	}
+
+	mock.On("Midpoints", nil, errors.New("boom"))
+	portfolio, err = client.GetPortfolio(context.Background(), user)
+	if err == nil {
+		t.Fatal("expected midpoint error")
+	}
+	if len(portfolio.Positions) != 2 || portfolio.Positions[1].Live || portfolio.Positions[1].Mark.String() != "0.7" {
+		t.Errorf("expected curPrice fallback, got %+v", portfolio.Positions)
+	}
+	if portfolio.TotalValue.String() != "8" {
+		t.Errorf("fallback total value = %s, want 8", portfolio.TotalValue)
+	}
+}